@@ -1,10 +1,14 @@
 package app
 
 import (
+	"errors"
 	"fmt"
 	"github.com/bossncn/restaurant-reservation-service/config"
 	"github.com/bossncn/restaurant-reservation-service/internal/adapters/event"
 	"github.com/bossncn/restaurant-reservation-service/internal/adapters/http"
+	"github.com/bossncn/restaurant-reservation-service/internal/adapters/memory"
+	"github.com/bossncn/restaurant-reservation-service/internal/core/model"
+	"github.com/bossncn/restaurant-reservation-service/internal/core/service"
 	"go.uber.org/zap"
 )
 
@@ -25,6 +29,25 @@ func initLogger(cfg *config.Config) (*zap.Logger, error) {
 	return logger, err
 }
 
+// initPaymentGateway returns the configured payment gateway, or nil when none is configured and no
+// booking asks for a deposit.
+func initPaymentGateway(cfg *config.Config) (service.PaymentGateway, error) {
+	switch cfg.PaymentGateway {
+	case "fake":
+		if cfg.AppEnv == "production" {
+			return nil, errors.New("the fake payment gateway cannot be used in production")
+		}
+		return memory.NewFakePaymentGateway(), nil
+	case "":
+		if cfg.DepositMinPartySize > 0 || len(cfg.DepositDates) > 0 {
+			return nil, errors.New("deposits are configured without a payment gateway")
+		}
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("unknown payment gateway %q", cfg.PaymentGateway)
+	}
+}
+
 func Run(cfg *config.Config) {
 	logger, err := initLogger(cfg)
 
@@ -34,12 +57,39 @@ func Run(cfg *config.Config) {
 
 	repo := http.InitRepository()
 
+	allocator, err := service.NewTableAllocator(cfg.TableAllocator)
+	if err != nil {
+		logger.Fatal("Failed to initialize table allocator", zap.Error(err))
+	}
+
+	turnTimes, err := service.NewTurnTimes(cfg.TurnTimes, cfg.TurnBuffer)
+	if err != nil {
+		logger.Fatal("Failed to initialize turn times", zap.Error(err))
+	}
+
+	sizing, err := service.NewSizingPolicy(cfg.SizingPolicy, service.PartySizeLimits{Min: cfg.MinPartySize, Max: cfg.MaxPartySize}, cfg.SeatsPerTable, cfg.PartyTables)
+	if err != nil {
+		logger.Fatal("Failed to initialize sizing policy", zap.Error(err))
+	}
+
+	payments, err := initPaymentGateway(cfg)
+	if err != nil {
+		logger.Fatal("Failed to initialize payment gateway", zap.Error(err))
+	}
+
 	// Init Event Processor
-	eventProcessor, requestEvent := event.NewProcessor(repo.TableRepository, repo.ReservationRepository, logger)
+	opts := []event.Option{event.WithAllocator(allocator), event.WithWaitlist(repo.WaitlistRepository), event.WithSchedule(repo.ScheduleRepository), event.WithBlocks(repo.BlockRepository), event.WithHoldTTL(cfg.HoldTTL), event.WithTurnTimes(turnTimes), event.WithSizing(sizing),
+		event.WithBookingWindow(model.ChannelPublic, model.BookingWindow{MinLeadTime: cfg.PublicMinLeadTime, MaxAdvance: cfg.PublicMaxAdvance}),
+		event.WithBookingWindow(model.ChannelStaff, model.BookingWindow{MinLeadTime: cfg.StaffMinLeadTime, MaxAdvance: cfg.StaffMaxAdvance}),
+		event.WithCancellationPolicy(model.CancellationPolicy{Cutoff: cfg.CancellationCutoff, Fee: cfg.CancellationFee, ForfeitDeposit: cfg.CancellationForfeitDeposit})}
+	if payments != nil {
+		opts = append(opts, event.WithPayments(payments, model.DepositPolicy{MinPartySize: cfg.DepositMinPartySize, Dates: cfg.DepositDates, AmountPerGuest: cfg.DepositPerGuest, PaymentTimeout: cfg.PaymentTimeout}))
+	}
+	eventProcessor, requestEvent := event.NewProcessor(repo.TableRepository, repo.ReservationRepository, logger, opts...)
 
 	service := http.InitService(logger, repo, requestEvent)
 	handler := http.InitHandler(logger, service)
-	mdw := http.InitMiddleware(logger)
+	mdw := http.InitMiddleware(logger, service)
 
 	defer func(logger *zap.Logger) {
 		err := logger.Sync()
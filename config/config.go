@@ -5,10 +5,49 @@ import (
 	"github.com/go-playground/validator/v10"
 	"github.com/joho/godotenv"
 	"github.com/kelseyhightower/envconfig"
+	"time"
 )
 
 type Config struct {
-	AppEnv string `envconfig:"APP_ENV" validate:"required" default:"development"`
+	AppEnv         string        `envconfig:"APP_ENV" validate:"required" default:"development"`
+	TableAllocator string        `envconfig:"TABLE_ALLOCATOR" validate:"oneof=best-fit bin-packing combine-adjacent" default:"best-fit"`
+	HoldTTL        time.Duration `envconfig:"HOLD_TTL" validate:"gt=0" default:"10m"`
+	// TurnTimes maps the smallest party size a turn time applies to onto the turn time, e.g. "1:90m,6:150m".
+	TurnTimes  map[int]time.Duration `envconfig:"TURN_TIMES"`
+	TurnBuffer time.Duration         `envconfig:"TURN_BUFFER" validate:"gte=0" default:"0s"`
+	// SizingPolicy caps the tables a party is spread across: "fixed" allows one table per SEATS_PER_TABLE
+	// guests and "lookup" reads PARTY_TABLES. Left empty, any combination the allocator picks is used.
+	SizingPolicy  string `envconfig:"SIZING_POLICY" validate:"omitempty,oneof=fixed lookup"`
+	SeatsPerTable int    `envconfig:"SEATS_PER_TABLE" validate:"gt=0" default:"4"`
+	// PartyTables maps the smallest party size a rule applies to onto the most tables it may use, e.g. "1:1,5:2,9:3".
+	PartyTables  map[int]int `envconfig:"PARTY_TABLES"`
+	MinPartySize int         `envconfig:"MIN_PARTY_SIZE" validate:"gte=1" default:"1"`
+	// MaxPartySize of zero takes parties of any size.
+	MaxPartySize int `envconfig:"MAX_PARTY_SIZE" validate:"gte=0" default:"0"`
+	// Booking windows limit how soon before and how far ahead of its start time a booking may be
+	// made, e.g. "2h" and "1440h" for 60 days. Online bookings use the public window and staff the
+	// staff one; zero is not enforced.
+	PublicMinLeadTime time.Duration `envconfig:"PUBLIC_MIN_LEAD_TIME" validate:"gte=0" default:"0s"`
+	PublicMaxAdvance  time.Duration `envconfig:"PUBLIC_MAX_ADVANCE" validate:"gte=0" default:"0s"`
+	StaffMinLeadTime  time.Duration `envconfig:"STAFF_MIN_LEAD_TIME" validate:"gte=0" default:"0s"`
+	StaffMaxAdvance   time.Duration `envconfig:"STAFF_MAX_ADVANCE" validate:"gte=0" default:"0s"`
+	// Reservations cancelled later than CANCELLATION_CUTOFF before their start time forfeit a paid
+	// deposit when CANCELLATION_FORFEIT_DEPOSIT is set and are otherwise charged CANCELLATION_FEE,
+	// in the smallest currency unit. A zero cutoff lets every reservation be cancelled free of charge.
+	CancellationCutoff         time.Duration `envconfig:"CANCELLATION_CUTOFF" validate:"gte=0" default:"0s"`
+	CancellationFee            int           `envconfig:"CANCELLATION_FEE" validate:"gte=0" default:"0"`
+	CancellationForfeitDeposit bool          `envconfig:"CANCELLATION_FORFEIT_DEPOSIT" default:"false"`
+	// Parties of at least DEPOSIT_MIN_PARTY_SIZE guests, and every party on DEPOSIT_DATES such as
+	// "2025-12-24,2025-12-31", pay DEPOSIT_PER_GUEST in the smallest currency unit before their booking
+	// is confirmed. Unpaid bookings give their tables back after PAYMENT_TIMEOUT. A zero deposit or
+	// party size turns the rule off.
+	DepositMinPartySize int           `envconfig:"DEPOSIT_MIN_PARTY_SIZE" validate:"gte=0" default:"0"`
+	DepositDates        []string      `envconfig:"DEPOSIT_DATES" validate:"dive,datetime=2006-01-02"`
+	DepositPerGuest     int           `envconfig:"DEPOSIT_PER_GUEST" validate:"gte=0" default:"0"`
+	PaymentTimeout      time.Duration `envconfig:"PAYMENT_TIMEOUT" validate:"gt=0" default:"15m"`
+	// PaymentGateway takes deposits. "fake" accepts every payment without moving any money and is
+	// refused in production. Deposits cannot be configured without a gateway.
+	PaymentGateway string `envconfig:"PAYMENT_GATEWAY" validate:"omitempty,oneof=fake"`
 }
 
 func (c *Config) Validate() error {
@@ -20,9 +20,154 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/public/table/init": {
+        "/public/restaurants": {
+            "get": {
+                "description": "Lists every registered venue.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Restaurant"
+                ],
+                "summary": "List restaurants",
+                "responses": {
+                    "200": {
+                        "description": "Registered restaurants.",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/model.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.RestaurantResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/public/restaurants/{restaurantId}/availability": {
+            "get": {
+                "description": "Lists the start times between from and to, every 15 minutes in the restaurant's time zone, at which the party could book, with the tables it would be seated at. Nothing is booked. Without from and to the whole day is searched; with only from, just that time. Times outside the public booking window are not offered. When none of the requested times are bookable, up to three of the nearest bookable times that day are returned as alternatives.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Availability"
+                ],
+                "summary": "Search availability",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "restaurantId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of customers in the group.",
+                        "name": "party_size",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Day to search (YYYY-MM-DD).",
+                        "name": "date",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Earliest start time (HH:MM).",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Latest start time (HH:MM).",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Overrides the turn time for the party size.",
+                        "name": "duration_minutes",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only offer tables in this section.",
+                        "name": "section",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Only offer tables with every one of these features.",
+                        "name": "features",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Bookable slots.",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/model.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.AvailabilityResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid search.",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/model.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.FieldErrorResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/public/restaurants/{restaurantId}/reservations": {
             "post": {
-                "description": "Initializes the total number of tables in the restaurant. This endpoint must be called first and only once.",
+                "description": "Books tables for a guest booking online, for the turn time of the party size. Bookings that need a deposit are left pending_payment until it is paid. Online bookings must fall inside the public booking window; a booking too close to its start time fails with code ERR01001 and one too far ahead with code ERR01002. A booking while the restaurant is closed fails with code ERR01004 and one beyond the shift's pacing limit with code ERR01005.",
                 "consumes": [
                     "application/json"
                 ],
@@ -30,23 +175,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Table"
+                    "Reservation"
                 ],
-                "summary": "Initialize tables in the restaurant",
+                "summary": "Book a table online",
                 "parameters": [
                     {
-                        "description": "Initialize Number of Table Request",
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "restaurantId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Number of customers in the group, who the booking is for and the requested start time.",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.InitializeTableRequest"
+                            "$ref": "#/definitions/dto.OnlineReservationRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Total Initialized Tables",
+                        "description": "Tables reserved successfully.",
                         "schema": {
                             "allOf": [
                                 {
@@ -56,7 +208,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/dto.InitializeTableResponse"
+                                            "$ref": "#/definitions/dto.ReservationResponse"
                                         }
                                     }
                                 }
@@ -64,17 +216,32 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Table Already Initialized",
+                        "description": "Invalid guest details, booking outside the booking window or reservation error.",
                         "schema": {
-                            "$ref": "#/definitions/model.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/model.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.FieldErrorResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
             }
         },
-        "/secure/reservations": {
+        "/public/restaurants/{restaurantId}/reservations/{id}/payment": {
             "post": {
-                "description": "Reserves tables for a group of customers.",
+                "description": "Pays the deposit of a booking in pending_payment status and confirms it. Large parties and bookings on holidays must pay a deposit before the payment deadline, or the tables are released. A declined payment fails with code ERR01003 and releases the tables straight away.",
                 "consumes": [
                     "application/json"
                 ],
@@ -84,21 +251,35 @@ const docTemplate = `{
                 "tags": [
                     "Reservation"
                 ],
-                "summary": "Reserve tables",
+                "summary": "Pay a booking's deposit",
                 "parameters": [
                     {
-                        "description": "Number of customers in the group.",
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "restaurantId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Booking ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The payment method to pay with.",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.ReservationRequest"
+                            "$ref": "#/definitions/dto.PaymentRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Tables reserved successfully.",
+                        "description": "Deposit paid and booking confirmed.",
                         "schema": {
                             "allOf": [
                                 {
@@ -116,7 +297,7 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Reservation error.",
+                        "description": "Payment declined, payment deadline passed or booking not awaiting payment.",
                         "schema": {
                             "$ref": "#/definitions/model.Response"
                         }
@@ -124,31 +305,28 @@ const docTemplate = `{
                 }
             }
         },
-        "/secure/reservations/{id}": {
-            "delete": {
-                "description": "Cancels a reservation and releases the reserved tables.",
-                "consumes": [
-                    "application/json"
-                ],
+        "/public/restaurants/{restaurantId}/schedule": {
+            "get": {
+                "description": "Returns the restaurant's weekly shifts and closure days.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Reservation"
+                    "Schedule"
                 ],
-                "summary": "Cancel a reservation",
+                "summary": "Get opening hours",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "The reservation ID to cancel.",
-                        "name": "id",
+                        "description": "Restaurant ID",
+                        "name": "restaurantId",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Reservation canceled successfully.",
+                        "description": "Schedule found.",
                         "schema": {
                             "allOf": [
                                 {
@@ -158,7 +336,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/dto.CancelReservationResponse"
+                                            "$ref": "#/definitions/dto.ScheduleResponse"
                                         }
                                     }
                                 }
@@ -166,62 +344,2608 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Cancellation error.",
+                        "description": "Schedule not found.",
                         "schema": {
                             "$ref": "#/definitions/model.Response"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "dto.CancelReservationResponse": {
-            "type": "object",
-            "properties": {
-                "freed_tables": {
-                    "type": "integer"
-                },
-                "remaining_tables": {
-                    "type": "integer"
+        },
+        "/public/restaurants/{restaurantId}/table": {
+            "get": {
+                "description": "Returns every table with whether it is free for the whole time window, the reservations overlapping it and the blocks in force during it. Real capacity (total_tables, available_tables) is shown alongside the overbooked capacity: the overbooking_allowance of the shift covering the window and the overbooked_tables taken by overbooked_reservations, which hold no tables. The window defaults to the next two hours.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "table"
+                ],
+                "summary": "Get table availability",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "restaurantId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Window start (RFC3339).",
+                        "name": "start_time",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Window end (RFC3339).",
+                        "name": "end_time",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Table availability",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/model.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.TablesResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid time window",
+                        "schema": {
+                            "$ref": "#/definitions/model.Response"
+                        }
+                    }
                 }
             }
         },
-        "dto.InitializeTableRequest": {
-            "type": "object",
-            "properties": {
-                "num_tables": {
-                    "type": "integer"
+        "/public/restaurants/{restaurantId}/table/init": {
+            "post": {
+                "description": "Initializes the table inventory of the restaurant, either as a list of tables with their seat capacity and the tables they can be pushed together with, or as a row of four-seat tables. This endpoint must be called first and only once.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "table"
+                ],
+                "summary": "Initialize tables in the restaurant",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "restaurantId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Initialize Tables Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.InitializeTableRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Total Initialized Tables",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/model.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.InitializeTableResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "table Already Initialized",
+                        "schema": {
+                            "$ref": "#/definitions/model.Response"
+                        }
+                    }
                 }
             }
         },
-        "dto.InitializeTableResponse": {
-            "type": "object",
-            "properties": {
-                "total_tables": {
-                    "type": "integer"
+        "/secure/restaurants": {
+            "post": {
+                "description": "Registers a venue. Its id scopes every table and reservation route; a generated id is used when none is given.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Restaurant"
+                ],
+                "summary": "Register a restaurant",
+                "parameters": [
+                    {
+                        "description": "Restaurant to register.",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateRestaurantRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Restaurant registered.",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/model.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RestaurantResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Registration error.",
+                        "schema": {
+                            "$ref": "#/definitions/model.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/secure/restaurants/{restaurantId}/events": {
+            "post": {
+                "description": "Books a whole section, or the whole venue when no section is given, for a private event or buyout. The event is stored as a reservation of type event holding every table in scope, so regular bookings overlapping it are turned away. It is refused, listing the conflicting reservations, when any of those tables is already booked during the event.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reservation"
+                ],
+                "summary": "Book a private event",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "restaurantId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Headcount, contact, section, time range and deposit status of the event.",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.EventBookingRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Event booked",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/model.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.ReservationResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Tables are already booked during the event",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/model.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.InventoryConflictResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/secure/restaurants/{restaurantId}/events/{id}/deposit": {
+            "put": {
+                "description": "Records whether the deposit of an event booking is pending or paid.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reservation"
+                ],
+                "summary": "Update an event's deposit status",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "restaurantId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Booking ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New deposit status",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.DepositStatusRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Deposit status updated",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/model.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.ReservationResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Booking not found or not an event",
+                        "schema": {
+                            "$ref": "#/definitions/model.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/secure/restaurants/{restaurantId}/holds": {
+            "post": {
+                "description": "Locks tables for a group while the guest fills in their details. The hold expires and the tables are released unless it is confirmed in time.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reservation"
+                ],
+                "summary": "Hold tables",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "restaurantId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Number of customers in the group and the requested time slot.",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.HoldRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Tables held successfully.",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/model.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.ReservationResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Hold error.",
+                        "schema": {
+                            "$ref": "#/definitions/model.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/secure/restaurants/{restaurantId}/reservations": {
+            "post": {
+                "description": "Reserves tables for a group of customers. Bookings that need a deposit are left pending_payment until it is paid. Staff bookings must fall inside the staff booking window; a booking too close to its start time fails with code ERR01001 and one too far ahead with code ERR01002. A booking while the restaurant is closed fails with code ERR01004 and one beyond the shift's pacing limit with code ERR01005.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reservation"
+                ],
+                "summary": "Reserve tables",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "restaurantId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Number of customers in the group, who the booking is for and the requested time slot.",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReservationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Tables reserved successfully.",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/model.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.ReservationResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid guest details or reservation error.",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/model.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.FieldErrorResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/secure/restaurants/{restaurantId}/reservations/series": {
+            "post": {
+                "description": "Books a reservation that repeats every week, or every few weeks, until a date or for a number of occurrences. Each occurrence is a reservation of its own holding its own tables, and can be cancelled on its own. The series is only booked when every occurrence can be seated; otherwise the occurrences that cannot are listed. Occurrences that need a deposit are each left pending_payment until it is paid.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reservation"
+                ],
+                "summary": "Reserve recurring tables",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "restaurantId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The first reservation and how it repeats.",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReservationSeriesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Series reserved successfully.",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/model.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.ReservationSeriesResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Occurrences cannot be seated.",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/model.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RecurrenceConflictResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/secure/restaurants/{restaurantId}/reservations/series/{seriesId}": {
+            "delete": {
+                "description": "Cancels every upcoming occurrence of a recurring reservation and releases their tables. Occurrences that have already started are left as they are; to cancel a single occurrence, cancel it as a reservation. Each occurrence is cancelled under the cancellation policy, as if cancelled on its own: occurrences past the free cancellation cutoff owe the fee or forfeit a paid deposit unless staff waive it, and other paid deposits are refunded. The fee is informational and is not charged through the payment gateway. An occurrence that cannot be cancelled, such as when its refund fails, is left as it was and listed under failed without holding back the others.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reservation"
+                ],
+                "summary": "Cancel a recurring reservation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "restaurantId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The series ID.",
+                        "name": "seriesId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Cancel without charging the guest for late cancellations.",
+                        "name": "waive_fee",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Series cancelled.",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/model.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.CancelSeriesResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Series not found.",
+                        "schema": {
+                            "$ref": "#/definitions/model.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/secure/restaurants/{restaurantId}/reservations/{id}": {
+            "get": {
+                "description": "Returns a reservation in any status, including cancelled ones.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reservation"
+                ],
+                "summary": "Get a reservation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "restaurantId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The reservation ID.",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Reservation found.",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/model.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.ReservationResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Reservation not found.",
+                        "schema": {
+                            "$ref": "#/definitions/model.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Cancels a reservation and releases the reserved tables. Cancellations are free until the cancellation cutoff before the start time; later ones owe the cancellation fee or forfeit a paid deposit, unless staff waive it. The fee is informational and is not charged through the payment gateway. A paid deposit that is not forfeited is refunded.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reservation"
+                ],
+                "summary": "Cancel a reservation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "restaurantId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The reservation ID to cancel.",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Cancel without charging the guest for a late cancellation.",
+                        "name": "waive_fee",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Reservation canceled successfully.",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/model.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.CancelReservationResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Cancellation error.",
+                        "schema": {
+                            "$ref": "#/definitions/model.Response"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Re-seats a reservation for a new number of customers. The reservation keeps its original tables if the new party cannot be seated. A confirmed party grown into needing a deposit is left pending_payment until it is paid. A party grown beyond the shift's pacing limit fails with code ERR01005.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reservation"
+                ],
+                "summary": "Change a reservation's party size",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "restaurantId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The reservation ID to modify.",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New number of customers in the group.",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ModifyReservationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Reservation modified successfully.",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/model.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.ReservationResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Modification error.",
+                        "schema": {
+                            "$ref": "#/definitions/model.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/secure/restaurants/{restaurantId}/reservations/{id}/complete": {
+            "post": {
+                "description": "Marks a seated reservation as completed and releases its tables. A party still seated past its end keeps its tables until then.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reservation"
+                ],
+                "summary": "Complete a reservation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "restaurantId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The reservation ID.",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Reservation completed.",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/model.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.ReservationResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Status change error.",
+                        "schema": {
+                            "$ref": "#/definitions/model.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/secure/restaurants/{restaurantId}/reservations/{id}/confirm": {
+            "post": {
+                "description": "Confirms held tables as a reservation for the guest before the hold expires. A party that needs a deposit is left pending_payment until it is paid.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reservation"
+                ],
+                "summary": "Confirm a hold",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "restaurantId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The held reservation ID.",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Who the booking is for.",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ConfirmReservationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Reservation confirmed.",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/model.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.ReservationResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid guest details or confirmation error.",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/model.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.FieldErrorResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/secure/restaurants/{restaurantId}/reservations/{id}/no-show": {
+            "post": {
+                "description": "Marks a confirmed reservation whose party never arrived and releases its tables.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reservation"
+                ],
+                "summary": "Mark a reservation as no-show",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "restaurantId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The reservation ID.",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Reservation marked as no-show.",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/model.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.ReservationResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Status change error.",
+                        "schema": {
+                            "$ref": "#/definitions/model.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/secure/restaurants/{restaurantId}/reservations/{id}/seat": {
+            "post": {
+                "description": "Marks a confirmed reservation as seated when the party arrives. An overbooked reservation is given free tables as it is seated and is refused while none are free.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reservation"
+                ],
+                "summary": "Seat a reservation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "restaurantId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The reservation ID.",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Reservation seated.",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/model.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.ReservationResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Status change error.",
+                        "schema": {
+                            "$ref": "#/definitions/model.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/secure/restaurants/{restaurantId}/schedule": {
+            "put": {
+                "description": "Replaces the restaurant's weekly shifts and closure days. Once set, bookings are only accepted when the whole visit falls inside a shift, and a shift's max_tables caps how many tables are booked at once (0 means no cap). Pacing limits how many covers (max_covers) and parties (max_parties) may arrive in each pacing interval of the shift, however many tables are free; bookings and availability respect them. Overbooking lets a shift accept reservations once no tables are free, up to overbooking_percent of its tables or overbooking_tables tables; those reservations are marked overbooked. A schedule without shifts only applies its closures.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Schedule"
+                ],
+                "summary": "Set opening hours",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "restaurantId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Time zone, weekly shifts and closure days.",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ScheduleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Schedule saved.",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/model.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.ScheduleResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid schedule.",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/model.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.FieldErrorResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/secure/restaurants/{restaurantId}/table": {
+            "put": {
+                "description": "Replaces the table inventory of an initialized restaurant without losing its reservations, in the same format as initialization. Tables can be added, removed or changed; the change is refused and the conflicting reservations listed when an upcoming reservation holds a removed table or its tables would no longer seat the party. Parties on the waitlist are seated at added tables straight away.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "table"
+                ],
+                "summary": "Resize the table inventory",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "restaurantId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Resize Tables Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.InitializeTableRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Total Tables",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/model.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.InitializeTableResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Reservations hold tables that would be removed",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/model.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.InventoryConflictResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/secure/restaurants/{restaurantId}/table/blocks": {
+            "post": {
+                "description": "Takes tables out of service, e.g. for maintenance or a staff meal. The block starts now unless start_time is given and lasts until it is lifted unless end_time is given. Blocked tables are not offered to new bookings; the block is refused and the conflicting reservations listed when a reservation holds one of the tables while the block is in force.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "table"
+                ],
+                "summary": "Block tables",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "restaurantId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Tables to block, when and why.",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.TableBlockRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Tables blocked",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/model.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.TableBlockResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Reservations hold the tables while the block is in force",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/model.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.InventoryConflictResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/secure/restaurants/{restaurantId}/table/blocks/{blockId}": {
+            "delete": {
+                "description": "Lifts a table block so its tables can be booked again. Parties on the waitlist are seated at them straight away.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "table"
+                ],
+                "summary": "Unblock tables",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "restaurantId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Block ID",
+                        "name": "blockId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Block lifted",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/model.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.TableBlockResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Block not found",
+                        "schema": {
+                            "$ref": "#/definitions/model.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/secure/restaurants/{restaurantId}/waitlist": {
+            "post": {
+                "description": "Queues a party for a time slot that has no tables left. The party is booked automatically, in the order it joined, once tables are released. A party that needs a deposit is booked pending_payment. Parties still waiting when their time slot starts expire, and time slots that have already started cannot be joined. A time slot while the restaurant is closed fails with code ERR01004.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Waitlist"
+                ],
+                "summary": "Join the waitlist",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "restaurantId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Number of customers in the group, who the booking is for and the requested time slot.",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReservationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Party added to the waitlist.",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/model.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.WaitlistEntryResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid guest details or waitlist error.",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/model.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.FieldErrorResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/secure/restaurants/{restaurantId}/waitlist/{id}": {
+            "get": {
+                "description": "Returns the party's place in the queue, the reservation it was promoted to, or expired when its time slot started before tables came free.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Waitlist"
+                ],
+                "summary": "Get a waitlist entry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "restaurantId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The waitlist entry ID.",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Waitlist entry found.",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/model.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.WaitlistEntryResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Waitlist entry not found.",
+                        "schema": {
+                            "$ref": "#/definitions/model.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Removes a waiting party from the queue.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Waitlist"
+                ],
+                "summary": "Leave the waitlist",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "restaurantId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The waitlist entry ID.",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Party removed from the waitlist.",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/model.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.WaitlistEntryResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Waitlist error.",
+                        "schema": {
+                            "$ref": "#/definitions/model.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/secure/restaurants/{restaurantId}/walk-ins": {
+            "post": {
+                "description": "Seats a party that arrived without booking on tables free from now and records it as a seated walk-in. When no table is free, the response carries the estimated wait until a booked table frees up.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reservation"
+                ],
+                "summary": "Seat a walk-in",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "restaurantId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Number of customers in the group and, optionally, who they are.",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.WalkInRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Party seated.",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/model.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.ReservationResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "No table is free; estimated wait.",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/model.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.WalkInWaitResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "dto.AvailabilityResponse": {
+            "type": "object",
+            "properties": {
+                "alternatives": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.SlotResponse"
+                    }
+                },
+                "date": {
+                    "type": "string"
+                },
+                "party_size": {
+                    "type": "integer"
+                },
+                "slots": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.SlotResponse"
+                    }
+                }
+            }
+        },
+        "dto.CancelReservationResponse": {
+            "type": "object",
+            "properties": {
+                "deposit_forfeited": {
+                    "type": "boolean"
+                },
+                "fee": {
+                    "type": "integer"
+                },
+                "fee_applies": {
+                    "description": "FeeApplies is set when the guest owes Fee or forfeits their deposit. Fee is not charged\nthrough the payment gateway; it is left to the venue to collect.",
+                    "type": "boolean"
+                },
+                "fee_waived": {
+                    "type": "boolean"
+                },
+                "free_until": {
+                    "type": "string"
+                },
+                "freed_tables": {
+                    "type": "integer"
+                },
+                "policy": {
+                    "$ref": "#/definitions/dto.CancellationPolicyResponse"
+                },
+                "refunded": {
+                    "description": "Refunded is the paid deposit returned to the guest.",
+                    "type": "integer"
+                },
+                "remaining_tables": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.CancelSeriesResponse": {
+            "type": "object",
+            "properties": {
+                "booking_ids": {
+                    "description": "BookingIds are the occurrences cancelled; past occurrences are left as they are.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "failed": {
+                    "description": "Failed lists the occurrences that could not be cancelled, such as when refunding their deposit\nfailed. They are left as they were and can be cancelled again on their own.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.FailedOccurrenceResponse"
+                    }
+                },
+                "occurrences": {
+                    "description": "Occurrences lists what cancelling each of them costs the guest under the cancellation policy.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CancelledOccurrenceResponse"
+                    }
+                },
+                "series_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CancellationPolicyResponse": {
+            "type": "object",
+            "properties": {
+                "cutoff_minutes": {
+                    "type": "integer"
+                },
+                "fee": {
+                    "type": "integer"
+                },
+                "forfeit_deposit": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "dto.CancelledOccurrenceResponse": {
+            "type": "object",
+            "properties": {
+                "booking_id": {
+                    "type": "string"
+                },
+                "deposit_forfeited": {
+                    "type": "boolean"
+                },
+                "fee": {
+                    "type": "integer"
+                },
+                "fee_applies": {
+                    "type": "boolean"
+                },
+                "fee_waived": {
+                    "type": "boolean"
+                },
+                "refunded": {
+                    "type": "integer"
+                },
+                "start_time": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ClosureRequest": {
+            "type": "object",
+            "required": [
+                "date"
+            ],
+            "properties": {
+                "date": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string",
+                    "maxLength": 200
+                }
+            }
+        },
+        "dto.ClosureResponse": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ConfirmReservationRequest": {
+            "type": "object",
+            "properties": {
+                "guest": {
+                    "$ref": "#/definitions/dto.GuestRequest"
+                }
+            }
+        },
+        "dto.ConflictingOccurrenceResponse": {
+            "type": "object",
+            "properties": {
+                "reason": {
+                    "type": "string"
+                },
+                "start_time": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ConflictingReservationResponse": {
+            "type": "object",
+            "properties": {
+                "booking_id": {
+                    "type": "string"
+                },
+                "end_time": {
+                    "type": "string"
+                },
+                "party_size": {
+                    "type": "integer"
+                },
+                "start_time": {
+                    "type": "string"
+                },
+                "table_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "dto.CreateRestaurantRequest": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.DepositStatusRequest": {
+            "type": "object",
+            "required": [
+                "status"
+            ],
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "not_required",
+                        "pending",
+                        "paid"
+                    ],
+                    "example": "paid"
+                }
+            }
+        },
+        "dto.EventBookingRequest": {
+            "type": "object",
+            "required": [
+                "end_time",
+                "start_time"
+            ],
+            "properties": {
+                "contact": {
+                    "$ref": "#/definitions/dto.GuestRequest"
+                },
+                "deposit_status": {
+                    "type": "string",
+                    "enum": [
+                        "not_required",
+                        "pending",
+                        "paid"
+                    ],
+                    "example": "pending"
+                },
+                "end_time": {
+                    "type": "string"
+                },
+                "headcount": {
+                    "description": "Headcount is the number of guests expected; it may exceed the seats of the booked tables.",
+                    "type": "integer"
+                },
+                "section": {
+                    "description": "Section to book; the whole venue is booked when it is left empty.",
+                    "type": "string",
+                    "enum": [
+                        "main_room",
+                        "patio",
+                        "bar",
+                        "private_room"
+                    ],
+                    "example": "private_room"
+                },
+                "start_time": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.FailedOccurrenceResponse": {
+            "type": "object",
+            "properties": {
+                "booking_id": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "start_time": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.FieldErrorResponse": {
+            "type": "object",
+            "properties": {
+                "field": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.GuestRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "phone"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "notes": {
+                    "type": "string",
+                    "maxLength": 500
+                },
+                "phone": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.GuestResponse": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "phone": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.HoldRequest": {
+            "type": "object",
+            "properties": {
+                "duration_minutes": {
+                    "description": "DurationMinutes overrides the turn time for the party size.",
+                    "type": "integer"
+                },
+                "num_customers": {
+                    "type": "integer"
+                },
+                "start_time": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.InitializeTableRequest": {
+            "type": "object",
+            "properties": {
+                "num_tables": {
+                    "type": "integer"
+                },
+                "tables": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TableRequest"
+                    }
+                }
+            }
+        },
+        "dto.InitializeTableResponse": {
+            "type": "object",
+            "properties": {
+                "total_seats": {
+                    "type": "integer"
+                },
+                "total_tables": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.InventoryConflictResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "reservations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ConflictingReservationResponse"
+                    }
+                }
+            }
+        },
+        "dto.ModifyReservationRequest": {
+            "type": "object",
+            "properties": {
+                "num_customers": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.OnlineReservationRequest": {
+            "type": "object",
+            "properties": {
+                "guest": {
+                    "$ref": "#/definitions/dto.GuestRequest"
+                },
+                "num_customers": {
+                    "type": "integer"
+                },
+                "preferences": {
+                    "$ref": "#/definitions/dto.SeatingRequest"
+                },
+                "requirements": {
+                    "$ref": "#/definitions/dto.SeatingRequest"
+                },
+                "start_time": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.PaymentRequest": {
+            "type": "object",
+            "required": [
+                "token"
+            ],
+            "properties": {
+                "token": {
+                    "description": "Token stands for the guest's payment method, as issued by the payment gateway.",
+                    "type": "string",
+                    "example": "tok_visa"
+                }
+            }
+        },
+        "dto.RecurrenceConflictResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "occurrences": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ConflictingOccurrenceResponse"
+                    }
+                }
+            }
+        },
+        "dto.RecurrenceRequest": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 8
+                },
+                "interval_weeks": {
+                    "description": "IntervalWeeks defaults to 1, repeating the reservation weekly.",
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 1
+                },
+                "until": {
+                    "description": "Until is the last day an occurrence may fall on and Count the number of occurrences; at least\none is required.",
+                    "type": "string",
+                    "example": "2025-03-28"
+                }
+            }
+        },
+        "dto.ReservationRequest": {
+            "type": "object",
+            "properties": {
+                "duration_minutes": {
+                    "description": "DurationMinutes overrides the turn time for the party size, e.g. for special occasions.",
+                    "type": "integer"
+                },
+                "guest": {
+                    "$ref": "#/definitions/dto.GuestRequest"
+                },
+                "num_customers": {
+                    "type": "integer"
+                },
+                "preferences": {
+                    "$ref": "#/definitions/dto.SeatingRequest"
+                },
+                "requirements": {
+                    "description": "Requirements must be met by every table or the booking fails; preferences are met when\npossible and reported back in unmet_preferences when not.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dto.SeatingRequest"
+                        }
+                    ]
+                },
+                "start_time": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ReservationResponse": {
+            "type": "object",
+            "properties": {
+                "booking_id": {
+                    "type": "string"
+                },
+                "combined": {
+                    "type": "boolean"
+                },
+                "deposit_amount": {
+                    "description": "DepositAmount must be paid by PaymentDueAt while the booking is pending_payment.",
+                    "type": "integer"
+                },
+                "deposit_status": {
+                    "type": "string"
+                },
+                "end_time": {
+                    "type": "string"
+                },
+                "guest": {
+                    "$ref": "#/definitions/dto.GuestResponse"
+                },
+                "hold_expires_at": {
+                    "type": "string"
+                },
+                "overbooked": {
+                    "description": "Overbooked reservations were accepted beyond the free tables and have no tables assigned yet.",
+                    "type": "boolean"
+                },
+                "overbooked_tables": {
+                    "type": "integer"
+                },
+                "payment_due_at": {
+                    "type": "string"
+                },
+                "preferences": {
+                    "$ref": "#/definitions/dto.SeatingResponse"
+                },
+                "remaining_tables": {
+                    "type": "integer"
+                },
+                "requirements": {
+                    "$ref": "#/definitions/dto.SeatingResponse"
+                },
+                "series_id": {
+                    "type": "string"
+                },
+                "start_time": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "table_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "tables": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ReservedTableResponse"
+                    }
+                },
+                "tables_reserved": {
+                    "type": "integer"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "unmet_preferences": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "walk_in": {
+                    "type": "boolean"
                 }
             }
         },
-        "dto.ReservationRequest": {
+        "dto.ReservationSeriesRequest": {
             "type": "object",
             "properties": {
+                "duration_minutes": {
+                    "description": "DurationMinutes overrides the turn time for the party size, e.g. for special occasions.",
+                    "type": "integer"
+                },
+                "guest": {
+                    "$ref": "#/definitions/dto.GuestRequest"
+                },
                 "num_customers": {
                     "type": "integer"
+                },
+                "preferences": {
+                    "$ref": "#/definitions/dto.SeatingRequest"
+                },
+                "recurrence": {
+                    "$ref": "#/definitions/dto.RecurrenceRequest"
+                },
+                "requirements": {
+                    "description": "Requirements must be met by every table or the booking fails; preferences are met when\npossible and reported back in unmet_preferences when not.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dto.SeatingRequest"
+                        }
+                    ]
+                },
+                "start_time": {
+                    "type": "string"
                 }
             }
         },
-        "dto.ReservationResponse": {
+        "dto.ReservationSeriesResponse": {
             "type": "object",
             "properties": {
-                "booking_id": {
+                "occurrences": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ReservationResponse"
+                    }
+                },
+                "series_id": {
                     "type": "string"
+                }
+            }
+        },
+        "dto.ReservedTableResponse": {
+            "type": "object",
+            "properties": {
+                "capacity": {
+                    "type": "integer"
                 },
-                "remaining_tables": {
+                "features": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "label": {
+                    "type": "string"
+                },
+                "section": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.RestaurantResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ScheduleRequest": {
+            "type": "object",
+            "properties": {
+                "closures": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ClosureRequest"
+                    }
+                },
+                "shifts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ShiftRequest"
+                    }
+                },
+                "time_zone": {
+                    "type": "string",
+                    "example": "Asia/Bangkok"
+                }
+            }
+        },
+        "dto.ScheduleResponse": {
+            "type": "object",
+            "properties": {
+                "closures": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ClosureResponse"
+                    }
+                },
+                "shifts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ShiftResponse"
+                    }
+                },
+                "time_zone": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.SeatingRequest": {
+            "type": "object",
+            "properties": {
+                "features": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "section": {
+                    "type": "string",
+                    "enum": [
+                        "main_room",
+                        "patio",
+                        "bar",
+                        "private_room"
+                    ]
+                }
+            }
+        },
+        "dto.SeatingResponse": {
+            "type": "object",
+            "properties": {
+                "features": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "section": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ShiftRequest": {
+            "type": "object",
+            "required": [
+                "closes",
+                "day",
+                "name",
+                "opens"
+            ],
+            "properties": {
+                "closes": {
+                    "type": "string",
+                    "example": "26:00"
+                },
+                "day": {
+                    "type": "string",
+                    "enum": [
+                        "sunday",
+                        "monday",
+                        "tuesday",
+                        "wednesday",
+                        "thursday",
+                        "friday",
+                        "saturday"
+                    ]
+                },
+                "max_covers": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 20
+                },
+                "max_parties": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "max_tables": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 50
+                },
+                "opens": {
+                    "description": "Opens and Closes are local times of day formatted as HH:MM. A shift that runs past midnight\ncloses at 24:00 or later, e.g. 26:00 for 2am the next day.",
+                    "type": "string",
+                    "example": "18:00"
+                },
+                "overbooking_percent": {
+                    "description": "Reservations are accepted beyond the free tables up to overbooking_percent of the shift's\ntables, or overbooking_tables tables when set.",
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 0,
+                    "example": 8
+                },
+                "overbooking_tables": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "pacing_interval_minutes": {
+                    "description": "At most max_covers guests and max_parties parties may arrive in each pacing interval of the\nshift, which defaults to 15 minutes. Zero limits are not enforced.",
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 15
+                }
+            }
+        },
+        "dto.ShiftResponse": {
+            "type": "object",
+            "properties": {
+                "closes": {
+                    "type": "string"
+                },
+                "day": {
+                    "type": "string"
+                },
+                "max_covers": {
                     "type": "integer"
                 },
-                "tables_reserved": {
+                "max_parties": {
+                    "type": "integer"
+                },
+                "max_tables": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "opens": {
+                    "type": "string"
+                },
+                "overbooking_percent": {
+                    "type": "integer"
+                },
+                "overbooking_tables": {
+                    "type": "integer"
+                },
+                "pacing_interval_minutes": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.SlotResponse": {
+            "type": "object",
+            "properties": {
+                "end_time": {
+                    "type": "string"
+                },
+                "start_time": {
+                    "type": "string"
+                },
+                "table_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "dto.TableBlockRequest": {
+            "type": "object",
+            "required": [
+                "table_ids"
+            ],
+            "properties": {
+                "end_time": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string",
+                    "maxLength": 200,
+                    "example": "broken chair"
+                },
+                "start_time": {
+                    "description": "StartTime defaults to now and EndTime to until the block is lifted.",
+                    "type": "string"
+                },
+                "table_ids": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "dto.TableBlockResponse": {
+            "type": "object",
+            "properties": {
+                "end_time": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "start_time": {
+                    "type": "string"
+                },
+                "table_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "dto.TableRequest": {
+            "type": "object",
+            "properties": {
+                "capacity": {
+                    "type": "integer"
+                },
+                "combinable_with": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "features": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "window"
+                    ]
+                },
+                "id": {
+                    "type": "string"
+                },
+                "label": {
+                    "type": "string"
+                },
+                "min_party_size": {
+                    "type": "integer"
+                },
+                "section": {
+                    "description": "Section defaults to main_room.",
+                    "type": "string",
+                    "example": "patio"
+                }
+            }
+        },
+        "dto.TableStatusResponse": {
+            "type": "object",
+            "properties": {
+                "available": {
+                    "type": "boolean"
+                },
+                "block_id": {
+                    "type": "string"
+                },
+                "block_reason": {
+                    "type": "string"
+                },
+                "blocked": {
+                    "type": "boolean"
+                },
+                "capacity": {
+                    "type": "integer"
+                },
+                "combinable_with": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "features": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "label": {
+                    "type": "string"
+                },
+                "min_party_size": {
+                    "type": "integer"
+                },
+                "reservation_id": {
+                    "type": "string"
+                },
+                "section": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.TablesResponse": {
+            "type": "object",
+            "properties": {
+                "available_tables": {
+                    "type": "integer"
+                },
+                "end_time": {
+                    "type": "string"
+                },
+                "overbooked_reservations": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "overbooked_tables": {
+                    "type": "integer"
+                },
+                "overbooking_allowance": {
+                    "description": "OverbookedTables of the shift's OverbookingAllowance are taken by reservations accepted beyond\nthe real tables; OverbookedReservations maps each of them to the tables it needs.",
+                    "type": "integer"
+                },
+                "reservations": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "start_time": {
+                    "type": "string"
+                },
+                "tables": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TableStatusResponse"
+                    }
+                },
+                "total_tables": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.WaitlistEntryResponse": {
+            "type": "object",
+            "properties": {
+                "end_time": {
+                    "type": "string"
+                },
+                "guest": {
+                    "$ref": "#/definitions/dto.GuestResponse"
+                },
+                "party_size": {
                     "type": "integer"
+                },
+                "position": {
+                    "type": "integer"
+                },
+                "reservation_id": {
+                    "type": "string"
+                },
+                "start_time": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "waitlist_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.WalkInRequest": {
+            "type": "object",
+            "properties": {
+                "duration_minutes": {
+                    "type": "integer"
+                },
+                "name": {
+                    "description": "Guest details are optional for walk-ins.",
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "num_customers": {
+                    "type": "integer"
+                },
+                "phone": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.WalkInWaitResponse": {
+            "type": "object",
+            "properties": {
+                "available_at": {
+                    "type": "string"
+                },
+                "estimated_wait_minutes": {
+                    "type": "integer"
+                },
+                "message": {
+                    "type": "string"
                 }
             }
         },
@@ -232,7 +2956,7 @@ const docTemplate = `{
                     "type": "string"
                 },
                 "data": {},
-                "memory": {
+                "message": {
                     "type": "string"
                 }
             }
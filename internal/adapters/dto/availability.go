@@ -0,0 +1,28 @@
+package dto
+
+import "time"
+
+type AvailabilityRequest struct {
+	PartySize int    `query:"party_size" json:"party_size" validate:"required,gt=0"`
+	Date      string `query:"date" json:"date" validate:"required,datetime=2006-01-02"`
+	// From and To bound the requested start times as local HH:MM times.
+	From            string `query:"from" json:"from" validate:"omitempty,datetime=15:04"`
+	To              string `query:"to" json:"to" validate:"omitempty,datetime=15:04"`
+	DurationMinutes int    `query:"duration_minutes" json:"duration_minutes" validate:"gte=0"`
+	// Section and Features are required of every table offered.
+	Section  string   `query:"section" json:"section" validate:"omitempty,oneof=main_room patio bar private_room"`
+	Features []string `query:"features" json:"features" validate:"dive,oneof=wheelchair_accessible high_chair window"`
+}
+
+type SlotResponse struct {
+	StartTime time.Time `json:"start_time"`
+	EndTime   time.Time `json:"end_time"`
+	TableIds  []string  `json:"table_ids"`
+}
+
+type AvailabilityResponse struct {
+	PartySize    int            `json:"party_size"`
+	Date         string         `json:"date"`
+	Slots        []SlotResponse `json:"slots"`
+	Alternatives []SlotResponse `json:"alternatives"`
+}
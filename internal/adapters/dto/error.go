@@ -0,0 +1,7 @@
+package dto
+
+// FieldErrorResponse describes why a single request field was rejected.
+type FieldErrorResponse struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
@@ -0,0 +1,18 @@
+package dto
+
+import "time"
+
+type EventBookingRequest struct {
+	// Headcount is the number of guests expected; it may exceed the seats of the booked tables.
+	Headcount int          `json:"headcount" validate:"gt=0"`
+	Contact   GuestRequest `json:"contact"`
+	// Section to book; the whole venue is booked when it is left empty.
+	Section       string    `json:"section" validate:"omitempty,oneof=main_room patio bar private_room" example:"private_room"`
+	StartTime     time.Time `json:"start_time" validate:"required"`
+	EndTime       time.Time `json:"end_time" validate:"required"`
+	DepositStatus string    `json:"deposit_status" validate:"omitempty,oneof=not_required pending paid" example:"pending"`
+}
+
+type DepositStatusRequest struct {
+	Status string `json:"status" validate:"required,oneof=not_required pending paid" example:"paid"`
+}
@@ -0,0 +1,6 @@
+package dto
+
+type PaymentRequest struct {
+	// Token stands for the guest's payment method, as issued by the payment gateway.
+	Token string `json:"token" validate:"required" example:"tok_visa"`
+}
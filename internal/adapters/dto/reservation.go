@@ -1,16 +1,129 @@
 package dto
 
+import "time"
+
+type GuestRequest struct {
+	Name  string `json:"name" validate:"required,max=100"`
+	Phone string `json:"phone" validate:"required,e164"`
+	Email string `json:"email" validate:"omitempty,email"`
+	Notes string `json:"notes" validate:"max=500"`
+}
+
 type ReservationRequest struct {
+	NumCustomers int          `json:"num_customers"`
+	Guest        GuestRequest `json:"guest"`
+	StartTime    time.Time    `json:"start_time"`
+	// DurationMinutes overrides the turn time for the party size, e.g. for special occasions.
+	DurationMinutes int `json:"duration_minutes"`
+	// Requirements must be met by every table or the booking fails; preferences are met when
+	// possible and reported back in unmet_preferences when not.
+	Requirements SeatingRequest `json:"requirements"`
+	Preferences  SeatingRequest `json:"preferences"`
+}
+
+// OnlineReservationRequest is a booking made by the guest online; it always runs for the turn time
+// of the party size.
+type OnlineReservationRequest struct {
+	NumCustomers int            `json:"num_customers"`
+	Guest        GuestRequest   `json:"guest"`
+	StartTime    time.Time      `json:"start_time"`
+	Requirements SeatingRequest `json:"requirements"`
+	Preferences  SeatingRequest `json:"preferences"`
+}
+
+type HoldRequest struct {
+	NumCustomers int       `json:"num_customers"`
+	StartTime    time.Time `json:"start_time"`
+	// DurationMinutes overrides the turn time for the party size.
+	DurationMinutes int `json:"duration_minutes"`
+}
+
+type ConfirmReservationRequest struct {
+	Guest GuestRequest `json:"guest"`
+}
+
+type ModifyReservationRequest struct {
 	NumCustomers int `json:"num_customers"`
 }
 
+type GuestResponse struct {
+	Name  string `json:"name"`
+	Phone string `json:"phone"`
+	Email string `json:"email,omitempty"`
+	Notes string `json:"notes,omitempty"`
+}
+
+type ReservedTableResponse struct {
+	Id       string   `json:"id"`
+	Label    string   `json:"label"`
+	Capacity int      `json:"capacity"`
+	Section  string   `json:"section"`
+	Features []string `json:"features"`
+}
+
 type ReservationResponse struct {
-	BookingId       string `json:"booking_id"`
-	TablesReserved  int    `json:"tables_reserved"`
-	RemainingTables int    `json:"remaining_tables"`
+	BookingId        string                  `json:"booking_id"`
+	Status           string                  `json:"status"`
+	Guest            GuestResponse           `json:"guest"`
+	TablesReserved   int                     `json:"tables_reserved"`
+	TableIds         []string                `json:"table_ids"`
+	Combined         bool                    `json:"combined"`
+	Tables           []ReservedTableResponse `json:"tables"`
+	RemainingTables  int                     `json:"remaining_tables"`
+	StartTime        time.Time               `json:"start_time"`
+	EndTime          time.Time               `json:"end_time"`
+	HoldExpiresAt    *time.Time              `json:"hold_expires_at,omitempty"`
+	WalkIn           bool                    `json:"walk_in"`
+	Requirements     SeatingResponse         `json:"requirements"`
+	Preferences      SeatingResponse         `json:"preferences"`
+	UnmetPreferences []string                `json:"unmet_preferences"`
+	Type             string                  `json:"type"`
+	DepositStatus    string                  `json:"deposit_status,omitempty"`
+	// DepositAmount must be paid by PaymentDueAt while the booking is pending_payment.
+	DepositAmount int        `json:"deposit_amount,omitempty"`
+	PaymentDueAt  *time.Time `json:"payment_due_at,omitempty"`
+	SeriesId      string     `json:"series_id,omitempty"`
+	// Overbooked reservations were accepted beyond the free tables and have no tables assigned yet.
+	Overbooked       bool `json:"overbooked"`
+	OverbookedTables int  `json:"overbooked_tables,omitempty"`
+}
+
+type WalkInRequest struct {
+	NumCustomers int `json:"num_customers"`
+	// Guest details are optional for walk-ins.
+	Name            string `json:"name" validate:"max=100"`
+	Phone           string `json:"phone" validate:"omitempty,e164"`
+	DurationMinutes int    `json:"duration_minutes"`
+}
+
+type WalkInWaitResponse struct {
+	Message              string    `json:"message"`
+	EstimatedWaitMinutes int       `json:"estimated_wait_minutes"`
+	AvailableAt          time.Time `json:"available_at"`
+}
+
+type CancelReservationRequest struct {
+	// WaiveFee lets staff cancel after the free cancellation cutoff without charging the guest.
+	WaiveFee bool `query:"waive_fee"`
 }
 
 type CancelReservationResponse struct {
 	FreedTables     int `json:"freed_tables"`
 	RemainingTables int `json:"remaining_tables"`
+	// FeeApplies is set when the guest owes Fee or forfeits their deposit. Fee is not charged
+	// through the payment gateway; it is left to the venue to collect.
+	FeeApplies       bool `json:"fee_applies"`
+	Fee              int  `json:"fee"`
+	DepositForfeited bool `json:"deposit_forfeited"`
+	FeeWaived        bool `json:"fee_waived"`
+	// Refunded is the paid deposit returned to the guest.
+	Refunded  int                        `json:"refunded"`
+	FreeUntil *time.Time                 `json:"free_until,omitempty"`
+	Policy    CancellationPolicyResponse `json:"policy"`
+}
+
+type CancellationPolicyResponse struct {
+	CutoffMinutes  int  `json:"cutoff_minutes"`
+	Fee            int  `json:"fee"`
+	ForfeitDeposit bool `json:"forfeit_deposit"`
 }
@@ -0,0 +1,64 @@
+package dto
+
+import "time"
+
+type RecurrenceRequest struct {
+	// IntervalWeeks defaults to 1, repeating the reservation weekly.
+	IntervalWeeks int `json:"interval_weeks" validate:"min=0" example:"1"`
+	// Until is the last day an occurrence may fall on and Count the number of occurrences; at least
+	// one is required.
+	Until string `json:"until" validate:"omitempty,datetime=2006-01-02" example:"2025-03-28"`
+	Count int    `json:"count" validate:"min=0" example:"8"`
+}
+
+type ReservationSeriesRequest struct {
+	ReservationRequest
+	Recurrence RecurrenceRequest `json:"recurrence"`
+}
+
+type ReservationSeriesResponse struct {
+	SeriesId    string                `json:"series_id"`
+	Occurrences []ReservationResponse `json:"occurrences"`
+}
+
+type RecurrenceConflictResponse struct {
+	Message     string                          `json:"message"`
+	Occurrences []ConflictingOccurrenceResponse `json:"occurrences"`
+}
+
+type ConflictingOccurrenceResponse struct {
+	StartTime time.Time `json:"start_time"`
+	Reason    string    `json:"reason"`
+}
+
+type CancelSeriesRequest struct {
+	// WaiveFee lets staff cancel occurrences after the free cancellation cutoff without charging the guest.
+	WaiveFee bool `query:"waive_fee"`
+}
+
+type CancelSeriesResponse struct {
+	SeriesId string `json:"series_id"`
+	// BookingIds are the occurrences cancelled; past occurrences are left as they are.
+	BookingIds []string `json:"booking_ids"`
+	// Occurrences lists what cancelling each of them costs the guest under the cancellation policy.
+	Occurrences []CancelledOccurrenceResponse `json:"occurrences"`
+	// Failed lists the occurrences that could not be cancelled, such as when refunding their deposit
+	// failed. They are left as they were and can be cancelled again on their own.
+	Failed []FailedOccurrenceResponse `json:"failed"`
+}
+
+type FailedOccurrenceResponse struct {
+	BookingId string    `json:"booking_id"`
+	StartTime time.Time `json:"start_time"`
+	Reason    string    `json:"reason"`
+}
+
+type CancelledOccurrenceResponse struct {
+	BookingId        string    `json:"booking_id"`
+	StartTime        time.Time `json:"start_time"`
+	FeeApplies       bool      `json:"fee_applies"`
+	Fee              int       `json:"fee"`
+	DepositForfeited bool      `json:"deposit_forfeited"`
+	FeeWaived        bool      `json:"fee_waived"`
+	Refunded         int       `json:"refunded"`
+}
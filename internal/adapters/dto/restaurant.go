@@ -0,0 +1,11 @@
+package dto
+
+type CreateRestaurantRequest struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type RestaurantResponse struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+}
@@ -0,0 +1,55 @@
+package dto
+
+type ShiftRequest struct {
+	Name string `json:"name" validate:"required,max=50"`
+	Day  string `json:"day" validate:"required,oneof=sunday monday tuesday wednesday thursday friday saturday"`
+	// Opens and Closes are local times of day formatted as HH:MM. A shift that runs past midnight
+	// closes at 24:00 or later, e.g. 26:00 for 2am the next day.
+	Opens     string `json:"opens" validate:"required,datetime=15:04" example:"18:00"`
+	Closes    string `json:"closes" validate:"required,timeofday" example:"26:00"`
+	MaxTables int    `json:"max_tables" validate:"gte=0"`
+	// At most max_covers guests and max_parties parties may arrive in each pacing interval of the
+	// shift, which defaults to 15 minutes. Zero limits are not enforced.
+	PacingIntervalMinutes int `json:"pacing_interval_minutes" validate:"gte=0" example:"15"`
+	MaxCovers             int `json:"max_covers" validate:"gte=0" example:"20"`
+	MaxParties            int `json:"max_parties" validate:"gte=0"`
+	// Reservations are accepted beyond the free tables up to overbooking_percent of the shift's
+	// tables, or overbooking_tables tables when set.
+	OverbookingPercent int `json:"overbooking_percent" validate:"gte=0,lte=100" example:"8"`
+	OverbookingTables  int `json:"overbooking_tables" validate:"gte=0"`
+}
+
+type ClosureRequest struct {
+	Date   string `json:"date" validate:"required,datetime=2006-01-02"`
+	Reason string `json:"reason" validate:"max=200"`
+}
+
+type ScheduleRequest struct {
+	TimeZone string           `json:"time_zone" example:"Asia/Bangkok"`
+	Shifts   []ShiftRequest   `json:"shifts" validate:"dive"`
+	Closures []ClosureRequest `json:"closures" validate:"dive"`
+}
+
+type ShiftResponse struct {
+	Name                  string `json:"name"`
+	Day                   string `json:"day"`
+	Opens                 string `json:"opens"`
+	Closes                string `json:"closes"`
+	MaxTables             int    `json:"max_tables"`
+	PacingIntervalMinutes int    `json:"pacing_interval_minutes"`
+	MaxCovers             int    `json:"max_covers"`
+	MaxParties            int    `json:"max_parties"`
+	OverbookingPercent    int    `json:"overbooking_percent"`
+	OverbookingTables     int    `json:"overbooking_tables"`
+}
+
+type ClosureResponse struct {
+	Date   string `json:"date"`
+	Reason string `json:"reason,omitempty"`
+}
+
+type ScheduleResponse struct {
+	TimeZone string            `json:"time_zone"`
+	Shifts   []ShiftResponse   `json:"shifts"`
+	Closures []ClosureResponse `json:"closures"`
+}
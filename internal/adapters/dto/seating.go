@@ -0,0 +1,11 @@
+package dto
+
+type SeatingRequest struct {
+	Section  string   `json:"section" validate:"omitempty,oneof=main_room patio bar private_room"`
+	Features []string `json:"features" validate:"dive,oneof=wheelchair_accessible high_chair window"`
+}
+
+type SeatingResponse struct {
+	Section  string   `json:"section,omitempty"`
+	Features []string `json:"features,omitempty"`
+}
@@ -1,15 +1,82 @@
 package dto
 
+import "time"
+
 type TablesResponse struct {
-	TotalTables     int            `json:"total_tables"`
-	AvailableTables int            `json:"available_tables"`
-	Reservations    map[string]int `json:"reservations"`
+	TotalTables     int `json:"total_tables"`
+	AvailableTables int `json:"available_tables"`
+	// OverbookedTables of the shift's OverbookingAllowance are taken by reservations accepted beyond
+	// the real tables; OverbookedReservations maps each of them to the tables it needs.
+	OverbookingAllowance   int                   `json:"overbooking_allowance"`
+	OverbookedTables       int                   `json:"overbooked_tables"`
+	StartTime              time.Time             `json:"start_time"`
+	EndTime                time.Time             `json:"end_time"`
+	Tables                 []TableStatusResponse `json:"tables"`
+	Reservations           map[string]int        `json:"reservations"`
+	OverbookedReservations map[string]int        `json:"overbooked_reservations"`
+}
+
+type TableStatusResponse struct {
+	Id             string   `json:"id"`
+	Label          string   `json:"label"`
+	Capacity       int      `json:"capacity"`
+	MinPartySize   int      `json:"min_party_size"`
+	CombinableWith []string `json:"combinable_with"`
+	Section        string   `json:"section"`
+	Features       []string `json:"features"`
+	Available      bool     `json:"available"`
+	ReservationId  string   `json:"reservation_id,omitempty"`
+	Blocked        bool     `json:"blocked"`
+	BlockId        string   `json:"block_id,omitempty"`
+	BlockReason    string   `json:"block_reason,omitempty"`
+}
+
+type TableRequest struct {
+	Id             string   `json:"id"`
+	Label          string   `json:"label"`
+	Capacity       int      `json:"capacity"`
+	MinPartySize   int      `json:"min_party_size"`
+	CombinableWith []string `json:"combinable_with"`
+	// Section defaults to main_room.
+	Section  string   `json:"section" example:"patio"`
+	Features []string `json:"features" example:"window"`
 }
 
 type InitializeTableRequest struct {
-	NumTables int `json:"num_tables"`
+	NumTables int            `json:"num_tables"`
+	Tables    []TableRequest `json:"tables"`
 }
 
 type InitializeTableResponse struct {
 	TotalTables int `json:"total_tables"`
+	TotalSeats  int `json:"total_seats"`
+}
+
+type TableBlockRequest struct {
+	TableIds []string `json:"table_ids" validate:"required,min=1"`
+	// StartTime defaults to now and EndTime to until the block is lifted.
+	StartTime time.Time `json:"start_time"`
+	EndTime   time.Time `json:"end_time"`
+	Reason    string    `json:"reason" validate:"max=200" example:"broken chair"`
+}
+
+type TableBlockResponse struct {
+	Id        string     `json:"id"`
+	TableIds  []string   `json:"table_ids"`
+	StartTime time.Time  `json:"start_time"`
+	EndTime   *time.Time `json:"end_time,omitempty"`
+	Reason    string     `json:"reason,omitempty"`
+}
+
+type InventoryConflictResponse struct {
+	Message      string                           `json:"message"`
+	Reservations []ConflictingReservationResponse `json:"reservations"`
+}
+
+type ConflictingReservationResponse struct {
+	BookingId string    `json:"booking_id"`
+	PartySize int       `json:"party_size"`
+	TableIds  []string  `json:"table_ids"`
+	StartTime time.Time `json:"start_time"`
+	EndTime   time.Time `json:"end_time"`
 }
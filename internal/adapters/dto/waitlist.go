@@ -0,0 +1,14 @@
+package dto
+
+import "time"
+
+type WaitlistEntryResponse struct {
+	WaitlistId    string        `json:"waitlist_id"`
+	Status        string        `json:"status"`
+	Position      int           `json:"position"`
+	PartySize     int           `json:"party_size"`
+	Guest         GuestResponse `json:"guest"`
+	StartTime     time.Time     `json:"start_time"`
+	EndTime       time.Time     `json:"end_time"`
+	ReservationId string        `json:"reservation_id,omitempty"`
+}
@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"github.com/bossncn/restaurant-reservation-service/internal/core/model"
 	"github.com/bossncn/restaurant-reservation-service/internal/core/repository"
+	"github.com/bossncn/restaurant-reservation-service/internal/core/service"
 	"go.uber.org/zap"
+	"sort"
 	"sync"
 	"time"
 )
@@ -13,96 +15,1447 @@ import (
 type Processor struct {
 	tableRepo       repository.TableRepository
 	reservationRepo repository.ReservationRepository
+	waitlistRepo    repository.WaitlistRepository
+	scheduleRepo    repository.ScheduleRepository
+	blockRepo       repository.BlockRepository
+	allocator       service.TableAllocator
+	clock           service.Clock
+	holdTTL         time.Duration
+	turnTimes       service.TurnTimes
+	sizing          service.SizingPolicy
+	bookingWindows  map[model.Channel]model.BookingWindow
+	cancellation    model.CancellationPolicy
+	payments        service.PaymentGateway
+	deposits        model.DepositPolicy
+	holds           []hold
 	requests        chan model.EventRequest
 	stopChan        chan bool
 	wg              sync.WaitGroup
 	logger          *zap.Logger
 }
 
-func NewProcessor(tableRepository repository.TableRepository, reservationRepository repository.ReservationRepository, logger *zap.Logger) (*Processor, *chan model.EventRequest) {
+// hold tracks when a held reservation must give its tables back, or, when waitlistId is set, when
+// a waiting party's time slot starts.
+type hold struct {
+	restaurantId  string
+	reservationId string
+	waitlistId    string
+	expiresAt     time.Time
+}
+
+// walkInWaitHorizon is how far ahead a walk-in's wait is estimated.
+const walkInWaitHorizon = 4 * time.Hour
+
+// endOfTime bounds searches over every upcoming reservation.
+var endOfTime = time.Date(9999, time.December, 31, 0, 0, 0, 0, time.UTC)
+
+// holdSweepInterval is how often held reservations are checked for expiry while no requests arrive.
+const holdSweepInterval = time.Second
+
+// Option customises the policies a Processor applies to the requests it serialises.
+type Option func(*Processor)
+
+// WithAllocator sets the strategy used to seat parties. Best-fit is used when it is not set.
+func WithAllocator(allocator service.TableAllocator) Option {
+	return func(p *Processor) {
+		p.allocator = allocator
+	}
+}
+
+// WithWaitlist queues parties that cannot be seated and promotes them when tables are released.
+// Without it the waitlist actions are rejected.
+func WithWaitlist(waitlistRepository repository.WaitlistRepository) Option {
+	return func(p *Processor) {
+		p.waitlistRepo = waitlistRepository
+	}
+}
+
+// WithSchedule only accepts bookings inside the restaurant's shifts and caps the tables booked per
+// shift. Without it, or for a restaurant without a schedule, bookings are accepted at any time.
+func WithSchedule(scheduleRepository repository.ScheduleRepository) Option {
+	return func(p *Processor) {
+		p.scheduleRepo = scheduleRepository
+	}
+}
+
+// WithBlocks keeps blocked tables out of allocation. Without it the block actions are rejected.
+func WithBlocks(blockRepository repository.BlockRepository) Option {
+	return func(p *Processor) {
+		p.blockRepo = blockRepository
+	}
+}
+
+// WithClock sets the clock used to stamp and expire holds. The system clock is used when it is not set.
+func WithClock(clock service.Clock) Option {
+	return func(p *Processor) {
+		p.clock = clock
+	}
+}
+
+// WithHoldTTL sets how long a hold locks its tables before it expires.
+func WithHoldTTL(ttl time.Duration) Option {
+	return func(p *Processor) {
+		p.holdTTL = ttl
+	}
+}
+
+// WithTurnTimes sets how long bookings without a duration keep their tables and the reset buffer
+// kept free between seatings. Without it every booking defaults to two hours with no buffer.
+func WithTurnTimes(turnTimes service.TurnTimes) Option {
+	return func(p *Processor) {
+		p.turnTimes = turnTimes
+	}
+}
+
+// WithSizing sets which party sizes are seated and how many tables a party may be spread across.
+// Without it any party is seated at whatever combination the allocator picks.
+func WithSizing(sizing service.SizingPolicy) Option {
+	return func(p *Processor) {
+		p.sizing = sizing
+	}
+}
+
+// WithBookingWindow limits how soon and how far ahead bookings may be made through the channel.
+// Without it bookings may be made at any time.
+func WithBookingWindow(channel model.Channel, window model.BookingWindow) Option {
+	return func(p *Processor) {
+		p.bookingWindows[channel] = window
+	}
+}
+
+// WithCancellationPolicy sets what late cancellations cost. Without it every cancellation is free.
+func WithCancellationPolicy(policy model.CancellationPolicy) Option {
+	return func(p *Processor) {
+		p.cancellation = policy
+	}
+}
+
+// WithPayments asks bookings for deposits under policy and takes them through gateway. Without it
+// no booking waits for a deposit.
+func WithPayments(gateway service.PaymentGateway, policy model.DepositPolicy) Option {
+	return func(p *Processor) {
+		p.payments = gateway
+		p.deposits = policy
+	}
+}
+
+func NewProcessor(tableRepository repository.TableRepository, reservationRepository repository.ReservationRepository, logger *zap.Logger, opts ...Option) (*Processor, *chan model.EventRequest) {
 	requests := make(chan model.EventRequest, 100)
 
 	processor := &Processor{
 		tableRepo:       tableRepository,
 		reservationRepo: reservationRepository,
+		allocator:       &service.BestFitAllocator{},
+		clock:           service.SystemClock{},
+		holdTTL:         service.DefaultHoldTTL,
+		sizing:          service.PartySizeLimits{},
+		bookingWindows:  make(map[model.Channel]model.BookingWindow),
 		requests:        requests,
 		stopChan:        make(chan bool),
 		logger:          logger,
 	}
 
+	for _, opt := range opts {
+		opt(processor)
+	}
+
 	processor.wg.Add(1)
 	return processor, &requests
 }
 
 func (e *Processor) ProcessRequests() {
 	defer e.wg.Done()
+	ticker := time.NewTicker(holdSweepInterval)
+	defer ticker.Stop()
 	for {
 		select {
 		case req := <-e.requests:
 			timeStarted := time.Now()
-			e.logger.Info("Incoming Event EventRequest", zap.String("requestId", req.Id), zap.String("action", req.Action))
+			e.logger.Info("Incoming Event EventRequest", zap.String("requestId", req.Id), zap.String("restaurantId", req.RestaurantId), zap.String("action", req.Action))
+			// Expire holds before serving the request so it never sees tables a lapsed hold still locks.
+			e.expireHolds(req.Id)
 			switch req.Action {
 			case "initialize":
-				err := e.tableRepo.InitializeTables(req.NumTables)
-				if err != nil {
-					req.Response <- e.logError(req.Id, "initialize", err)
-				} else {
-					req.Response <- err
-				}
-			case "reserve":
-				if !e.tableRepo.IsTableInitialized() {
-					req.Response <- e.logError(req.Id, "reserve", errors.New("tables has not been initialized"))
-				} else if req.NumTables <= 0 {
-					req.Response <- e.logError(req.Id, "reserve", errors.New("invalid number of tables"))
-				} else if req.NumTables > e.tableRepo.AvailableTables() {
-					req.Response <- e.logError(req.Id, "reserve", errors.New("not enough tables available"))
-				} else {
-					reservation := e.reservationRepo.CreateReservation(req.NumTables)
-					err := e.tableRepo.ReserveTables(*reservation)
-					if err != nil {
-						req.Response <- err
-					}
-					req.Response <- reservation.Id
-				}
+				req.Response <- e.initialize(req)
+			case "resize":
+				req.Response <- e.resize(req)
+			case "block":
+				req.Response <- e.block(req)
+			case "unblock":
+				req.Response <- e.unblock(req)
+			case "reserve", "hold":
+				req.Response <- e.reserve(req)
+			case "reserve_series":
+				req.Response <- e.reserveSeries(req)
+			case "cancel_series":
+				req.Response <- e.cancelSeries(req)
+			case "walk_in":
+				req.Response <- e.walkIn(req)
+			case "book_event":
+				req.Response <- e.bookEvent(req)
+			case "update_deposit":
+				req.Response <- e.updateDeposit(req)
+			case "pay":
+				req.Response <- e.pay(req)
+			case "confirm":
+				req.Response <- e.transition(req, model.ReservationConfirmed)
+			case "modify":
+				req.Response <- e.modify(req)
 			case "cancel":
-				if !e.tableRepo.IsTableInitialized() {
-					req.Response <- e.logError(req.Id, "cancel", errors.New("tables has not been initialized"))
-				} else {
-					reservation, err := e.reservationRepo.FindReservationById(req.ResID)
-					if err != nil {
-						req.Response <- e.logError(req.Id, "cancel", err)
-					} else {
-						err := e.tableRepo.CancelReservedTable(reservation.Id)
-						if err != nil {
-							req.Response <- e.logError(req.Id, "cancel", err)
-						} else {
-							err = e.reservationRepo.CancelReservation(reservation.Id)
-							if err != nil {
-								req.Response <- e.logError(req.Id, "cancel", err)
-							}
-							req.Response <- reservation.NumTables
-						}
-					}
-				}
+				req.Response <- e.transition(req, model.ReservationCancelled)
+			case "seat":
+				req.Response <- e.transition(req, model.ReservationSeated)
+			case "complete":
+				req.Response <- e.transition(req, model.ReservationCompleted)
+			case "no_show":
+				req.Response <- e.transition(req, model.ReservationNoShow)
+			case "join_waitlist":
+				req.Response <- e.joinWaitlist(req)
+			case "leave_waitlist":
+				req.Response <- e.leaveWaitlist(req)
+			case "availability":
+				req.Response <- e.availability(req)
 			}
 			e.logger.Info("Event EventRequest Complete", zap.String("requestId", req.Id), zap.String("action", req.Action), zap.String("elapsed", fmt.Sprintf("%.3f ms", float64(time.Since(timeStarted).Microseconds())/1000)))
+		case <-ticker.C:
+			e.expireHolds("hold-sweep")
 		case <-e.stopChan:
 			return
 		}
 	}
 }
 
+func (e *Processor) initialize(req model.EventRequest) interface{} {
+	err := e.tableRepo.InitializeTables(req.RestaurantId, req.Tables)
+	if err != nil {
+		return e.logError(req.Id, "initialize", err)
+	}
+	return nil
+}
+
+// resize replaces the restaurant's inventory. It is refused when an upcoming reservation holds a
+// table that would be removed, or its tables would no longer seat the party; added tables are
+// offered to the waitlist straight away.
+func (e *Processor) resize(req model.EventRequest) interface{} {
+	if !e.tableRepo.IsTableInitialized(req.RestaurantId) {
+		return e.logError(req.Id, "resize", errors.New("tables has not been initialized"))
+	}
+
+	inventory := make(map[string]model.Table, len(req.Tables))
+	for _, table := range req.Tables {
+		inventory[table.Id] = table
+	}
+	conflicts := make([]model.Reservation, 0)
+	for _, reservation := range e.tableRepo.ReservationsBetween(req.RestaurantId, e.clock.Now(), endOfTime) {
+		if !reservation.Overbooked && !seatsParty(reservation, inventory) {
+			conflicts = append(conflicts, reservation)
+		}
+	}
+	if len(conflicts) > 0 {
+		return e.logError(req.Id, "resize", &service.InventoryConflictError{Reservations: conflicts})
+	}
+
+	if err := e.tableRepo.ReplaceTables(req.RestaurantId, req.Tables); err != nil {
+		return e.logError(req.Id, "resize", err)
+	}
+	e.fillReleasedTables(req.Id, req.RestaurantId)
+	return nil
+}
+
+// block takes tables out of service. A block without a start begins now. It is refused when a
+// reservation holds a blocked table while the block is in force.
+func (e *Processor) block(req model.EventRequest) interface{} {
+	if e.blockRepo == nil {
+		return e.logError(req.Id, req.Action, errors.New("table blocks are not enabled"))
+	}
+	if !e.tableRepo.IsTableInitialized(req.RestaurantId) {
+		return e.logError(req.Id, req.Action, errors.New("tables has not been initialized"))
+	}
+
+	block := req.Block
+	block.RestaurantId = req.RestaurantId
+	if block.StartTime.IsZero() {
+		block.StartTime = e.clock.Now()
+	}
+	if !block.EndTime.IsZero() && !block.EndTime.After(block.StartTime) {
+		return e.logError(req.Id, req.Action, errors.New("block end time must be after its start time"))
+	}
+	tables := e.tableRepo.Tables(req.RestaurantId)
+	for _, id := range block.TableIds {
+		if !containsTableId(tables, id) {
+			return e.logError(req.Id, req.Action, fmt.Errorf("table %s not found", id))
+		}
+	}
+
+	end := block.EndTime
+	if end.IsZero() {
+		end = endOfTime
+	}
+	conflicts := make([]model.Reservation, 0)
+	for _, reservation := range e.tableRepo.ReservationsBetween(req.RestaurantId, block.StartTime, end) {
+		for _, id := range block.TableIds {
+			if reservation.HasTable(id) {
+				conflicts = append(conflicts, reservation)
+				break
+			}
+		}
+	}
+	if len(conflicts) > 0 {
+		return e.logError(req.Id, req.Action, &service.InventoryConflictError{Reservations: conflicts})
+	}
+
+	return *e.blockRepo.AddBlock(block)
+}
+
+// unblock lifts a block and offers the tables to the waitlist.
+func (e *Processor) unblock(req model.EventRequest) interface{} {
+	if e.blockRepo == nil {
+		return e.logError(req.Id, req.Action, errors.New("table blocks are not enabled"))
+	}
+
+	block, err := e.blockRepo.RemoveBlock(req.RestaurantId, req.Block.Id)
+	if err != nil {
+		return e.logError(req.Id, req.Action, err)
+	}
+	e.fillReleasedTables(req.Id, req.RestaurantId)
+	return *block
+}
+
+// unblocked drops the tables blocked at any point in [start, end).
+func (e *Processor) unblocked(restaurantId string, start time.Time, end time.Time, tables []model.Table) []model.Table {
+	if e.blockRepo == nil {
+		return tables
+	}
+	blocks := e.blockRepo.BlocksBetween(restaurantId, start, end)
+	if len(blocks) == 0 {
+		return tables
+	}
+
+	available := make([]model.Table, 0, len(tables))
+	for _, table := range tables {
+		blocked := false
+		for _, block := range blocks {
+			if block.HasTable(table.Id) {
+				blocked = true
+				break
+			}
+		}
+		if !blocked {
+			available = append(available, table)
+		}
+	}
+	return available
+}
+
+// seatsParty reports whether the reservation's tables are all in the inventory and still seat its party.
+func seatsParty(reservation model.Reservation, inventory map[string]model.Table) bool {
+	tables := make([]model.Table, 0, len(reservation.TableIds))
+	seats := 0
+	for _, id := range reservation.TableIds {
+		table, ok := inventory[id]
+		if !ok {
+			return false
+		}
+		tables = append(tables, table)
+		seats += table.Capacity
+	}
+	return seats >= reservation.PartySize && model.Combinable(tables)
+}
+
+// reserve seats the party and books the tables, either as a confirmed reservation or, for the
+// "hold" action, as a hold that expires after the hold TTL unless it is confirmed. A reservation no
+// free tables seat is accepted into the shift's overbooking band while it has room.
+func (e *Processor) reserve(req model.EventRequest) interface{} {
+	if !e.tableRepo.IsTableInitialized(req.RestaurantId) {
+		return e.logError(req.Id, req.Action, errors.New("tables has not been initialized"))
+	}
+	if err := e.checkPartySize(req.PartySize); err != nil {
+		return e.logError(req.Id, req.Action, err)
+	}
+	duration := e.durationFor(req)
+	if req.StartTime.IsZero() || duration <= 0 {
+		return e.logError(req.Id, req.Action, errors.New("invalid reservation time slot"))
+	}
+	if err := e.checkBookingWindow(req.Channel, req.StartTime); err != nil {
+		return e.logError(req.Id, req.Action, err)
+	}
+
+	tables, err := e.allocate(req.RestaurantId, "", req.PartySize, req.StartTime, req.StartTime.Add(duration), nil, req.Requirements, req.Preferences)
+	overbookedTables := 0
+	if errors.Is(err, service.ErrNotEnoughTables) && req.Action == "reserve" {
+		overbookedTables, err = e.overbook(req.RestaurantId, req.PartySize, req.StartTime, req.StartTime.Add(duration), req.Requirements)
+	}
+	if err != nil {
+		return e.logError(req.Id, req.Action, err)
+	}
+
+	reservation := model.Reservation{
+		RestaurantId: req.RestaurantId,
+		PartySize:    req.PartySize,
+		Guest:        req.Guest,
+		StartTime:    req.StartTime,
+		Duration:     duration,
+		Status:       model.ReservationConfirmed,
+		Requirements: req.Requirements,
+		Preferences:  req.Preferences,
+	}
+	if overbookedTables > 0 {
+		reservation.Overbooked = true
+		reservation.OverbookedTables = overbookedTables
+	}
+	if req.Action == "hold" {
+		reservation.Status = model.ReservationHeld
+		reservation.HoldExpiresAt = e.clock.Now().Add(e.holdTTL)
+	} else if e.requireDeposit(&reservation) {
+		reservation.Status = model.ReservationPendingPayment
+	}
+
+	booked, err := e.book(reservation, tables)
+	if err != nil {
+		return e.logError(req.Id, req.Action, err)
+	}
+	switch booked.Status {
+	case model.ReservationHeld:
+		e.holds = append(e.holds, hold{restaurantId: booked.RestaurantId, reservationId: booked.Id, expiresAt: booked.HoldExpiresAt})
+	case model.ReservationPendingPayment:
+		e.awaitPayment(*booked)
+	}
+	return *booked
+}
+
+// reserveSeries books a confirmed reservation for each occurrence in req.Slots under req.SeriesId.
+// Every occurrence is allocated before any is booked, so when one cannot be seated the whole series
+// is refused with the list of conflicting occurrences.
+func (e *Processor) reserveSeries(req model.EventRequest) interface{} {
+	if !e.tableRepo.IsTableInitialized(req.RestaurantId) {
+		return e.logError(req.Id, req.Action, errors.New("tables has not been initialized"))
+	}
+	if err := e.checkPartySize(req.PartySize); err != nil {
+		return e.logError(req.Id, req.Action, err)
+	}
+	duration := e.durationFor(req)
+	if len(req.Slots) == 0 || duration <= 0 {
+		return e.logError(req.Id, req.Action, errors.New("invalid reservation time slot"))
+	}
+	// Occurrences are allocated independently, so they must not compete for the same tables.
+	if len(req.Slots) > 1 && req.Slots[0].Add(duration+2*e.turnTimes.Buffer).After(req.Slots[1]) {
+		return e.logError(req.Id, req.Action, errors.New("reservation must end before its next occurrence"))
+	}
+	// Occurrences are in time order, so only the first can be too soon and the last too far ahead.
+	for _, start := range []time.Time{req.Slots[0], req.Slots[len(req.Slots)-1]} {
+		if err := e.checkBookingWindow(req.Channel, start); err != nil {
+			return e.logError(req.Id, req.Action, err)
+		}
+	}
+
+	allocations := make([][]model.Table, len(req.Slots))
+	conflicts := make([]service.OccurrenceConflict, 0)
+	for i, start := range req.Slots {
+		tables, err := e.allocate(req.RestaurantId, "", req.PartySize, start, start.Add(duration), nil, req.Requirements, req.Preferences)
+		if err != nil {
+			conflicts = append(conflicts, service.OccurrenceConflict{StartTime: start, Reason: err.Error()})
+			continue
+		}
+		allocations[i] = tables
+	}
+	if len(conflicts) > 0 {
+		return e.logError(req.Id, req.Action, &service.RecurrenceConflictError{Occurrences: conflicts})
+	}
+
+	occurrences := make([]model.Reservation, 0, len(req.Slots))
+	for i, start := range req.Slots {
+		occurrence := model.Reservation{
+			RestaurantId: req.RestaurantId,
+			PartySize:    req.PartySize,
+			Guest:        req.Guest,
+			StartTime:    start,
+			Duration:     duration,
+			Status:       model.ReservationConfirmed,
+			Requirements: req.Requirements,
+			Preferences:  req.Preferences,
+			SeriesId:     req.SeriesId,
+		}
+		if e.requireDeposit(&occurrence) {
+			occurrence.Status = model.ReservationPendingPayment
+		}
+		booked, err := e.book(occurrence, allocations[i])
+		if err != nil {
+			return e.logError(req.Id, req.Action, err)
+		}
+		if booked.Status == model.ReservationPendingPayment {
+			e.awaitPayment(*booked)
+		}
+		occurrences = append(occurrences, *booked)
+	}
+	return occurrences
+}
+
+// cancelSeries cancels every occurrence of a recurring reservation that has not started yet and can
+// still be cancelled, each under the cancellation policy. Past occurrences keep their status. Refunds
+// cannot be taken back, so an occurrence that fails to cancel is reported and left as it was
+// rather than stopping the others.
+func (e *Processor) cancelSeries(req model.EventRequest) interface{} {
+	occurrences := e.reservationRepo.FindReservationsBySeriesId(req.RestaurantId, req.SeriesId)
+	if len(occurrences) == 0 {
+		return e.logError(req.Id, req.Action, errors.New("reservation series not found"))
+	}
+
+	now := e.clock.Now()
+	result := service.SeriesCancellation{Cancelled: make([]model.Reservation, 0, len(occurrences)), Failed: make([]service.OccurrenceFailure, 0)}
+	for _, occurrence := range occurrences {
+		if occurrence.StartTime.Before(now) || !occurrence.Status.CanTransitionTo(model.ReservationCancelled) {
+			continue
+		}
+		if err := e.cancelOccurrence(&occurrence, req.WaiveFee); err != nil {
+			e.logError(req.Id, req.Action, err)
+			result.Failed = append(result.Failed, service.OccurrenceFailure{ReservationId: occurrence.Id, StartTime: occurrence.StartTime, Reason: err.Error()})
+			continue
+		}
+		result.Cancelled = append(result.Cancelled, occurrence)
+	}
+	if len(result.Cancelled) > 0 {
+		e.fillReleasedTables(req.Id, req.RestaurantId)
+	}
+	return result
+}
+
+// cancelOccurrence cancels one occurrence of a series and releases its tables.
+func (e *Processor) cancelOccurrence(occurrence *model.Reservation, waiveFee bool) error {
+	wasAwaiting := occurrence.Status.IsAwaiting()
+	if err := e.cancel(occurrence, waiveFee); err != nil {
+		return err
+	}
+	if err := e.tableRepo.CancelReservedTable(occurrence.RestaurantId, occurrence.Id); err != nil {
+		return err
+	}
+	if err := e.reservationRepo.UpdateReservation(*occurrence); err != nil {
+		return err
+	}
+	if wasAwaiting {
+		e.forgetHold(occurrence.Id)
+	}
+	return nil
+}
+
+// walkIn seats a party at the host stand on tables free from now. When none are, the error carries
+// the earliest time a booked table is expected to come free for the party.
+func (e *Processor) walkIn(req model.EventRequest) interface{} {
+	if !e.tableRepo.IsTableInitialized(req.RestaurantId) {
+		return e.logError(req.Id, req.Action, errors.New("tables has not been initialized"))
+	}
+	if err := e.checkPartySize(req.PartySize); err != nil {
+		return e.logError(req.Id, req.Action, err)
+	}
+	now := e.clock.Now()
+	duration := e.durationFor(req)
+	if duration <= 0 {
+		return e.logError(req.Id, req.Action, errors.New("invalid reservation time slot"))
+	}
+
+	tables, err := e.allocate(req.RestaurantId, "", req.PartySize, now, now.Add(duration), nil, req.Requirements, req.Preferences)
+	if errors.Is(err, service.ErrNotEnoughTables) {
+		if availableAt, ok := e.nextSeating(req.RestaurantId, req.PartySize, now, duration, req.Requirements); ok {
+			err = &service.WaitError{AvailableAt: availableAt, EstimatedWait: availableAt.Sub(now)}
+		}
+	}
+	if err != nil {
+		return e.logError(req.Id, req.Action, err)
+	}
+
+	booked, err := e.book(model.Reservation{
+		RestaurantId: req.RestaurantId,
+		PartySize:    req.PartySize,
+		Guest:        req.Guest,
+		StartTime:    now,
+		Duration:     duration,
+		Status:       model.ReservationSeated,
+		WalkIn:       true,
+		Requirements: req.Requirements,
+		Preferences:  req.Preferences,
+	}, tables)
+	if err != nil {
+		return e.logError(req.Id, req.Action, err)
+	}
+	return *booked
+}
+
+// bookEvent reserves every table in the requested section, or in the whole venue when no section is
+// given, for a private event. It is refused when a reservation or block holds any of those tables
+// during the event or its reset buffer. Events are arranged by staff, so they are not bound to the
+// restaurant's shifts or party size limits.
+func (e *Processor) bookEvent(req model.EventRequest) interface{} {
+	if !e.tableRepo.IsTableInitialized(req.RestaurantId) {
+		return e.logError(req.Id, req.Action, errors.New("tables has not been initialized"))
+	}
+	if req.PartySize <= 0 {
+		return e.logError(req.Id, req.Action, errors.New("invalid party size"))
+	}
+	if req.StartTime.IsZero() || req.Duration <= 0 {
+		return e.logError(req.Id, req.Action, errors.New("invalid reservation time slot"))
+	}
+	section := req.Requirements.Section
+	if section != "" && !model.IsSection(section) {
+		return e.logError(req.Id, req.Action, fmt.Errorf("unknown section %s", section))
+	}
+
+	tables := req.Requirements.Filter(e.tableRepo.Tables(req.RestaurantId))
+	if len(tables) == 0 {
+		return e.logError(req.Id, req.Action, fmt.Errorf("section %s has no tables", section))
+	}
+
+	start := req.StartTime.Add(-e.turnTimes.Buffer)
+	end := req.StartTime.Add(req.Duration + e.turnTimes.Buffer)
+	conflicts := make([]model.Reservation, 0)
+	for _, reservation := range e.tableRepo.ReservationsBetween(req.RestaurantId, start, end) {
+		for _, table := range tables {
+			if reservation.HasTable(table.Id) {
+				conflicts = append(conflicts, reservation)
+				break
+			}
+		}
+	}
+	if len(conflicts) > 0 {
+		return e.logError(req.Id, req.Action, &service.InventoryConflictError{Reservations: conflicts})
+	}
+	if len(e.unblocked(req.RestaurantId, start, end, tables)) < len(tables) {
+		return e.logError(req.Id, req.Action, errors.New("tables are blocked during the event"))
+	}
+
+	depositStatus := req.DepositStatus
+	if depositStatus == "" {
+		depositStatus = model.DepositNotRequired
+	}
+	booked, err := e.book(model.Reservation{
+		RestaurantId:  req.RestaurantId,
+		PartySize:     req.PartySize,
+		Guest:         req.Guest,
+		StartTime:     req.StartTime,
+		Duration:      req.Duration,
+		Status:        model.ReservationConfirmed,
+		Requirements:  req.Requirements,
+		Type:          model.ReservationTypeEvent,
+		DepositStatus: depositStatus,
+	}, tables)
+	if err != nil {
+		return e.logError(req.Id, req.Action, err)
+	}
+	return *booked
+}
+
+// updateDeposit records the deposit status of an event booking.
+func (e *Processor) updateDeposit(req model.EventRequest) interface{} {
+	if !model.IsDepositStatus(req.DepositStatus) {
+		return e.logError(req.Id, req.Action, fmt.Errorf("unknown deposit status %s", req.DepositStatus))
+	}
+
+	reservation, err := e.reservationRepo.FindReservationById(req.RestaurantId, req.ResID)
+	if err != nil {
+		return e.logError(req.Id, req.Action, err)
+	}
+	if !reservation.IsEvent() {
+		return e.logError(req.Id, req.Action, errors.New("deposits are only tracked on event bookings"))
+	}
+	if reservation.Status.IsFinal() {
+		return e.logError(req.Id, req.Action, fmt.Errorf("cannot update the deposit of a %s reservation", reservation.Status))
+	}
+
+	reservation.DepositStatus = req.DepositStatus
+	if err := e.reservationRepo.UpdateReservation(*reservation); err != nil {
+		return e.logError(req.Id, req.Action, err)
+	}
+	return *reservation
+}
+
+// pay takes the deposit of a booking awaiting payment and confirms it. A declined payment gives the
+// tables back straight away; other gateway errors leave the booking waiting so the guest may retry.
+func (e *Processor) pay(req model.EventRequest) interface{} {
+	reservation, err := e.reservationRepo.FindReservationById(req.RestaurantId, req.ResID)
+	if err != nil {
+		return e.logError(req.Id, req.Action, err)
+	}
+	if reservation.Status != model.ReservationPendingPayment {
+		return e.logError(req.Id, req.Action, fmt.Errorf("cannot pay the deposit of a %s reservation", reservation.Status))
+	}
+
+	paymentId, err := e.payments.Charge(req.PaymentToken, reservation.Deposit, reservation.Id)
+	if errors.Is(err, service.ErrPaymentDeclined) {
+		if err := e.release(req.Id, req.RestaurantId, *reservation, model.ReservationPaymentFailed); err != nil {
+			return e.logError(req.Id, req.Action, err)
+		}
+		return e.logError(req.Id, req.Action, fmt.Errorf("%w, the tables have been released", err))
+	}
+	if err != nil {
+		return e.logError(req.Id, req.Action, err)
+	}
+
+	if err := reservation.Transition(model.ReservationConfirmed); err != nil {
+		return e.logError(req.Id, req.Action, err)
+	}
+	reservation.PaymentId = paymentId
+	reservation.DepositStatus = model.DepositPaid
+	reservation.PaymentDueAt = time.Time{}
+	if err := e.reservationRepo.UpdateReservation(*reservation); err != nil {
+		return e.logError(req.Id, req.Action, err)
+	}
+	e.forgetHold(reservation.Id)
+	return *reservation
+}
+
+// release moves a hold or a booking awaiting payment to a final status and gives its tables back.
+func (e *Processor) release(requestId string, restaurantId string, reservation model.Reservation, status model.ReservationStatus) error {
+	if err := reservation.Transition(status); err != nil {
+		return err
+	}
+	if err := e.tableRepo.CancelReservedTable(restaurantId, reservation.Id); err != nil {
+		return err
+	}
+	if err := e.reservationRepo.UpdateReservation(reservation); err != nil {
+		return err
+	}
+	e.forgetHold(reservation.Id)
+	e.fillReleasedTables(requestId, restaurantId)
+	return nil
+}
+
+// depositFor returns the deposit a party booking for start must pay before it is confirmed.
+func (e *Processor) depositFor(restaurantId string, partySize int, start time.Time) int {
+	if e.payments == nil {
+		return 0
+	}
+	location := time.UTC
+	if e.scheduleRepo != nil {
+		if schedule, err := e.scheduleRepo.FindScheduleByRestaurantId(restaurantId); err == nil {
+			if loc, err := schedule.Location(); err == nil {
+				location = loc
+			}
+		}
+	}
+	return e.deposits.Amount(partySize, start, location)
+}
+
+// requireDeposit asks for the deposit the reservation's party and date call for, due within the
+// payment timeout, and reports whether one is due. The caller leaves the reservation pending payment.
+func (e *Processor) requireDeposit(reservation *model.Reservation) bool {
+	deposit := e.depositFor(reservation.RestaurantId, reservation.PartySize, reservation.StartTime)
+	if deposit <= 0 {
+		return false
+	}
+	reservation.Deposit = deposit
+	reservation.DepositStatus = model.DepositPending
+	reservation.PaymentDueAt = e.clock.Now().Add(e.deposits.PaymentTimeout)
+	return true
+}
+
+// awaitPayment releases the reservation's tables unless its deposit is paid by the due time.
+func (e *Processor) awaitPayment(reservation model.Reservation) {
+	e.holds = append(e.holds, hold{restaurantId: reservation.RestaurantId, reservationId: reservation.Id, expiresAt: reservation.PaymentDueAt})
+}
+
+// nextSeating returns the earliest time within walkInWaitHorizon at which a booked table frees up
+// and the party could be seated for duration.
+func (e *Processor) nextSeating(restaurantId string, partySize int, now time.Time, duration time.Duration, requirements model.Seating) (time.Time, bool) {
+	releases := make([]time.Time, 0)
+	for _, reservation := range e.tableRepo.ReservationsBetween(restaurantId, now, now.Add(walkInWaitHorizon)) {
+		if release := reservation.EndTime().Add(e.turnTimes.Buffer); release.After(now) {
+			releases = append(releases, release)
+		}
+	}
+	sort.Slice(releases, func(i, j int) bool { return releases[i].Before(releases[j]) })
+
+	for _, release := range releases {
+		if release.After(now.Add(walkInWaitHorizon)) {
+			break
+		}
+		if _, err := e.allocate(restaurantId, "", partySize, release, release.Add(duration), nil, requirements, model.Seating{}); err == nil {
+			return release, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// availability returns the requested start times the party could be seated at, and on which tables,
+// without booking anything.
+func (e *Processor) availability(req model.EventRequest) interface{} {
+	if !e.tableRepo.IsTableInitialized(req.RestaurantId) {
+		return e.logError(req.Id, req.Action, errors.New("tables has not been initialized"))
+	}
+	if err := e.checkPartySize(req.PartySize); err != nil {
+		return e.logError(req.Id, req.Action, err)
+	}
+	duration := e.durationFor(req)
+	if duration <= 0 {
+		return e.logError(req.Id, req.Action, errors.New("invalid reservation time slot"))
+	}
+
+	slots := make([]model.Slot, 0)
+	for _, start := range req.Slots {
+		if e.checkBookingWindow(req.Channel, start) != nil {
+			continue
+		}
+		tables, err := e.allocate(req.RestaurantId, "", req.PartySize, start, start.Add(duration), nil, req.Requirements, req.Preferences)
+		if err != nil {
+			continue
+		}
+		tableIds := make([]string, 0, len(tables))
+		for _, table := range tables {
+			tableIds = append(tableIds, table.Id)
+		}
+		slots = append(slots, model.Slot{StartTime: start, EndTime: start.Add(duration), TableIds: tableIds})
+	}
+	return slots
+}
+
+// allocate picks tables for a party between start and end. reservationId is the party's booking
+// when it is being changed, and own lists the tables it already holds in that slot; they are offered
+// first so that, between equally good allocations, the party keeps them. Only tables meeting the requirements are considered, and tables meeting the preferences
+// too are tried first. Bookings outside the restaurant's shifts are rejected and the shift's table
+// cap and pacing are enforced.
+func (e *Processor) allocate(restaurantId string, reservationId string, partySize int, start time.Time, end time.Time, own []model.Table, requirements model.Seating, preferences model.Seating) ([]model.Table, error) {
+	shift, location, err := e.shiftFor(restaurantId, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	tables, free, err := e.fitFree(restaurantId, partySize, start, end, own, requirements, preferences)
+	if err != nil {
+		return nil, err
+	}
+
+	if shift != nil && shift.MaxTables > 0 {
+		booked := e.tableRepo.TotalTables(restaurantId) - len(free) - len(own)
+		if booked+len(tables) > shift.MaxTables {
+			return nil, service.ErrNotEnoughTables
+		}
+	}
+	if shift != nil && !shift.Pacing.IsEmpty() {
+		if err := e.checkPacing(restaurantId, reservationId, partySize, start, shift, location); err != nil {
+			return nil, err
+		}
+	}
+	return tables, nil
+}
+
+// fitFree seats the party at the unblocked tables free from start to end, together with own. It
+// also returns the free tables, blocked or not.
+func (e *Processor) fitFree(restaurantId string, partySize int, start time.Time, end time.Time, own []model.Table, requirements model.Seating, preferences model.Seating) ([]model.Table, []model.Table, error) {
+	// Tables must be free for the reset buffer on either side of the seating.
+	free := e.occupied(restaurantId, start.Add(-e.turnTimes.Buffer), end.Add(e.turnTimes.Buffer), e.tableRepo.FreeTables(restaurantId, start.Add(-e.turnTimes.Buffer), end.Add(e.turnTimes.Buffer)))
+	available := e.unblocked(restaurantId, start.Add(-e.turnTimes.Buffer), end.Add(e.turnTimes.Buffer), free)
+	candidates := requirements.Filter(append(append([]model.Table{}, own...), available...))
+	tables, err := e.fit(partySize, preferences.Filter(candidates))
+	if err != nil && !preferences.IsEmpty() {
+		tables, err = e.fit(partySize, candidates)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return tables, free, nil
+}
+
+// occupied drops from tables those still held by parties seated past their scheduled end. Such a
+// party keeps its tables until it is completed, and as nobody knows when it will leave, they are
+// held for any seating within walkInWaitHorizon of now.
+func (e *Processor) occupied(restaurantId string, start time.Time, end time.Time, tables []model.Table) []model.Table {
+	now := e.clock.Now()
+	if len(tables) == 0 || !end.After(now) || !start.Before(now.Add(walkInWaitHorizon)) {
+		return tables
+	}
+
+	held := make(map[string]bool)
+	for _, reservation := range e.tableRepo.ReservationsBetween(restaurantId, time.Time{}, now) {
+		if reservation.Status == model.ReservationSeated && !reservation.EndTime().After(now) {
+			for _, tableId := range reservation.TableIds {
+				held[tableId] = true
+			}
+		}
+	}
+	if len(held) == 0 {
+		return tables
+	}
+
+	free := make([]model.Table, 0, len(tables))
+	for _, table := range tables {
+		if !held[table.Id] {
+			free = append(free, table)
+		}
+	}
+	return free
+}
+
+// overbook places a party that no free tables seat in the shift's overbooking band. It returns how
+// many tables the party would need were the venue's tables free, and ErrNotEnoughTables once those
+// would take the overlapping overbooked reservations beyond the shift's allowance.
+func (e *Processor) overbook(restaurantId string, partySize int, start time.Time, end time.Time, requirements model.Seating) (int, error) {
+	shift, location, err := e.shiftFor(restaurantId, start, end)
+	if err != nil {
+		return 0, err
+	}
+	if shift == nil || shift.Overbooking.IsEmpty() {
+		return 0, service.ErrNotEnoughTables
+	}
+
+	tables, err := e.fit(partySize, requirements.Filter(e.tableRepo.Tables(restaurantId)))
+	if err != nil {
+		return 0, err
+	}
+	allowance := shift.OverbookingAllowance(e.tableRepo.TotalTables(restaurantId))
+	if e.overbookedTables(restaurantId, start, end)+len(tables) > allowance {
+		return 0, service.ErrNotEnoughTables
+	}
+	if !shift.Pacing.IsEmpty() {
+		if err := e.checkPacing(restaurantId, "", partySize, start, shift, location); err != nil {
+			return 0, err
+		}
+	}
+	return len(tables), nil
+}
+
+// overbookedTables returns how many tables the overbooked reservations overlapping the window need.
+func (e *Processor) overbookedTables(restaurantId string, start time.Time, end time.Time) int {
+	overbooked := 0
+	for _, reservation := range e.tableRepo.ReservationsBetween(restaurantId, start, end) {
+		if reservation.Overbooked {
+			overbooked += reservation.OverbookedTables
+		}
+	}
+	return overbooked
+}
+
+// fit runs the allocator, never spreading the party over more tables than the sizing policy allows.
+func (e *Processor) fit(partySize int, candidates []model.Table) ([]model.Table, error) {
+	return e.allocator.Allocate(partySize, candidates, e.sizing.MaxTables(partySize))
+}
+
+func (e *Processor) checkPartySize(partySize int) error {
+	if partySize <= 0 {
+		return errors.New("invalid party size")
+	}
+	return e.sizing.Check(partySize)
+}
+
+// checkBookingWindow rejects a booking through the channel that starts too soon or too far ahead.
+func (e *Processor) checkBookingWindow(channel model.Channel, start time.Time) error {
+	if channel == "" {
+		channel = model.ChannelStaff
+	}
+	return e.bookingWindows[channel].Check(e.clock.Now(), start)
+}
+
+// durationFor returns the requested duration, or the turn time for the party size when none was given.
+func (e *Processor) durationFor(req model.EventRequest) time.Duration {
+	if req.Duration != 0 {
+		return req.Duration
+	}
+	return e.turnTimes.DurationFor(req.PartySize)
+}
+
+// shiftFor returns the shift a booking falls in and the restaurant's time zone, or a nil shift when
+// the restaurant has no schedule.
+func (e *Processor) shiftFor(restaurantId string, start time.Time, end time.Time) (*model.Shift, *time.Location, error) {
+	if e.scheduleRepo == nil {
+		return nil, nil, nil
+	}
+	schedule, err := e.scheduleRepo.FindScheduleByRestaurantId(restaurantId)
+	if err != nil {
+		// Restaurants without a schedule take bookings at any time.
+		return nil, nil, nil
+	}
+	shift, err := schedule.ShiftFor(start, end)
+	if err != nil || shift == nil {
+		return nil, nil, err
+	}
+	location, err := schedule.Location()
+	return shift, location, err
+}
+
+// checkPacing rejects a party arriving at start when the shift's pacing interval already has as many
+// covers or parties arriving as the kitchen can absorb. The reservation being changed is the party
+// itself, and events are catered separately, so neither counts.
+func (e *Processor) checkPacing(restaurantId string, reservationId string, partySize int, start time.Time, shift *model.Shift, location *time.Location) error {
+	from, to := shift.PacingWindow(start, location)
+	covers, parties := partySize, 1
+	for _, reservation := range e.tableRepo.ReservationsBetween(restaurantId, from, to) {
+		if reservation.IsEvent() || reservation.StartTime.Before(from) || !reservation.StartTime.Before(to) {
+			continue
+		}
+		if reservationId != "" && reservation.Id == reservationId {
+			continue
+		}
+		covers += reservation.PartySize
+		parties++
+	}
+	if (shift.Pacing.MaxCovers > 0 && covers > shift.Pacing.MaxCovers) || (shift.Pacing.MaxParties > 0 && parties > shift.Pacing.MaxParties) {
+		return fmt.Errorf("%w for arrivals between %s and %s", model.ErrPacingLimitReached, from.Format("15:04"), to.Format("15:04"))
+	}
+	return nil
+}
+
+// book records the reservation on the allocated tables and holds them for it.
+func (e *Processor) book(reservation model.Reservation, tables []model.Table) (*model.Reservation, error) {
+	reservation.NumTables = len(tables)
+	reservation.TableIds = tableIds(tables)
+	reservation.UnmetPreferences = reservation.Preferences.Unmet(tables)
+
+	booked := e.reservationRepo.CreateReservation(reservation)
+	if err := e.tableRepo.ReserveTables(*booked); err != nil {
+		return nil, err
+	}
+	return booked, nil
+}
+
+// modify re-seats a reservation for a new party size. The reservation's own tables count as free so
+// it can keep, grow or shrink its allocation; if no allocation fits, the reservation is left untouched.
+// An overbooked reservation can only be modified onto free tables.
+func (e *Processor) modify(req model.EventRequest) interface{} {
+	if !e.tableRepo.IsTableInitialized(req.RestaurantId) {
+		return e.logError(req.Id, "modify", errors.New("tables has not been initialized"))
+	}
+	if req.PartySize <= 0 {
+		return e.logError(req.Id, "modify", errors.New("invalid party size"))
+	}
+
+	original, err := e.reservationRepo.FindReservationById(req.RestaurantId, req.ResID)
+	if err != nil {
+		return e.logError(req.Id, "modify", err)
+	}
+	if original.Status.IsFinal() || original.Status == model.ReservationPendingPayment {
+		return e.logError(req.Id, "modify", fmt.Errorf("cannot modify a %s reservation", original.Status))
+	}
+	if original.IsEvent() {
+		// An event already holds its whole section, so only the headcount changes.
+		modified := *original
+		modified.PartySize = req.PartySize
+		if err := e.reservationRepo.UpdateReservation(modified); err != nil {
+			return e.logError(req.Id, "modify", err)
+		}
+		return modified
+	}
+	if err := e.sizing.Check(req.PartySize); err != nil {
+		return e.logError(req.Id, "modify", err)
+	}
+
+	own := make([]model.Table, 0, len(original.TableIds))
+	for _, table := range e.tableRepo.Tables(req.RestaurantId) {
+		if original.HasTable(table.Id) {
+			own = append(own, table)
+		}
+	}
+	tables, err := e.allocate(req.RestaurantId, original.Id, req.PartySize, original.StartTime, original.EndTime(), own, original.Requirements, original.Preferences)
+	if err != nil {
+		return e.logError(req.Id, "modify", err)
+	}
+
+	modified := *original
+	modified.PartySize = req.PartySize
+	modified.NumTables = len(tables)
+	modified.TableIds = tableIds(tables)
+	modified.UnmetPreferences = modified.Preferences.Unmet(tables)
+	// An overbooked reservation leaves the band once it is seated on real tables.
+	modified.Overbooked = false
+	modified.OverbookedTables = 0
+	// A party grown into needing a deposit pays it before the booking stands again.
+	grown := modified.PartySize > original.PartySize
+	if grown && modified.Status == model.ReservationConfirmed && modified.DepositStatus != model.DepositPaid && e.requireDeposit(&modified) {
+		if err := modified.Transition(model.ReservationPendingPayment); err != nil {
+			return e.logError(req.Id, "modify", err)
+		}
+	}
+
+	if err := e.tableRepo.CancelReservedTable(req.RestaurantId, original.Id); err != nil {
+		return e.logError(req.Id, "modify", err)
+	}
+	if err := e.tableRepo.ReserveTables(modified); err != nil {
+		// Put the original allocation back so a failed change never costs the party its tables.
+		if restoreErr := e.tableRepo.ReserveTables(*original); restoreErr != nil {
+			e.logError(req.Id, "modify", restoreErr)
+		}
+		return e.logError(req.Id, "modify", err)
+	}
+	if err := e.reservationRepo.UpdateReservation(modified); err != nil {
+		return e.logError(req.Id, "modify", err)
+	}
+	if modified.Status == model.ReservationPendingPayment {
+		e.awaitPayment(modified)
+	}
+
+	for _, id := range original.TableIds {
+		if !modified.HasTable(id) {
+			e.fillReleasedTables(req.Id, req.RestaurantId)
+			break
+		}
+	}
+	return modified
+}
+
+// transition moves a reservation through its lifecycle, releasing its tables once it reaches a
+// final status so they can be booked again.
+func (e *Processor) transition(req model.EventRequest, status model.ReservationStatus) interface{} {
+	if !e.tableRepo.IsTableInitialized(req.RestaurantId) {
+		return e.logError(req.Id, req.Action, errors.New("tables has not been initialized"))
+	}
+
+	reservation, err := e.reservationRepo.FindReservationById(req.RestaurantId, req.ResID)
+	if err != nil {
+		return e.logError(req.Id, req.Action, err)
+	}
+	if reservation.Status == model.ReservationExpired && status == model.ReservationConfirmed {
+		return e.logError(req.Id, req.Action, errors.New("hold has expired"))
+	}
+	if reservation.Status == model.ReservationPendingPayment && status == model.ReservationConfirmed {
+		return e.logError(req.Id, req.Action, errors.New("reservation is awaiting its deposit"))
+	}
+	var tables []model.Table
+	if reservation.Overbooked && status == model.ReservationSeated {
+		// An overbooked party has no tables until it arrives, so it is seated at free ones or not at all.
+		tables, _, err = e.fitFree(req.RestaurantId, reservation.PartySize, reservation.StartTime, reservation.EndTime(), nil, reservation.Requirements, reservation.Preferences)
+		if err != nil {
+			return e.logError(req.Id, req.Action, fmt.Errorf("%w to seat the overbooked reservation", err))
+		}
+	}
+	confirmingHold := reservation.Status == model.ReservationHeld && status == model.ReservationConfirmed
+	wasAwaiting := reservation.Status.IsAwaiting()
+	if confirmingHold && e.requireDeposit(reservation) {
+		status = model.ReservationPendingPayment
+	}
+	if status == model.ReservationCancelled {
+		if err := e.cancel(reservation, req.WaiveFee); err != nil {
+			return e.logError(req.Id, req.Action, err)
+		}
+	} else if err := reservation.Transition(status); err != nil {
+		return e.logError(req.Id, req.Action, err)
+	}
+	if confirmingHold {
+		reservation.Guest = req.Guest
+		reservation.HoldExpiresAt = time.Time{}
+	}
+	if tables != nil {
+		placeOverbooked(reservation, tables)
+	}
+	if status == model.ReservationSeated {
+		// The inventory records who is seated, since a party keeps its tables until it is completed.
+		if err := e.tableRepo.ReserveTables(*reservation); err != nil {
+			return e.logError(req.Id, req.Action, err)
+		}
+	}
+	if status.IsFinal() {
+		if err := e.tableRepo.CancelReservedTable(req.RestaurantId, reservation.Id); err != nil {
+			return e.logError(req.Id, req.Action, err)
+		}
+	}
+	if err := e.reservationRepo.UpdateReservation(*reservation); err != nil {
+		return e.logError(req.Id, req.Action, err)
+	}
+	if wasAwaiting {
+		e.forgetHold(reservation.Id)
+	}
+	if reservation.Status == model.ReservationPendingPayment {
+		e.awaitPayment(*reservation)
+	}
+	if status.IsFinal() {
+		e.fillReleasedTables(req.Id, req.RestaurantId)
+	}
+	return *reservation
+}
+
+// cancel cancels the reservation under the cancellation policy, forfeiting or refunding a paid
+// deposit, and records the outcome in its Cancellation. waiveFee lets staff override the charge. A
+// cancellation fee is only recorded: there is no payment method on file to charge it to.
+func (e *Processor) cancel(reservation *model.Reservation, waiveFee bool) error {
+	cancellation := e.cancellation.Evaluate(*reservation, e.clock.Now(), waiveFee)
+	if err := reservation.Transition(model.ReservationCancelled); err != nil {
+		return err
+	}
+	if cancellation.DepositForfeited {
+		reservation.DepositStatus = model.DepositForfeited
+	} else if reservation.PaymentId != "" && reservation.DepositStatus == model.DepositPaid {
+		// A deposit the cancellation does not forfeit goes back the way it was paid.
+		if err := e.payments.Refund(reservation.PaymentId, reservation.Deposit); err != nil {
+			return err
+		}
+		reservation.DepositStatus = model.DepositRefunded
+		cancellation.Refunded = reservation.Deposit
+	}
+	reservation.Cancellation = &cancellation
+	return nil
+}
+
+// expireHolds gives back the tables of every hold whose TTL has passed, and of every booking whose
+// deposit was not paid in time.
+func (e *Processor) expireHolds(requestId string) {
+	now := e.clock.Now()
+	active := e.holds[:0]
+	var expired []hold
+	for _, h := range e.holds {
+		if now.Before(h.expiresAt) {
+			active = append(active, h)
+		} else {
+			expired = append(expired, h)
+		}
+	}
+	e.holds = active
+
+	for _, h := range expired {
+		if h.waitlistId != "" {
+			e.expireWaitlistEntry(requestId, h.restaurantId, h.waitlistId)
+			continue
+		}
+		reservation, err := e.reservationRepo.FindReservationById(h.restaurantId, h.reservationId)
+		if err != nil {
+			e.logError(requestId, "expire_hold", err)
+			continue
+		}
+		if err := e.release(requestId, h.restaurantId, *reservation, model.ReservationExpired); err != nil {
+			e.logError(requestId, "expire_hold", err)
+			continue
+		}
+		e.logger.Info("Expired hold", zap.String("requestId", requestId), zap.String("restaurantId", h.restaurantId), zap.String("reservationId", reservation.Id))
+	}
+}
+
+// expireWaitlistEntry marks a party still waiting when its time slot starts as expired, so it
+// stops counting towards the positions of the parties behind it.
+func (e *Processor) expireWaitlistEntry(requestId string, restaurantId string, waitlistId string) {
+	entry, err := e.waitlistRepo.FindEntryById(restaurantId, waitlistId)
+	if err != nil {
+		e.logError(requestId, "expire_waitlist", err)
+		return
+	}
+	if entry.Status != model.WaitlistWaiting {
+		return
+	}
+
+	entry.Status = model.WaitlistExpired
+	if err := e.waitlistRepo.UpdateEntry(*entry); err != nil {
+		e.logError(requestId, "expire_waitlist", err)
+		return
+	}
+	e.logger.Info("Expired waitlist entry", zap.String("requestId", requestId), zap.String("restaurantId", restaurantId), zap.String("waitlistId", entry.Id))
+}
+
+func (e *Processor) forgetHold(reservationId string) {
+	for i, h := range e.holds {
+		if h.reservationId == reservationId {
+			e.holds = append(e.holds[:i], e.holds[i+1:]...)
+			return
+		}
+	}
+}
+
+func (e *Processor) joinWaitlist(req model.EventRequest) interface{} {
+	if e.waitlistRepo == nil {
+		return e.logError(req.Id, req.Action, errors.New("waitlist is not enabled"))
+	}
+	if !e.tableRepo.IsTableInitialized(req.RestaurantId) {
+		return e.logError(req.Id, req.Action, errors.New("tables has not been initialized"))
+	}
+	if err := e.checkPartySize(req.PartySize); err != nil {
+		return e.logError(req.Id, req.Action, err)
+	}
+	duration := e.durationFor(req)
+	if req.StartTime.IsZero() || duration <= 0 {
+		return e.logError(req.Id, req.Action, errors.New("invalid reservation time slot"))
+	}
+	if req.StartTime.Before(e.clock.Now()) {
+		return e.logError(req.Id, req.Action, errors.New("cannot wait for a time slot that has already started"))
+	}
+	if err := e.checkBookingWindow(req.Channel, req.StartTime); err != nil {
+		return e.logError(req.Id, req.Action, err)
+	}
+	// Only parties turned away for lack of tables or by pacing can wait; a closed slot will never free up.
+	_, err := e.allocate(req.RestaurantId, "", req.PartySize, req.StartTime, req.StartTime.Add(duration), nil, req.Requirements, req.Preferences)
+	if err == nil {
+		return e.logError(req.Id, req.Action, errors.New("tables are available for this time slot"))
+	}
+	if !errors.Is(err, service.ErrNotEnoughTables) && !errors.Is(err, model.ErrPacingLimitReached) {
+		return e.logError(req.Id, req.Action, err)
+	}
+
+	entry := e.waitlistRepo.AddEntry(model.WaitlistEntry{
+		RestaurantId: req.RestaurantId,
+		PartySize:    req.PartySize,
+		Guest:        req.Guest,
+		StartTime:    req.StartTime,
+		Duration:     duration,
+		Status:       model.WaitlistWaiting,
+		Requirements: req.Requirements,
+		Preferences:  req.Preferences,
+		Channel:      req.Channel,
+	})
+	e.holds = append(e.holds, hold{restaurantId: entry.RestaurantId, waitlistId: entry.Id, expiresAt: entry.StartTime})
+	return *entry
+}
+
+func (e *Processor) leaveWaitlist(req model.EventRequest) interface{} {
+	if e.waitlistRepo == nil {
+		return e.logError(req.Id, req.Action, errors.New("waitlist is not enabled"))
+	}
+
+	entry, err := e.waitlistRepo.FindEntryById(req.RestaurantId, req.WaitlistId)
+	if err != nil {
+		return e.logError(req.Id, req.Action, err)
+	}
+	if entry.Status != model.WaitlistWaiting {
+		return e.logError(req.Id, req.Action, fmt.Errorf("waitlist entry is already %s", entry.Status))
+	}
+
+	entry.Status = model.WaitlistLeft
+	if err := e.waitlistRepo.UpdateEntry(*entry); err != nil {
+		return e.logError(req.Id, req.Action, err)
+	}
+	return *entry
+}
+
+// fillReleasedTables offers tables given back to the overbooked reservations first, since they are
+// already booked, and then to the waitlist.
+func (e *Processor) fillReleasedTables(requestId string, restaurantId string) {
+	e.reseatOverbooked(requestId, restaurantId)
+	e.promoteWaitlist(requestId, restaurantId)
+}
+
+// reseatOverbooked moves upcoming overbooked reservations, earliest first, onto tables that have
+// become free. Overbooking only comes with a schedule, so restaurants without one are skipped.
+func (e *Processor) reseatOverbooked(requestId string, restaurantId string) {
+	if e.scheduleRepo == nil {
+		return
+	}
+
+	for _, reservation := range e.tableRepo.ReservationsBetween(restaurantId, e.clock.Now(), endOfTime) {
+		if !reservation.Overbooked || reservation.Status.IsFinal() {
+			continue
+		}
+		tables, _, err := e.fitFree(restaurantId, reservation.PartySize, reservation.StartTime, reservation.EndTime(), nil, reservation.Requirements, reservation.Preferences)
+		if err != nil {
+			continue
+		}
+
+		placeOverbooked(&reservation, tables)
+		if err := e.tableRepo.ReserveTables(reservation); err != nil {
+			e.logError(requestId, "reseat_overbooked", err)
+			continue
+		}
+		if err := e.reservationRepo.UpdateReservation(reservation); err != nil {
+			e.logError(requestId, "reseat_overbooked", err)
+			continue
+		}
+		e.logger.Info("Reseated overbooked reservation", zap.String("requestId", requestId), zap.String("restaurantId", restaurantId), zap.String("reservationId", reservation.Id))
+	}
+}
+
+// placeOverbooked moves an overbooked reservation out of the band onto real tables.
+func placeOverbooked(reservation *model.Reservation, tables []model.Table) {
+	reservation.NumTables = len(tables)
+	reservation.TableIds = tableIds(tables)
+	reservation.UnmetPreferences = reservation.Preferences.Unmet(tables)
+	reservation.Overbooked = false
+	reservation.OverbookedTables = 0
+}
+
+// promoteWaitlist books every waiting party that now fits, in the order they joined. It runs in the
+// processor right after tables are released so no other request can take them first. Parties whose
+// time slot has already started are marked expired instead, and parties whose channel could not book
+// the slot now are left waiting.
+func (e *Processor) promoteWaitlist(requestId string, restaurantId string) {
+	if e.waitlistRepo == nil {
+		return
+	}
+
+	now := e.clock.Now()
+	for _, entry := range e.waitlistRepo.WaitingEntries(restaurantId) {
+		if entry.StartTime.Before(now) {
+			entry.Status = model.WaitlistExpired
+			if err := e.waitlistRepo.UpdateEntry(entry); err != nil {
+				e.logError(requestId, "promote_waitlist", err)
+			}
+			continue
+		}
+		// A party is only booked when its channel could book the slot now.
+		if e.checkBookingWindow(entry.Channel, entry.StartTime) != nil {
+			continue
+		}
+
+		tables, err := e.allocate(restaurantId, "", entry.PartySize, entry.StartTime, entry.EndTime(), nil, entry.Requirements, entry.Preferences)
+		if err != nil {
+			continue
+		}
+
+		promoted := model.Reservation{
+			RestaurantId: restaurantId,
+			PartySize:    entry.PartySize,
+			Guest:        entry.Guest,
+			StartTime:    entry.StartTime,
+			Duration:     entry.Duration,
+			Status:       model.ReservationConfirmed,
+			Requirements: entry.Requirements,
+			Preferences:  entry.Preferences,
+		}
+		if e.requireDeposit(&promoted) {
+			promoted.Status = model.ReservationPendingPayment
+		}
+		reservation, err := e.book(promoted, tables)
+		if err != nil {
+			e.logError(requestId, "promote_waitlist", err)
+			continue
+		}
+		if reservation.Status == model.ReservationPendingPayment {
+			e.awaitPayment(*reservation)
+		}
+
+		entry.Status = model.WaitlistPromoted
+		entry.ReservationId = reservation.Id
+		if err := e.waitlistRepo.UpdateEntry(entry); err != nil {
+			e.logError(requestId, "promote_waitlist", err)
+			continue
+		}
+		e.logger.Info("Promoted waitlist entry", zap.String("requestId", requestId), zap.String("restaurantId", restaurantId), zap.String("waitlistId", entry.Id), zap.String("reservationId", reservation.Id))
+	}
+}
+
+func tableIds(tables []model.Table) []string {
+	ids := make([]string, 0, len(tables))
+	for _, table := range tables {
+		ids = append(ids, table.Id)
+	}
+	return ids
+}
+
+func containsTableId(tables []model.Table, id string) bool {
+	for _, table := range tables {
+		if table.Id == id {
+			return true
+		}
+	}
+	return false
+}
+
 func (e *Processor) logError(requestId string, action string, err error) error {
 	switch action {
 	case "initialize":
 		e.logger.Error("Error Initialize tables", zap.String("requestId", requestId), zap.Error(err))
 		return err
-	case "reserve":
+	case "resize":
+		e.logger.Error("Error Resize tables", zap.String("requestId", requestId), zap.Error(err))
+		return err
+	case "block", "unblock":
+		e.logger.Error("Error Block tables", zap.String("requestId", requestId), zap.String("action", action), zap.Error(err))
+		return err
+	case "reserve", "hold", "walk_in", "book_event", "reserve_series":
 		e.logger.Error("Error Reserve tables", zap.String("requestId", requestId), zap.Error(err))
 		return err
-	case "cancel":
+	case "modify", "reseat_overbooked":
+		e.logger.Error("Error Modify reservation", zap.String("requestId", requestId), zap.Error(err))
+		return err
+	case "cancel", "cancel_series":
 		e.logger.Error("Error Cancel tables", zap.String("requestId", requestId), zap.Error(err))
 		return err
+	case "availability":
+		e.logger.Error("Error Search availability", zap.String("requestId", requestId), zap.Error(err))
+		return err
+	case "join_waitlist", "leave_waitlist", "promote_waitlist", "expire_waitlist":
+		e.logger.Error("Error Process waitlist", zap.String("requestId", requestId), zap.String("action", action), zap.Error(err))
+		return err
+	case "confirm", "seat", "complete", "no_show", "expire_hold", "update_deposit", "pay":
+		e.logger.Error("Error Update reservation status", zap.String("requestId", requestId), zap.String("action", action), zap.Error(err))
+		return err
 	default:
 		e.logger.Error("Error Process Event", zap.String("requestId", requestId), zap.Error(err))
 		return err
@@ -4,16 +4,39 @@ import (
 	"errors"
 	"github.com/bossncn/restaurant-reservation-service/internal/adapters/event"
 	"github.com/bossncn/restaurant-reservation-service/internal/core/model"
+	"github.com/bossncn/restaurant-reservation-service/internal/core/service"
 	"go.uber.org/mock/gomock"
+	"sync"
 	"testing"
 	"time"
 
 	mockRepository "github.com/bossncn/restaurant-reservation-service/internal/core/repository/mock"
+	serviceMock "github.com/bossncn/restaurant-reservation-service/internal/core/service/mock"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap"
 )
 
+// fakeClock is a clock the tests move forward by hand.
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
 func TestEventProcessor_Initialize(t *testing.T) {
+	tables := []model.Table{{Id: "T1", Label: "T1", Capacity: 4, MinPartySize: 1}}
+
 	t.Run("Success", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -24,16 +47,17 @@ func TestEventProcessor_Initialize(t *testing.T) {
 
 		processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, logger)
 
-		mockTableRepo.EXPECT().InitializeTables(10).Return(nil).Times(1)
+		mockTableRepo.EXPECT().InitializeTables("r1", tables).Return(nil).Times(1)
 
 		go processor.ProcessRequests()
 
 		response := make(chan interface{}, 1)
 		*requests <- model.EventRequest{
-			Id:        "req-1",
-			Action:    "initialize",
-			NumTables: 10,
-			Response:  response,
+			Id:           "req-1",
+			Action:       "initialize",
+			RestaurantId: "r1",
+			Tables:       tables,
+			Response:     response,
 		}
 
 		select {
@@ -53,16 +77,17 @@ func TestEventProcessor_Initialize(t *testing.T) {
 
 		processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, logger)
 
-		mockTableRepo.EXPECT().InitializeTables(10).Return(errors.New("already initialized")).Times(1)
+		mockTableRepo.EXPECT().InitializeTables("r1", tables).Return(errors.New("already initialized")).Times(1)
 
 		go processor.ProcessRequests()
 
 		response := make(chan interface{}, 1)
 		*requests <- model.EventRequest{
-			Id:        "req-2",
-			Action:    "initialize",
-			NumTables: 10,
-			Response:  response,
+			Id:           "req-2",
+			Action:       "initialize",
+			RestaurantId: "r1",
+			Tables:       tables,
+			Response:     response,
 		}
 
 		select {
@@ -75,6 +100,14 @@ func TestEventProcessor_Initialize(t *testing.T) {
 }
 
 func TestEventProcessor_Reserve(t *testing.T) {
+	startTime := time.Date(2025, 1, 10, 19, 0, 0, 0, time.UTC)
+	duration := 2 * time.Hour
+	freeTables := []model.Table{
+		{Id: "T1", Capacity: 2, MinPartySize: 1, CombinableWith: []string{"T2", "T3"}},
+		{Id: "T2", Capacity: 4, MinPartySize: 1, CombinableWith: []string{"T1", "T3"}},
+		{Id: "T3", Capacity: 6, MinPartySize: 3, CombinableWith: []string{"T1", "T2"}},
+	}
+
 	t.Run("Success", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -84,25 +117,101 @@ func TestEventProcessor_Reserve(t *testing.T) {
 		logger := zap.NewNop()
 
 		processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, logger)
+		guest := model.Guest{Name: "Alex Tan", Phone: "+66812345678"}
+
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockTableRepo.EXPECT().FreeTables("r1", startTime, startTime.Add(duration)).Return(freeTables).Times(1)
+		mockReservationRepo.EXPECT().CreateReservation(model.Reservation{RestaurantId: "r1", PartySize: 3, Guest: guest, NumTables: 1, TableIds: []string{"T2"}, StartTime: startTime, Duration: duration, Status: model.ReservationConfirmed}).Return(&model.Reservation{Id: "res-1", RestaurantId: "r1", PartySize: 3, NumTables: 1, TableIds: []string{"T2"}, StartTime: startTime, Duration: duration, Status: model.ReservationConfirmed}).Times(1)
+		mockTableRepo.EXPECT().ReserveTables(model.Reservation{Id: "res-1", RestaurantId: "r1", PartySize: 3, NumTables: 1, TableIds: []string{"T2"}, StartTime: startTime, Duration: duration, Status: model.ReservationConfirmed}).Return(nil).Times(1)
+
+		go processor.ProcessRequests()
+
+		response := make(chan interface{}, 1)
+		*requests <- model.EventRequest{
+			Id:           "req-3",
+			Action:       "reserve",
+			RestaurantId: "r1",
+			PartySize:    3,
+			Guest:        guest,
+			StartTime:    startTime,
+			Duration:     duration,
+			Response:     response,
+		}
+
+		select {
+		case res := <-response:
+			assert.Equal(t, "res-1", res.(model.Reservation).Id)
+			assert.Equal(t, []string{"T2"}, res.(model.Reservation).TableIds)
+		case <-time.After(1 * time.Second):
+			t.Fatal("timeout waiting for response")
+		}
+	})
+	t.Run("CombineTables", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockTableRepo := mockRepository.NewMockTableRepository(ctrl)
+		mockReservationRepo := mockRepository.NewMockReservationRepository(ctrl)
+		logger := zap.NewNop()
+
+		processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, logger)
+
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockTableRepo.EXPECT().FreeTables("r1", startTime, startTime.Add(duration)).Return(freeTables).Times(1)
+		mockReservationRepo.EXPECT().CreateReservation(model.Reservation{RestaurantId: "r1", PartySize: 9, NumTables: 2, TableIds: []string{"T3", "T2"}, StartTime: startTime, Duration: duration, Status: model.ReservationConfirmed}).Return(&model.Reservation{Id: "res-1", RestaurantId: "r1", PartySize: 9, NumTables: 2, TableIds: []string{"T3", "T2"}, StartTime: startTime, Duration: duration, Status: model.ReservationConfirmed}).Times(1)
+		mockTableRepo.EXPECT().ReserveTables(gomock.Any()).Return(nil).Times(1)
 
-		mockTableRepo.EXPECT().IsTableInitialized().Return(true).Times(1)
-		mockTableRepo.EXPECT().AvailableTables().Return(5).Times(1)
-		mockReservationRepo.EXPECT().CreateReservation(3).Return(&model.Reservation{Id: "res-1", NumTables: 3}).Times(1)
-		mockTableRepo.EXPECT().ReserveTables(model.Reservation{Id: "res-1", NumTables: 3}).Return(nil).Times(1)
+		go processor.ProcessRequests()
+
+		response := make(chan interface{}, 1)
+		*requests <- model.EventRequest{
+			Id:           "req-3",
+			Action:       "reserve",
+			RestaurantId: "r1",
+			PartySize:    9,
+			StartTime:    startTime,
+			Duration:     duration,
+			Response:     response,
+		}
+
+		select {
+		case res := <-response:
+			assert.Equal(t, 2, res.(model.Reservation).NumTables)
+		case <-time.After(1 * time.Second):
+			t.Fatal("timeout waiting for response")
+		}
+	})
+	t.Run("WithAllocator", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockTableRepo := mockRepository.NewMockTableRepository(ctrl)
+		mockReservationRepo := mockRepository.NewMockReservationRepository(ctrl)
+		logger := zap.NewNop()
+
+		processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, logger, event.WithAllocator(&service.BinPackingAllocator{}))
+
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockTableRepo.EXPECT().FreeTables("r1", startTime, startTime.Add(duration)).Return(freeTables).Times(1)
+		mockReservationRepo.EXPECT().CreateReservation(model.Reservation{RestaurantId: "r1", PartySize: 8, NumTables: 2, TableIds: []string{"T3", "T1"}, StartTime: startTime, Duration: duration, Status: model.ReservationConfirmed}).Return(&model.Reservation{Id: "res-1", RestaurantId: "r1", PartySize: 8, NumTables: 2, TableIds: []string{"T3", "T1"}, StartTime: startTime, Duration: duration, Status: model.ReservationConfirmed}).Times(1)
+		mockTableRepo.EXPECT().ReserveTables(gomock.Any()).Return(nil).Times(1)
 
 		go processor.ProcessRequests()
 
 		response := make(chan interface{}, 1)
 		*requests <- model.EventRequest{
-			Id:        "req-3",
-			Action:    "reserve",
-			NumTables: 3,
-			Response:  response,
+			Id:           "req-3",
+			Action:       "reserve",
+			RestaurantId: "r1",
+			PartySize:    8,
+			StartTime:    startTime,
+			Duration:     duration,
+			Response:     response,
 		}
 
 		select {
 		case res := <-response:
-			assert.Equal(t, "res-1", res)
+			assert.Equal(t, []string{"T3", "T1"}, res.(model.Reservation).TableIds)
 		case <-time.After(1 * time.Second):
 			t.Fatal("timeout waiting for response")
 		}
@@ -117,16 +226,19 @@ func TestEventProcessor_Reserve(t *testing.T) {
 
 		processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, logger)
 
-		mockTableRepo.EXPECT().IsTableInitialized().Return(false).Times(1)
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(false).Times(1)
 
 		go processor.ProcessRequests()
 
 		response := make(chan interface{}, 1)
 		*requests <- model.EventRequest{
-			Id:        "req-4",
-			Action:    "reserve",
-			NumTables: 3,
-			Response:  response,
+			Id:           "req-4",
+			Action:       "reserve",
+			RestaurantId: "r1",
+			PartySize:    3,
+			StartTime:    startTime,
+			Duration:     duration,
+			Response:     response,
 		}
 
 		select {
@@ -136,7 +248,38 @@ func TestEventProcessor_Reserve(t *testing.T) {
 			t.Fatal("timeout waiting for response")
 		}
 	})
-	t.Run("InvalidNumberOfTable", func(t *testing.T) {
+	t.Run("InvalidPartySize", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockTableRepo := mockRepository.NewMockTableRepository(ctrl)
+		mockReservationRepo := mockRepository.NewMockReservationRepository(ctrl)
+		logger := zap.NewNop()
+
+		processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, logger)
+
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		go processor.ProcessRequests()
+
+		response := make(chan interface{}, 1)
+		*requests <- model.EventRequest{
+			Id:           "req-4",
+			Action:       "reserve",
+			RestaurantId: "r1",
+			PartySize:    0,
+			StartTime:    startTime,
+			Duration:     duration,
+			Response:     response,
+		}
+
+		select {
+		case res := <-response:
+			assert.EqualError(t, res.(error), "invalid party size")
+		case <-time.After(1 * time.Second):
+			t.Fatal("timeout waiting for response")
+		}
+	})
+	t.Run("InvalidTimeSlot", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
@@ -146,20 +289,21 @@ func TestEventProcessor_Reserve(t *testing.T) {
 
 		processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, logger)
 
-		mockTableRepo.EXPECT().IsTableInitialized().Return(true).Times(1)
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
 		go processor.ProcessRequests()
 
 		response := make(chan interface{}, 1)
 		*requests <- model.EventRequest{
-			Id:        "req-4",
-			Action:    "reserve",
-			NumTables: 0,
-			Response:  response,
+			Id:           "req-4",
+			Action:       "reserve",
+			RestaurantId: "r1",
+			PartySize:    1,
+			Response:     response,
 		}
 
 		select {
 		case res := <-response:
-			assert.EqualError(t, res.(error), "invalid number of tables")
+			assert.EqualError(t, res.(error), "invalid reservation time slot")
 		case <-time.After(1 * time.Second):
 			t.Fatal("timeout waiting for response")
 		}
@@ -174,16 +318,19 @@ func TestEventProcessor_Reserve(t *testing.T) {
 
 		processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, logger)
 
-		mockTableRepo.EXPECT().IsTableInitialized().Return(true).Times(1)
-		mockTableRepo.EXPECT().AvailableTables().Return(5).Times(1)
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockTableRepo.EXPECT().FreeTables("r1", startTime, startTime.Add(duration)).Return(freeTables).Times(1)
 		go processor.ProcessRequests()
 
 		response := make(chan interface{}, 1)
 		*requests <- model.EventRequest{
-			Id:        "req-3",
-			Action:    "reserve",
-			NumTables: 6,
-			Response:  response,
+			Id:           "req-3",
+			Action:       "reserve",
+			RestaurantId: "r1",
+			PartySize:    13,
+			StartTime:    startTime,
+			Duration:     duration,
+			Response:     response,
 		}
 
 		select {
@@ -203,19 +350,22 @@ func TestEventProcessor_Reserve(t *testing.T) {
 
 		processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, logger)
 
-		mockTableRepo.EXPECT().IsTableInitialized().Return(true).Times(1)
-		mockTableRepo.EXPECT().AvailableTables().Return(5).Times(1)
-		mockReservationRepo.EXPECT().CreateReservation(3).Return(&model.Reservation{Id: "res-1", NumTables: 3}).Times(1)
-		mockTableRepo.EXPECT().ReserveTables(model.Reservation{Id: "res-1", NumTables: 3}).Return(errors.New("something went wrong")).Times(1)
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockTableRepo.EXPECT().FreeTables("r1", startTime, startTime.Add(duration)).Return(freeTables).Times(1)
+		mockReservationRepo.EXPECT().CreateReservation(model.Reservation{RestaurantId: "r1", PartySize: 3, NumTables: 1, TableIds: []string{"T2"}, StartTime: startTime, Duration: duration, Status: model.ReservationConfirmed}).Return(&model.Reservation{Id: "res-1", RestaurantId: "r1", PartySize: 3, NumTables: 1, TableIds: []string{"T2"}, StartTime: startTime, Duration: duration, Status: model.ReservationConfirmed}).Times(1)
+		mockTableRepo.EXPECT().ReserveTables(model.Reservation{Id: "res-1", RestaurantId: "r1", PartySize: 3, NumTables: 1, TableIds: []string{"T2"}, StartTime: startTime, Duration: duration, Status: model.ReservationConfirmed}).Return(errors.New("something went wrong")).Times(1)
 
 		go processor.ProcessRequests()
 
 		response := make(chan interface{}, 1)
 		*requests <- model.EventRequest{
-			Id:        "req-3",
-			Action:    "reserve",
-			NumTables: 3,
-			Response:  response,
+			Id:           "req-3",
+			Action:       "reserve",
+			RestaurantId: "r1",
+			PartySize:    3,
+			StartTime:    startTime,
+			Duration:     duration,
+			Response:     response,
 		}
 
 		select {
@@ -227,6 +377,175 @@ func TestEventProcessor_Reserve(t *testing.T) {
 	})
 }
 
+func TestEventProcessor_Modify(t *testing.T) {
+	startTime := time.Date(2025, 1, 10, 19, 0, 0, 0, time.UTC)
+	duration := 2 * time.Hour
+	tables := []model.Table{
+		{Id: "T1", Capacity: 4, MinPartySize: 1, CombinableWith: []string{"T2"}},
+		{Id: "T2", Capacity: 4, MinPartySize: 1, CombinableWith: []string{"T1"}},
+		{Id: "T3", Capacity: 2, MinPartySize: 1},
+	}
+	original := model.Reservation{Id: "res-1", RestaurantId: "r1", PartySize: 4, NumTables: 1, TableIds: []string{"T1"}, StartTime: startTime, Duration: duration, Status: model.ReservationConfirmed}
+
+	t.Run("Grow", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockTableRepo := mockRepository.NewMockTableRepository(ctrl)
+		mockReservationRepo := mockRepository.NewMockReservationRepository(ctrl)
+		logger := zap.NewNop()
+
+		processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, logger)
+
+		found := original
+		grown := original
+		grown.PartySize = 7
+		grown.NumTables = 2
+		grown.TableIds = []string{"T1", "T2"}
+
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockReservationRepo.EXPECT().FindReservationById("r1", "res-1").Return(&found, nil).Times(1)
+		mockTableRepo.EXPECT().FreeTables("r1", startTime, startTime.Add(duration)).Return([]model.Table{tables[1], tables[2]}).Times(1)
+		mockTableRepo.EXPECT().Tables("r1").Return(tables).Times(1)
+		mockTableRepo.EXPECT().CancelReservedTable("r1", "res-1").Return(nil).Times(1)
+		mockTableRepo.EXPECT().ReserveTables(grown).Return(nil).Times(1)
+		mockReservationRepo.EXPECT().UpdateReservation(grown).Return(nil).Times(1)
+
+		go processor.ProcessRequests()
+
+		response := make(chan interface{}, 1)
+		*requests <- model.EventRequest{
+			Id:           "req-17",
+			Action:       "modify",
+			RestaurantId: "r1",
+			ResID:        "res-1",
+			PartySize:    7,
+			Response:     response,
+		}
+
+		select {
+		case res := <-response:
+			assert.Equal(t, grown, res.(model.Reservation))
+		case <-time.After(1 * time.Second):
+			t.Fatal("timeout waiting for response")
+		}
+	})
+	t.Run("NotEnoughTables", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockTableRepo := mockRepository.NewMockTableRepository(ctrl)
+		mockReservationRepo := mockRepository.NewMockReservationRepository(ctrl)
+		logger := zap.NewNop()
+
+		processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, logger)
+
+		// T2 is taken by another party, so the original tables are never released
+		found := original
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockReservationRepo.EXPECT().FindReservationById("r1", "res-1").Return(&found, nil).Times(1)
+		mockTableRepo.EXPECT().FreeTables("r1", startTime, startTime.Add(duration)).Return([]model.Table{tables[2]}).Times(1)
+		mockTableRepo.EXPECT().Tables("r1").Return(tables).Times(1)
+
+		go processor.ProcessRequests()
+
+		response := make(chan interface{}, 1)
+		*requests <- model.EventRequest{
+			Id:           "req-18",
+			Action:       "modify",
+			RestaurantId: "r1",
+			ResID:        "res-1",
+			PartySize:    7,
+			Response:     response,
+		}
+
+		select {
+		case res := <-response:
+			assert.EqualError(t, res.(error), service.ErrNotEnoughTables.Error())
+		case <-time.After(1 * time.Second):
+			t.Fatal("timeout waiting for response")
+		}
+	})
+	t.Run("RestoreOnFailure", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockTableRepo := mockRepository.NewMockTableRepository(ctrl)
+		mockReservationRepo := mockRepository.NewMockReservationRepository(ctrl)
+		logger := zap.NewNop()
+
+		processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, logger)
+
+		found := original
+		shrunk := original
+		shrunk.PartySize = 2
+		shrunk.TableIds = []string{"T3"}
+
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockReservationRepo.EXPECT().FindReservationById("r1", "res-1").Return(&found, nil).Times(1)
+		mockTableRepo.EXPECT().FreeTables("r1", startTime, startTime.Add(duration)).Return([]model.Table{tables[2]}).Times(1)
+		mockTableRepo.EXPECT().Tables("r1").Return(tables).Times(1)
+		gomock.InOrder(
+			mockTableRepo.EXPECT().CancelReservedTable("r1", "res-1").Return(nil),
+			mockTableRepo.EXPECT().ReserveTables(shrunk).Return(errors.New("table T3 is already reserved")),
+			mockTableRepo.EXPECT().ReserveTables(original).Return(nil),
+		)
+
+		go processor.ProcessRequests()
+
+		response := make(chan interface{}, 1)
+		*requests <- model.EventRequest{
+			Id:           "req-19",
+			Action:       "modify",
+			RestaurantId: "r1",
+			ResID:        "res-1",
+			PartySize:    2,
+			Response:     response,
+		}
+
+		select {
+		case res := <-response:
+			assert.EqualError(t, res.(error), "table T3 is already reserved")
+		case <-time.After(1 * time.Second):
+			t.Fatal("timeout waiting for response")
+		}
+	})
+	t.Run("FinalStatus", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockTableRepo := mockRepository.NewMockTableRepository(ctrl)
+		mockReservationRepo := mockRepository.NewMockReservationRepository(ctrl)
+		logger := zap.NewNop()
+
+		processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, logger)
+
+		found := original
+		found.Status = model.ReservationCancelled
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockReservationRepo.EXPECT().FindReservationById("r1", "res-1").Return(&found, nil).Times(1)
+
+		go processor.ProcessRequests()
+
+		response := make(chan interface{}, 1)
+		*requests <- model.EventRequest{
+			Id:           "req-20",
+			Action:       "modify",
+			RestaurantId: "r1",
+			ResID:        "res-1",
+			PartySize:    2,
+			Response:     response,
+		}
+
+		select {
+		case res := <-response:
+			assert.EqualError(t, res.(error), "cannot modify a cancelled reservation")
+		case <-time.After(1 * time.Second):
+			t.Fatal("timeout waiting for response")
+		}
+	})
+}
+
 func TestEventProcessor_Cancel(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
@@ -236,26 +555,28 @@ func TestEventProcessor_Cancel(t *testing.T) {
 		mockReservationRepo := mockRepository.NewMockReservationRepository(ctrl)
 		logger := zap.NewNop()
 
-		processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, logger)
+		processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, logger, event.WithClock(&fakeClock{now: time.Date(2025, 1, 9, 12, 0, 0, 0, time.UTC)}))
 
-		mockTableRepo.EXPECT().IsTableInitialized().Return(true).Times(1)
-		mockReservationRepo.EXPECT().FindReservationById("res-1").Return(&model.Reservation{Id: "res-1", NumTables: 3}, nil).Times(1)
-		mockTableRepo.EXPECT().CancelReservedTable("res-1").Return(nil).Times(1)
-		mockReservationRepo.EXPECT().CancelReservation("res-1").Return(nil).Times(1)
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockReservationRepo.EXPECT().FindReservationById("r1", "res-1").Return(&model.Reservation{Id: "res-1", NumTables: 3, Status: model.ReservationConfirmed}, nil).Times(1)
+		mockTableRepo.EXPECT().CancelReservedTable("r1", "res-1").Return(nil).Times(1)
+		mockReservationRepo.EXPECT().UpdateReservation(model.Reservation{Id: "res-1", NumTables: 3, Status: model.ReservationCancelled, Cancellation: &model.Cancellation{CancelledAt: time.Date(2025, 1, 9, 12, 0, 0, 0, time.UTC)}}).Return(nil).Times(1)
 
 		go processor.ProcessRequests()
 
 		response := make(chan interface{}, 1)
 		*requests <- model.EventRequest{
-			Id:       "req-5",
-			Action:   "cancel",
-			ResID:    "res-1",
-			Response: response,
+			Id:           "req-5",
+			Action:       "cancel",
+			RestaurantId: "r1",
+			ResID:        "res-1",
+			Response:     response,
 		}
 
 		select {
 		case res := <-response:
-			assert.Equal(t, 3, res)
+			assert.Equal(t, 3, res.(model.Reservation).NumTables)
+			assert.Equal(t, model.ReservationCancelled, res.(model.Reservation).Status)
 		case <-time.After(1 * time.Second):
 			t.Fatal("timeout waiting for response")
 		}
@@ -270,15 +591,16 @@ func TestEventProcessor_Cancel(t *testing.T) {
 
 		processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, logger)
 
-		mockTableRepo.EXPECT().IsTableInitialized().Return(false).Times(1)
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(false).Times(1)
 		go processor.ProcessRequests()
 
 		response := make(chan interface{}, 1)
 		*requests <- model.EventRequest{
-			Id:       "req-5",
-			Action:   "cancel",
-			ResID:    "res-1",
-			Response: response,
+			Id:           "req-5",
+			Action:       "cancel",
+			RestaurantId: "r1",
+			ResID:        "res-1",
+			Response:     response,
 		}
 
 		select {
@@ -298,17 +620,18 @@ func TestEventProcessor_Cancel(t *testing.T) {
 
 		processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, logger)
 
-		mockTableRepo.EXPECT().IsTableInitialized().Return(true).Times(1)
-		mockReservationRepo.EXPECT().FindReservationById("res-1").Return(nil, errors.New("not found")).Times(1)
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockReservationRepo.EXPECT().FindReservationById("r1", "res-1").Return(nil, errors.New("not found")).Times(1)
 
 		go processor.ProcessRequests()
 
 		response := make(chan interface{}, 1)
 		*requests <- model.EventRequest{
-			Id:       "req-5",
-			Action:   "cancel",
-			ResID:    "res-1",
-			Response: response,
+			Id:           "req-5",
+			Action:       "cancel",
+			RestaurantId: "r1",
+			ResID:        "res-1",
+			Response:     response,
 		}
 
 		select {
@@ -328,18 +651,19 @@ func TestEventProcessor_Cancel(t *testing.T) {
 
 		processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, logger)
 
-		mockTableRepo.EXPECT().IsTableInitialized().Return(true).Times(1)
-		mockReservationRepo.EXPECT().FindReservationById("res-1").Return(&model.Reservation{Id: "res-1", NumTables: 3}, nil).Times(1)
-		mockTableRepo.EXPECT().CancelReservedTable("res-1").Return(errors.New("something went wrong")).Times(1)
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockReservationRepo.EXPECT().FindReservationById("r1", "res-1").Return(&model.Reservation{Id: "res-1", NumTables: 3, Status: model.ReservationConfirmed}, nil).Times(1)
+		mockTableRepo.EXPECT().CancelReservedTable("r1", "res-1").Return(errors.New("something went wrong")).Times(1)
 
 		go processor.ProcessRequests()
 
 		response := make(chan interface{}, 1)
 		*requests <- model.EventRequest{
-			Id:       "req-5",
-			Action:   "cancel",
-			ResID:    "res-1",
-			Response: response,
+			Id:           "req-5",
+			Action:       "cancel",
+			RestaurantId: "r1",
+			ResID:        "res-1",
+			Response:     response,
 		}
 
 		select {
@@ -349,7 +673,7 @@ func TestEventProcessor_Cancel(t *testing.T) {
 			t.Fatal("timeout waiting for response")
 		}
 	})
-	t.Run("CancelReservationFailed", func(t *testing.T) {
+	t.Run("AlreadyCancelled", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
@@ -359,19 +683,51 @@ func TestEventProcessor_Cancel(t *testing.T) {
 
 		processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, logger)
 
-		mockTableRepo.EXPECT().IsTableInitialized().Return(true).Times(1)
-		mockReservationRepo.EXPECT().FindReservationById("res-1").Return(&model.Reservation{Id: "res-1", NumTables: 3}, nil).Times(1)
-		mockTableRepo.EXPECT().CancelReservedTable("res-1").Return(nil).Times(1)
-		mockReservationRepo.EXPECT().CancelReservation("res-1").Return(errors.New("something went wrong")).Times(1)
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockReservationRepo.EXPECT().FindReservationById("r1", "res-1").Return(&model.Reservation{Id: "res-1", NumTables: 3, Status: model.ReservationCancelled}, nil).Times(1)
+
+		go processor.ProcessRequests()
+
+		response := make(chan interface{}, 1)
+		*requests <- model.EventRequest{
+			Id:           "req-5",
+			Action:       "cancel",
+			RestaurantId: "r1",
+			ResID:        "res-1",
+			Response:     response,
+		}
+
+		select {
+		case res := <-response:
+			assert.EqualError(t, res.(error), "cannot change reservation from cancelled to cancelled")
+		case <-time.After(1 * time.Second):
+			t.Fatal("timeout waiting for response")
+		}
+	})
+	t.Run("UpdateReservationFailed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockTableRepo := mockRepository.NewMockTableRepository(ctrl)
+		mockReservationRepo := mockRepository.NewMockReservationRepository(ctrl)
+		logger := zap.NewNop()
+
+		processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, logger, event.WithClock(&fakeClock{now: time.Date(2025, 1, 9, 12, 0, 0, 0, time.UTC)}))
+
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockReservationRepo.EXPECT().FindReservationById("r1", "res-1").Return(&model.Reservation{Id: "res-1", NumTables: 3, Status: model.ReservationConfirmed}, nil).Times(1)
+		mockTableRepo.EXPECT().CancelReservedTable("r1", "res-1").Return(nil).Times(1)
+		mockReservationRepo.EXPECT().UpdateReservation(model.Reservation{Id: "res-1", NumTables: 3, Status: model.ReservationCancelled, Cancellation: &model.Cancellation{CancelledAt: time.Date(2025, 1, 9, 12, 0, 0, 0, time.UTC)}}).Return(errors.New("something went wrong")).Times(1)
 
 		go processor.ProcessRequests()
 
 		response := make(chan interface{}, 1)
 		*requests <- model.EventRequest{
-			Id:       "req-5",
-			Action:   "cancel",
-			ResID:    "res-1",
-			Response: response,
+			Id:           "req-5",
+			Action:       "cancel",
+			RestaurantId: "r1",
+			ResID:        "res-1",
+			Response:     response,
 		}
 
 		select {
@@ -383,29 +739,2548 @@ func TestEventProcessor_Cancel(t *testing.T) {
 	})
 }
 
-func TestEventProcessor_InvalidAction(t *testing.T) {
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
+func TestEventProcessor_Transition(t *testing.T) {
+	t.Run("Seat", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
 
-	mockTableRepo := mockRepository.NewMockTableRepository(ctrl)
-	mockReservationRepo := mockRepository.NewMockReservationRepository(ctrl)
-	logger := zap.NewNop()
+		mockTableRepo := mockRepository.NewMockTableRepository(ctrl)
+		mockReservationRepo := mockRepository.NewMockReservationRepository(ctrl)
+		logger := zap.NewNop()
 
-	processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, logger)
+		processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, logger)
 
-	go processor.ProcessRequests()
+		// Seating keeps the tables held
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockReservationRepo.EXPECT().FindReservationById("r1", "res-1").Return(&model.Reservation{Id: "res-1", Status: model.ReservationConfirmed}, nil).Times(1)
+		mockTableRepo.EXPECT().ReserveTables(model.Reservation{Id: "res-1", Status: model.ReservationSeated}).Return(nil).Times(1)
+		mockReservationRepo.EXPECT().UpdateReservation(model.Reservation{Id: "res-1", Status: model.ReservationSeated}).Return(nil).Times(1)
 
-	response := make(chan interface{}, 1)
-	*requests <- model.EventRequest{
-		Id:       "req-6",
-		Action:   "unknown",
-		Response: response,
-	}
+		go processor.ProcessRequests()
 
-	select {
-	case <-response:
-		t.Fatal("unexpected response for invalid action")
-	case <-time.After(1 * time.Second):
-		// Expected timeout for invalid action
-	}
+		response := make(chan interface{}, 1)
+		*requests <- model.EventRequest{
+			Id:           "req-7",
+			Action:       "seat",
+			RestaurantId: "r1",
+			ResID:        "res-1",
+			Response:     response,
+		}
+
+		select {
+		case res := <-response:
+			assert.Equal(t, model.ReservationSeated, res.(model.Reservation).Status)
+		case <-time.After(1 * time.Second):
+			t.Fatal("timeout waiting for response")
+		}
+	})
+	t.Run("Complete", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockTableRepo := mockRepository.NewMockTableRepository(ctrl)
+		mockReservationRepo := mockRepository.NewMockReservationRepository(ctrl)
+		logger := zap.NewNop()
+
+		processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, logger)
+
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockReservationRepo.EXPECT().FindReservationById("r1", "res-1").Return(&model.Reservation{Id: "res-1", Status: model.ReservationSeated}, nil).Times(1)
+		mockTableRepo.EXPECT().CancelReservedTable("r1", "res-1").Return(nil).Times(1)
+		mockReservationRepo.EXPECT().UpdateReservation(model.Reservation{Id: "res-1", Status: model.ReservationCompleted}).Return(nil).Times(1)
+
+		go processor.ProcessRequests()
+
+		response := make(chan interface{}, 1)
+		*requests <- model.EventRequest{
+			Id:           "req-8",
+			Action:       "complete",
+			RestaurantId: "r1",
+			ResID:        "res-1",
+			Response:     response,
+		}
+
+		select {
+		case res := <-response:
+			assert.Equal(t, model.ReservationCompleted, res.(model.Reservation).Status)
+		case <-time.After(1 * time.Second):
+			t.Fatal("timeout waiting for response")
+		}
+	})
+	t.Run("NoShow", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockTableRepo := mockRepository.NewMockTableRepository(ctrl)
+		mockReservationRepo := mockRepository.NewMockReservationRepository(ctrl)
+		logger := zap.NewNop()
+
+		processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, logger)
+
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockReservationRepo.EXPECT().FindReservationById("r1", "res-1").Return(&model.Reservation{Id: "res-1", Status: model.ReservationConfirmed}, nil).Times(1)
+		mockTableRepo.EXPECT().CancelReservedTable("r1", "res-1").Return(nil).Times(1)
+		mockReservationRepo.EXPECT().UpdateReservation(model.Reservation{Id: "res-1", Status: model.ReservationNoShow}).Return(nil).Times(1)
+
+		go processor.ProcessRequests()
+
+		response := make(chan interface{}, 1)
+		*requests <- model.EventRequest{
+			Id:           "req-9",
+			Action:       "no_show",
+			RestaurantId: "r1",
+			ResID:        "res-1",
+			Response:     response,
+		}
+
+		select {
+		case res := <-response:
+			assert.Equal(t, model.ReservationNoShow, res.(model.Reservation).Status)
+		case <-time.After(1 * time.Second):
+			t.Fatal("timeout waiting for response")
+		}
+	})
+	t.Run("InvalidTransition", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockTableRepo := mockRepository.NewMockTableRepository(ctrl)
+		mockReservationRepo := mockRepository.NewMockReservationRepository(ctrl)
+		logger := zap.NewNop()
+
+		processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, logger)
+
+		// A party that has not been seated cannot complete
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockReservationRepo.EXPECT().FindReservationById("r1", "res-1").Return(&model.Reservation{Id: "res-1", Status: model.ReservationConfirmed}, nil).Times(1)
+
+		go processor.ProcessRequests()
+
+		response := make(chan interface{}, 1)
+		*requests <- model.EventRequest{
+			Id:           "req-10",
+			Action:       "complete",
+			RestaurantId: "r1",
+			ResID:        "res-1",
+			Response:     response,
+		}
+
+		select {
+		case res := <-response:
+			assert.EqualError(t, res.(error), "cannot change reservation from confirmed to completed")
+		case <-time.After(1 * time.Second):
+			t.Fatal("timeout waiting for response")
+		}
+	})
+}
+
+func TestEventProcessor_Hold(t *testing.T) {
+	startTime := time.Date(2025, 1, 10, 19, 0, 0, 0, time.UTC)
+	duration := 2 * time.Hour
+	now := time.Date(2025, 1, 9, 12, 0, 0, 0, time.UTC)
+	freeTables := []model.Table{{Id: "T1", Capacity: 4, MinPartySize: 1}}
+	guest := model.Guest{Name: "Alex Tan", Phone: "+66812345678"}
+
+	t.Run("Hold", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockTableRepo := mockRepository.NewMockTableRepository(ctrl)
+		mockReservationRepo := mockRepository.NewMockReservationRepository(ctrl)
+		logger := zap.NewNop()
+		clock := &fakeClock{now: now}
+
+		processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, logger, event.WithClock(clock), event.WithHoldTTL(5*time.Minute))
+
+		held := model.Reservation{RestaurantId: "r1", PartySize: 4, NumTables: 1, TableIds: []string{"T1"}, StartTime: startTime, Duration: duration, Status: model.ReservationHeld, HoldExpiresAt: now.Add(5 * time.Minute)}
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockTableRepo.EXPECT().FreeTables("r1", startTime, startTime.Add(duration)).Return(freeTables).Times(1)
+		mockReservationRepo.EXPECT().CreateReservation(held).DoAndReturn(func(reservation model.Reservation) *model.Reservation {
+			reservation.Id = "res-1"
+			return &reservation
+		}).Times(1)
+		held.Id = "res-1"
+		mockTableRepo.EXPECT().ReserveTables(held).Return(nil).Times(1)
+
+		go processor.ProcessRequests()
+
+		response := make(chan interface{}, 1)
+		*requests <- model.EventRequest{
+			Id:           "req-21",
+			Action:       "hold",
+			RestaurantId: "r1",
+			PartySize:    4,
+			StartTime:    startTime,
+			Duration:     duration,
+			Response:     response,
+		}
+
+		select {
+		case res := <-response:
+			assert.Equal(t, held, res.(model.Reservation))
+		case <-time.After(1 * time.Second):
+			t.Fatal("timeout waiting for response")
+		}
+	})
+	t.Run("Confirm", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockTableRepo := mockRepository.NewMockTableRepository(ctrl)
+		mockReservationRepo := mockRepository.NewMockReservationRepository(ctrl)
+		logger := zap.NewNop()
+
+		processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, logger, event.WithClock(&fakeClock{now: now}))
+
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockReservationRepo.EXPECT().FindReservationById("r1", "res-1").Return(&model.Reservation{Id: "res-1", Status: model.ReservationHeld, HoldExpiresAt: now.Add(time.Minute)}, nil).Times(1)
+		mockReservationRepo.EXPECT().UpdateReservation(model.Reservation{Id: "res-1", Guest: guest, Status: model.ReservationConfirmed}).Return(nil).Times(1)
+
+		go processor.ProcessRequests()
+
+		response := make(chan interface{}, 1)
+		*requests <- model.EventRequest{
+			Id:           "req-22",
+			Action:       "confirm",
+			RestaurantId: "r1",
+			ResID:        "res-1",
+			Guest:        guest,
+			Response:     response,
+		}
+
+		select {
+		case res := <-response:
+			assert.Equal(t, model.ReservationConfirmed, res.(model.Reservation).Status)
+			assert.Equal(t, guest, res.(model.Reservation).Guest)
+		case <-time.After(1 * time.Second):
+			t.Fatal("timeout waiting for response")
+		}
+	})
+	t.Run("ExpireBeforeConfirm", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockTableRepo := mockRepository.NewMockTableRepository(ctrl)
+		mockReservationRepo := mockRepository.NewMockReservationRepository(ctrl)
+		logger := zap.NewNop()
+		clock := &fakeClock{now: now}
+
+		processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, logger, event.WithClock(clock), event.WithHoldTTL(5*time.Minute))
+
+		held := model.Reservation{Id: "res-1", RestaurantId: "r1", PartySize: 4, NumTables: 1, TableIds: []string{"T1"}, StartTime: startTime, Duration: duration, Status: model.ReservationHeld, HoldExpiresAt: now.Add(5 * time.Minute)}
+		expired := held
+		expired.Status = model.ReservationExpired
+
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(2)
+		mockTableRepo.EXPECT().FreeTables("r1", startTime, startTime.Add(duration)).Return(freeTables).Times(1)
+		mockReservationRepo.EXPECT().CreateReservation(gomock.Any()).Return(&held).Times(1)
+		mockTableRepo.EXPECT().ReserveTables(held).Return(nil).Times(1)
+		gomock.InOrder(
+			mockReservationRepo.EXPECT().FindReservationById("r1", "res-1").Return(&model.Reservation{Id: "res-1", RestaurantId: "r1", PartySize: 4, NumTables: 1, TableIds: []string{"T1"}, StartTime: startTime, Duration: duration, Status: model.ReservationHeld, HoldExpiresAt: now.Add(5 * time.Minute)}, nil),
+			mockTableRepo.EXPECT().CancelReservedTable("r1", "res-1").Return(nil),
+			mockReservationRepo.EXPECT().UpdateReservation(expired).Return(nil),
+			mockReservationRepo.EXPECT().FindReservationById("r1", "res-1").Return(&expired, nil),
+		)
+
+		go processor.ProcessRequests()
+
+		response := make(chan interface{}, 1)
+		*requests <- model.EventRequest{Id: "req-23", Action: "hold", RestaurantId: "r1", PartySize: 4, StartTime: startTime, Duration: duration, Response: response}
+		select {
+		case <-response:
+		case <-time.After(1 * time.Second):
+			t.Fatal("timeout waiting for response")
+		}
+
+		// The guest takes longer than the hold TTL to fill in their details
+		clock.Advance(5 * time.Minute)
+
+		*requests <- model.EventRequest{Id: "req-24", Action: "confirm", RestaurantId: "r1", ResID: "res-1", Guest: guest, Response: response}
+		select {
+		case res := <-response:
+			assert.EqualError(t, res.(error), "hold has expired")
+		case <-time.After(1 * time.Second):
+			t.Fatal("timeout waiting for response")
+		}
+	})
+}
+
+func TestEventProcessor_Waitlist(t *testing.T) {
+	startTime := time.Date(2025, 1, 10, 19, 0, 0, 0, time.UTC)
+	duration := 2 * time.Hour
+	guest := model.Guest{Name: "Alex Tan", Phone: "+66812345678"}
+
+	t.Run("Join", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockTableRepo := mockRepository.NewMockTableRepository(ctrl)
+		mockReservationRepo := mockRepository.NewMockReservationRepository(ctrl)
+		mockWaitlistRepo := mockRepository.NewMockWaitlistRepository(ctrl)
+		logger := zap.NewNop()
+
+		processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, logger, event.WithWaitlist(mockWaitlistRepo), event.WithClock(&fakeClock{now: startTime.Add(-24 * time.Hour)}))
+
+		entry := model.WaitlistEntry{RestaurantId: "r1", PartySize: 4, Guest: guest, StartTime: startTime, Duration: duration, Status: model.WaitlistWaiting}
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockTableRepo.EXPECT().FreeTables("r1", startTime, startTime.Add(duration)).Return([]model.Table{}).Times(1)
+		mockWaitlistRepo.EXPECT().AddEntry(entry).DoAndReturn(func(entry model.WaitlistEntry) *model.WaitlistEntry {
+			entry.Id = "w-1"
+			return &entry
+		}).Times(1)
+
+		go processor.ProcessRequests()
+
+		response := make(chan interface{}, 1)
+		*requests <- model.EventRequest{
+			Id:           "req-11",
+			Action:       "join_waitlist",
+			RestaurantId: "r1",
+			PartySize:    4,
+			Guest:        guest,
+			StartTime:    startTime,
+			Duration:     duration,
+			Response:     response,
+		}
+
+		select {
+		case res := <-response:
+			assert.Equal(t, "w-1", res.(model.WaitlistEntry).Id)
+			assert.Equal(t, model.WaitlistWaiting, res.(model.WaitlistEntry).Status)
+		case <-time.After(1 * time.Second):
+			t.Fatal("timeout waiting for response")
+		}
+	})
+	t.Run("JoinWhenTablesAreAvailable", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockTableRepo := mockRepository.NewMockTableRepository(ctrl)
+		mockReservationRepo := mockRepository.NewMockReservationRepository(ctrl)
+		mockWaitlistRepo := mockRepository.NewMockWaitlistRepository(ctrl)
+		logger := zap.NewNop()
+
+		processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, logger, event.WithWaitlist(mockWaitlistRepo), event.WithClock(&fakeClock{now: startTime.Add(-24 * time.Hour)}))
+
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockTableRepo.EXPECT().FreeTables("r1", startTime, startTime.Add(duration)).Return([]model.Table{{Id: "T1", Capacity: 4, MinPartySize: 1}}).Times(1)
+
+		go processor.ProcessRequests()
+
+		response := make(chan interface{}, 1)
+		*requests <- model.EventRequest{
+			Id:           "req-12",
+			Action:       "join_waitlist",
+			RestaurantId: "r1",
+			PartySize:    4,
+			StartTime:    startTime,
+			Duration:     duration,
+			Response:     response,
+		}
+
+		select {
+		case res := <-response:
+			assert.EqualError(t, res.(error), "tables are available for this time slot")
+		case <-time.After(1 * time.Second):
+			t.Fatal("timeout waiting for response")
+		}
+	})
+	t.Run("NotEnabled", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockTableRepo := mockRepository.NewMockTableRepository(ctrl)
+		mockReservationRepo := mockRepository.NewMockReservationRepository(ctrl)
+		logger := zap.NewNop()
+
+		processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, logger)
+
+		go processor.ProcessRequests()
+
+		response := make(chan interface{}, 1)
+		*requests <- model.EventRequest{
+			Id:           "req-13",
+			Action:       "join_waitlist",
+			RestaurantId: "r1",
+			PartySize:    4,
+			StartTime:    startTime,
+			Duration:     duration,
+			Response:     response,
+		}
+
+		select {
+		case res := <-response:
+			assert.EqualError(t, res.(error), "waitlist is not enabled")
+		case <-time.After(1 * time.Second):
+			t.Fatal("timeout waiting for response")
+		}
+	})
+	t.Run("Leave", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockTableRepo := mockRepository.NewMockTableRepository(ctrl)
+		mockReservationRepo := mockRepository.NewMockReservationRepository(ctrl)
+		mockWaitlistRepo := mockRepository.NewMockWaitlistRepository(ctrl)
+		logger := zap.NewNop()
+
+		processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, logger, event.WithWaitlist(mockWaitlistRepo))
+
+		mockWaitlistRepo.EXPECT().FindEntryById("r1", "w-1").Return(&model.WaitlistEntry{Id: "w-1", RestaurantId: "r1", Status: model.WaitlistWaiting}, nil).Times(1)
+		mockWaitlistRepo.EXPECT().UpdateEntry(model.WaitlistEntry{Id: "w-1", RestaurantId: "r1", Status: model.WaitlistLeft}).Return(nil).Times(1)
+
+		go processor.ProcessRequests()
+
+		response := make(chan interface{}, 1)
+		*requests <- model.EventRequest{
+			Id:           "req-14",
+			Action:       "leave_waitlist",
+			RestaurantId: "r1",
+			WaitlistId:   "w-1",
+			Response:     response,
+		}
+
+		select {
+		case res := <-response:
+			assert.Equal(t, model.WaitlistLeft, res.(model.WaitlistEntry).Status)
+		case <-time.After(1 * time.Second):
+			t.Fatal("timeout waiting for response")
+		}
+	})
+	t.Run("LeaveAfterPromotion", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockTableRepo := mockRepository.NewMockTableRepository(ctrl)
+		mockReservationRepo := mockRepository.NewMockReservationRepository(ctrl)
+		mockWaitlistRepo := mockRepository.NewMockWaitlistRepository(ctrl)
+		logger := zap.NewNop()
+
+		processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, logger, event.WithWaitlist(mockWaitlistRepo))
+
+		mockWaitlistRepo.EXPECT().FindEntryById("r1", "w-1").Return(&model.WaitlistEntry{Id: "w-1", RestaurantId: "r1", Status: model.WaitlistPromoted}, nil).Times(1)
+
+		go processor.ProcessRequests()
+
+		response := make(chan interface{}, 1)
+		*requests <- model.EventRequest{
+			Id:           "req-15",
+			Action:       "leave_waitlist",
+			RestaurantId: "r1",
+			WaitlistId:   "w-1",
+			Response:     response,
+		}
+
+		select {
+		case res := <-response:
+			assert.EqualError(t, res.(error), "waitlist entry is already promoted")
+		case <-time.After(1 * time.Second):
+			t.Fatal("timeout waiting for response")
+		}
+	})
+	t.Run("PromoteOnCancel", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockTableRepo := mockRepository.NewMockTableRepository(ctrl)
+		mockReservationRepo := mockRepository.NewMockReservationRepository(ctrl)
+		mockWaitlistRepo := mockRepository.NewMockWaitlistRepository(ctrl)
+		logger := zap.NewNop()
+
+		processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, logger, event.WithWaitlist(mockWaitlistRepo), event.WithClock(&fakeClock{now: time.Date(2025, 1, 9, 12, 0, 0, 0, time.UTC)}))
+
+		// The party of 6 joined first but does not fit the freed 4-top, so the party of 4 behind it is booked.
+		freed := []model.Table{{Id: "T1", Capacity: 4, MinPartySize: 1}}
+		waiting := []model.WaitlistEntry{
+			{Id: "w-1", RestaurantId: "r1", PartySize: 6, StartTime: startTime, Duration: duration, Status: model.WaitlistWaiting},
+			{Id: "w-2", RestaurantId: "r1", PartySize: 4, Guest: guest, StartTime: startTime, Duration: duration, Status: model.WaitlistWaiting},
+		}
+		promoted := model.Reservation{RestaurantId: "r1", PartySize: 4, Guest: guest, NumTables: 1, TableIds: []string{"T1"}, StartTime: startTime, Duration: duration, Status: model.ReservationConfirmed}
+
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockReservationRepo.EXPECT().FindReservationById("r1", "res-1").Return(&model.Reservation{Id: "res-1", Status: model.ReservationConfirmed}, nil).Times(1)
+		mockTableRepo.EXPECT().CancelReservedTable("r1", "res-1").Return(nil).Times(1)
+		mockReservationRepo.EXPECT().UpdateReservation(model.Reservation{Id: "res-1", Status: model.ReservationCancelled, Cancellation: &model.Cancellation{CancelledAt: time.Date(2025, 1, 9, 12, 0, 0, 0, time.UTC)}}).Return(nil).Times(1)
+		mockWaitlistRepo.EXPECT().WaitingEntries("r1").Return(waiting).Times(1)
+		mockTableRepo.EXPECT().FreeTables("r1", startTime, startTime.Add(duration)).Return(freed).Times(2)
+		mockReservationRepo.EXPECT().CreateReservation(promoted).DoAndReturn(func(reservation model.Reservation) *model.Reservation {
+			reservation.Id = "res-2"
+			return &reservation
+		}).Times(1)
+		promoted.Id = "res-2"
+		mockTableRepo.EXPECT().ReserveTables(promoted).Return(nil).Times(1)
+		mockWaitlistRepo.EXPECT().UpdateEntry(model.WaitlistEntry{Id: "w-2", RestaurantId: "r1", PartySize: 4, Guest: guest, StartTime: startTime, Duration: duration, Status: model.WaitlistPromoted, ReservationId: "res-2"}).Return(nil).Times(1)
+
+		go processor.ProcessRequests()
+
+		response := make(chan interface{}, 1)
+		*requests <- model.EventRequest{
+			Id:           "req-16",
+			Action:       "cancel",
+			RestaurantId: "r1",
+			ResID:        "res-1",
+			Response:     response,
+		}
+
+		select {
+		case res := <-response:
+			assert.Equal(t, model.ReservationCancelled, res.(model.Reservation).Status)
+		case <-time.After(1 * time.Second):
+			t.Fatal("timeout waiting for response")
+		}
+	})
+	t.Run("ExpireStartedEntries", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockTableRepo := mockRepository.NewMockTableRepository(ctrl)
+		mockReservationRepo := mockRepository.NewMockReservationRepository(ctrl)
+		mockWaitlistRepo := mockRepository.NewMockWaitlistRepository(ctrl)
+		logger := zap.NewNop()
+
+		now := startTime.Add(30 * time.Minute)
+		processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, logger, event.WithWaitlist(mockWaitlistRepo), event.WithClock(&fakeClock{now: now}))
+
+		// A table freed after the party's slot started is too late for them, so they are not booked.
+		waiting := []model.WaitlistEntry{{Id: "w-1", RestaurantId: "r1", PartySize: 4, Guest: guest, StartTime: startTime, Duration: duration, Status: model.WaitlistWaiting}}
+		expired := waiting[0]
+		expired.Status = model.WaitlistExpired
+
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockReservationRepo.EXPECT().FindReservationById("r1", "res-1").Return(&model.Reservation{Id: "res-1", Status: model.ReservationConfirmed}, nil).Times(1)
+		mockTableRepo.EXPECT().CancelReservedTable("r1", "res-1").Return(nil).Times(1)
+		mockReservationRepo.EXPECT().UpdateReservation(model.Reservation{Id: "res-1", Status: model.ReservationCancelled, Cancellation: &model.Cancellation{CancelledAt: now}}).Return(nil).Times(1)
+		mockWaitlistRepo.EXPECT().WaitingEntries("r1").Return(waiting).Times(1)
+		mockWaitlistRepo.EXPECT().UpdateEntry(expired).Return(nil).Times(1)
+
+		go processor.ProcessRequests()
+
+		response := make(chan interface{}, 1)
+		*requests <- model.EventRequest{
+			Id:           "req-17",
+			Action:       "cancel",
+			RestaurantId: "r1",
+			ResID:        "res-1",
+			Response:     response,
+		}
+
+		select {
+		case res := <-response:
+			assert.Equal(t, model.ReservationCancelled, res.(model.Reservation).Status)
+		case <-time.After(1 * time.Second):
+			t.Fatal("timeout waiting for response")
+		}
+	})
+	t.Run("JoinStartedSlot", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockTableRepo := mockRepository.NewMockTableRepository(ctrl)
+		mockReservationRepo := mockRepository.NewMockReservationRepository(ctrl)
+		mockWaitlistRepo := mockRepository.NewMockWaitlistRepository(ctrl)
+		logger := zap.NewNop()
+
+		processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, logger, event.WithWaitlist(mockWaitlistRepo), event.WithClock(&fakeClock{now: startTime.Add(time.Minute)}))
+
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+
+		go processor.ProcessRequests()
+
+		response := make(chan interface{}, 1)
+		*requests <- model.EventRequest{
+			Id:           "req-18",
+			Action:       "join_waitlist",
+			RestaurantId: "r1",
+			PartySize:    4,
+			Guest:        guest,
+			StartTime:    startTime,
+			Duration:     duration,
+			Response:     response,
+		}
+
+		select {
+		case res := <-response:
+			assert.EqualError(t, res.(error), "cannot wait for a time slot that has already started")
+		case <-time.After(1 * time.Second):
+			t.Fatal("timeout waiting for response")
+		}
+	})
+	t.Run("ExpireWhenSlotStarts", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockTableRepo := mockRepository.NewMockTableRepository(ctrl)
+		mockReservationRepo := mockRepository.NewMockReservationRepository(ctrl)
+		mockWaitlistRepo := mockRepository.NewMockWaitlistRepository(ctrl)
+		logger := zap.NewNop()
+
+		clock := &fakeClock{now: startTime.Add(-time.Hour)}
+		processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, logger, event.WithWaitlist(mockWaitlistRepo), event.WithClock(clock))
+
+		entry := model.WaitlistEntry{Id: "w-1", RestaurantId: "r1", PartySize: 4, Guest: guest, StartTime: startTime, Duration: duration, Status: model.WaitlistWaiting}
+		expired := entry
+		expired.Status = model.WaitlistExpired
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockTableRepo.EXPECT().FreeTables("r1", startTime, startTime.Add(duration)).Return([]model.Table{}).Times(1)
+		mockWaitlistRepo.EXPECT().AddEntry(gomock.Any()).Return(&entry).Times(1)
+		// No table is released, yet the party stops waiting once its slot starts.
+		mockWaitlistRepo.EXPECT().FindEntryById("r1", "w-1").Return(&entry, nil).Times(1)
+		mockWaitlistRepo.EXPECT().UpdateEntry(expired).Return(nil).Times(1)
+		mockWaitlistRepo.EXPECT().FindEntryById("r1", "w-2").Return(nil, errors.New("waitlist entry not found")).Times(1)
+
+		go processor.ProcessRequests()
+
+		for _, req := range []model.EventRequest{
+			{Id: "req-19", Action: "join_waitlist", RestaurantId: "r1", PartySize: 4, Guest: guest, StartTime: startTime, Duration: duration},
+			{Id: "req-20", Action: "leave_waitlist", RestaurantId: "r1", WaitlistId: "w-2"},
+		} {
+			response := make(chan interface{}, 1)
+			req.Response = response
+			*requests <- req
+
+			select {
+			case <-response:
+			case <-time.After(1 * time.Second):
+				t.Fatal("timeout waiting for response")
+			}
+			clock.Advance(time.Hour)
+		}
+	})
+	t.Run("JoinOutsideBookingWindow", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockTableRepo := mockRepository.NewMockTableRepository(ctrl)
+		mockReservationRepo := mockRepository.NewMockReservationRepository(ctrl)
+		mockWaitlistRepo := mockRepository.NewMockWaitlistRepository(ctrl)
+		logger := zap.NewNop()
+
+		processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, logger, event.WithWaitlist(mockWaitlistRepo), event.WithClock(&fakeClock{now: startTime.Add(-time.Hour)}),
+			event.WithBookingWindow(model.ChannelPublic, model.BookingWindow{MinLeadTime: 2 * time.Hour}))
+
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+
+		go processor.ProcessRequests()
+
+		response := make(chan interface{}, 1)
+		*requests <- model.EventRequest{
+			Id:           "req-21",
+			Action:       "join_waitlist",
+			RestaurantId: "r1",
+			PartySize:    4,
+			Guest:        guest,
+			StartTime:    startTime,
+			Duration:     duration,
+			Channel:      model.ChannelPublic,
+			Response:     response,
+		}
+
+		select {
+		case res := <-response:
+			assert.ErrorIs(t, res.(error), model.ErrBookingTooSoon)
+		case <-time.After(1 * time.Second):
+			t.Fatal("timeout waiting for response")
+		}
+	})
+	t.Run("PromoteInsideBookingWindow", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockTableRepo := mockRepository.NewMockTableRepository(ctrl)
+		mockReservationRepo := mockRepository.NewMockReservationRepository(ctrl)
+		mockWaitlistRepo := mockRepository.NewMockWaitlistRepository(ctrl)
+		logger := zap.NewNop()
+
+		now := startTime.Add(-time.Hour)
+		processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, logger, event.WithWaitlist(mockWaitlistRepo), event.WithClock(&fakeClock{now: now}),
+			event.WithBookingWindow(model.ChannelPublic, model.BookingWindow{MinLeadTime: 2 * time.Hour}))
+
+		// The party joined from the public channel, which can no longer book an hour ahead.
+		waiting := []model.WaitlistEntry{{Id: "w-1", RestaurantId: "r1", PartySize: 4, Guest: guest, StartTime: startTime, Duration: duration, Status: model.WaitlistWaiting, Channel: model.ChannelPublic}}
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockReservationRepo.EXPECT().FindReservationById("r1", "res-1").Return(&model.Reservation{Id: "res-1", Status: model.ReservationConfirmed}, nil).Times(1)
+		mockTableRepo.EXPECT().CancelReservedTable("r1", "res-1").Return(nil).Times(1)
+		mockReservationRepo.EXPECT().UpdateReservation(model.Reservation{Id: "res-1", Status: model.ReservationCancelled, Cancellation: &model.Cancellation{CancelledAt: now}}).Return(nil).Times(1)
+		mockWaitlistRepo.EXPECT().WaitingEntries("r1").Return(waiting).Times(1)
+
+		go processor.ProcessRequests()
+
+		response := make(chan interface{}, 1)
+		*requests <- model.EventRequest{
+			Id:           "req-22",
+			Action:       "cancel",
+			RestaurantId: "r1",
+			ResID:        "res-1",
+			Response:     response,
+		}
+
+		select {
+		case res := <-response:
+			assert.Equal(t, model.ReservationCancelled, res.(model.Reservation).Status)
+		case <-time.After(1 * time.Second):
+			t.Fatal("timeout waiting for response")
+		}
+	})
+}
+
+func TestEventProcessor_InvalidAction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTableRepo := mockRepository.NewMockTableRepository(ctrl)
+	mockReservationRepo := mockRepository.NewMockReservationRepository(ctrl)
+	logger := zap.NewNop()
+
+	processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, logger)
+
+	go processor.ProcessRequests()
+
+	response := make(chan interface{}, 1)
+	*requests <- model.EventRequest{
+		Id:       "req-6",
+		Action:   "unknown",
+		Response: response,
+	}
+
+	select {
+	case <-response:
+		t.Fatal("unexpected response for invalid action")
+	case <-time.After(1 * time.Second):
+		// Expected timeout for invalid action
+	}
+}
+
+func TestEventProcessor_Schedule(t *testing.T) {
+	// 10 January 2025 is a Friday.
+	startTime := time.Date(2025, 1, 10, 19, 0, 0, 0, time.UTC)
+	duration := 2 * time.Hour
+	guest := model.Guest{Name: "Alex Tan", Phone: "+66812345678"}
+	freeTables := []model.Table{
+		{Id: "T1", Capacity: 2, MinPartySize: 1},
+		{Id: "T2", Capacity: 4, MinPartySize: 1},
+	}
+	schedule := &model.Schedule{
+		RestaurantId: "r1",
+		Shifts: []model.Shift{
+			{Name: "Lunch", Weekday: time.Friday, Opens: 11 * time.Hour, Closes: 14 * time.Hour},
+			{Name: "Dinner", Weekday: time.Friday, Opens: 17 * time.Hour, Closes: 22 * time.Hour, MaxTables: 2},
+		},
+		Closures: []model.Closure{{Date: "2025-01-17", Reason: "staff party"}},
+	}
+
+	reserve := func(requests *chan model.EventRequest, start time.Time) interface{} {
+		response := make(chan interface{}, 1)
+		*requests <- model.EventRequest{
+			Id:           "req-12",
+			Action:       "reserve",
+			RestaurantId: "r1",
+			PartySize:    2,
+			Guest:        guest,
+			StartTime:    start,
+			Duration:     duration,
+			Response:     response,
+		}
+
+		select {
+		case res := <-response:
+			return res
+		case <-time.After(1 * time.Second):
+			t.Fatal("timeout waiting for response")
+			return nil
+		}
+	}
+
+	t.Run("InsideShift", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockTableRepo := mockRepository.NewMockTableRepository(ctrl)
+		mockReservationRepo := mockRepository.NewMockReservationRepository(ctrl)
+		mockScheduleRepo := mockRepository.NewMockScheduleRepository(ctrl)
+		logger := zap.NewNop()
+
+		processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, logger, event.WithSchedule(mockScheduleRepo))
+
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockScheduleRepo.EXPECT().FindScheduleByRestaurantId("r1").Return(schedule, nil).Times(1)
+		mockTableRepo.EXPECT().FreeTables("r1", startTime, startTime.Add(duration)).Return(freeTables).Times(1)
+		mockTableRepo.EXPECT().TotalTables("r1").Return(3).Times(1)
+		mockReservationRepo.EXPECT().CreateReservation(gomock.Any()).DoAndReturn(func(reservation model.Reservation) *model.Reservation {
+			reservation.Id = "res-1"
+			return &reservation
+		}).Times(1)
+		mockTableRepo.EXPECT().ReserveTables(gomock.Any()).Return(nil).Times(1)
+
+		go processor.ProcessRequests()
+
+		res := reserve(requests, startTime)
+
+		assert.Equal(t, []string{"T1"}, res.(model.Reservation).TableIds)
+	})
+	t.Run("OutsideShift", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockTableRepo := mockRepository.NewMockTableRepository(ctrl)
+		mockReservationRepo := mockRepository.NewMockReservationRepository(ctrl)
+		mockScheduleRepo := mockRepository.NewMockScheduleRepository(ctrl)
+		logger := zap.NewNop()
+
+		processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, logger, event.WithSchedule(mockScheduleRepo))
+
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockScheduleRepo.EXPECT().FindScheduleByRestaurantId("r1").Return(schedule, nil).Times(1)
+
+		go processor.ProcessRequests()
+
+		// Dinner closes at 22:00, so a 21:00 booking runs past the end of the shift.
+		res := reserve(requests, startTime.Add(2*time.Hour))
+
+		assert.ErrorIs(t, res.(error), model.ErrRestaurantClosed)
+	})
+	t.Run("ClosureDay", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockTableRepo := mockRepository.NewMockTableRepository(ctrl)
+		mockReservationRepo := mockRepository.NewMockReservationRepository(ctrl)
+		mockScheduleRepo := mockRepository.NewMockScheduleRepository(ctrl)
+		logger := zap.NewNop()
+
+		processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, logger, event.WithSchedule(mockScheduleRepo))
+
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockScheduleRepo.EXPECT().FindScheduleByRestaurantId("r1").Return(schedule, nil).Times(1)
+
+		go processor.ProcessRequests()
+
+		res := reserve(requests, startTime.AddDate(0, 0, 7))
+
+		assert.EqualError(t, res.(error), "restaurant is closed at the requested time: staff party")
+	})
+	t.Run("ShiftCapacityReached", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockTableRepo := mockRepository.NewMockTableRepository(ctrl)
+		mockReservationRepo := mockRepository.NewMockReservationRepository(ctrl)
+		mockScheduleRepo := mockRepository.NewMockScheduleRepository(ctrl)
+		logger := zap.NewNop()
+
+		processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, logger, event.WithSchedule(mockScheduleRepo))
+
+		// Two of the four tables are already booked, which is the dinner shift's cap. The schedule is
+		// read again to look for an overbooking allowance, which the shift does not have.
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockScheduleRepo.EXPECT().FindScheduleByRestaurantId("r1").Return(schedule, nil).Times(2)
+		mockTableRepo.EXPECT().FreeTables("r1", startTime, startTime.Add(duration)).Return(freeTables).Times(1)
+		mockTableRepo.EXPECT().TotalTables("r1").Return(4).Times(1)
+
+		go processor.ProcessRequests()
+
+		res := reserve(requests, startTime)
+
+		assert.ErrorIs(t, res.(error), service.ErrNotEnoughTables)
+	})
+	t.Run("NoSchedule", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockTableRepo := mockRepository.NewMockTableRepository(ctrl)
+		mockReservationRepo := mockRepository.NewMockReservationRepository(ctrl)
+		mockScheduleRepo := mockRepository.NewMockScheduleRepository(ctrl)
+		logger := zap.NewNop()
+
+		processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, logger, event.WithSchedule(mockScheduleRepo))
+
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockScheduleRepo.EXPECT().FindScheduleByRestaurantId("r1").Return(nil, errors.New("schedule not found")).Times(1)
+		mockTableRepo.EXPECT().FreeTables("r1", startTime.Add(8*time.Hour), startTime.Add(8*time.Hour+duration)).Return(freeTables).Times(1)
+		mockReservationRepo.EXPECT().CreateReservation(gomock.Any()).DoAndReturn(func(reservation model.Reservation) *model.Reservation {
+			reservation.Id = "res-1"
+			return &reservation
+		}).Times(1)
+		mockTableRepo.EXPECT().ReserveTables(gomock.Any()).Return(nil).Times(1)
+
+		go processor.ProcessRequests()
+
+		res := reserve(requests, startTime.Add(8*time.Hour))
+
+		assert.Equal(t, "res-1", res.(model.Reservation).Id)
+	})
+	t.Run("WaitlistWhenClosed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockTableRepo := mockRepository.NewMockTableRepository(ctrl)
+		mockReservationRepo := mockRepository.NewMockReservationRepository(ctrl)
+		mockWaitlistRepo := mockRepository.NewMockWaitlistRepository(ctrl)
+		mockScheduleRepo := mockRepository.NewMockScheduleRepository(ctrl)
+		logger := zap.NewNop()
+
+		processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, logger, event.WithWaitlist(mockWaitlistRepo), event.WithSchedule(mockScheduleRepo), event.WithClock(&fakeClock{now: startTime.Add(-24 * time.Hour)}))
+
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockScheduleRepo.EXPECT().FindScheduleByRestaurantId("r1").Return(schedule, nil).Times(1)
+
+		go processor.ProcessRequests()
+
+		response := make(chan interface{}, 1)
+		*requests <- model.EventRequest{
+			Id:           "req-13",
+			Action:       "join_waitlist",
+			RestaurantId: "r1",
+			PartySize:    2,
+			Guest:        guest,
+			StartTime:    startTime.Add(-4 * time.Hour),
+			Duration:     duration,
+			Response:     response,
+		}
+
+		select {
+		case res := <-response:
+			assert.ErrorIs(t, res.(error), model.ErrRestaurantClosed)
+		case <-time.After(1 * time.Second):
+			t.Fatal("timeout waiting for response")
+		}
+	})
+}
+
+func TestEventProcessor_TurnTimes(t *testing.T) {
+	startTime := time.Date(2025, 1, 10, 19, 0, 0, 0, time.UTC)
+	guest := model.Guest{Name: "Alex Tan", Phone: "+66812345678"}
+	freeTables := []model.Table{{Id: "T1", Capacity: 4, MinPartySize: 1}}
+	turnTimes, _ := service.NewTurnTimes(map[int]time.Duration{1: 90 * time.Minute, 6: 150 * time.Minute}, 15*time.Minute)
+
+	reserve := func(requests *chan model.EventRequest, duration time.Duration) interface{} {
+		response := make(chan interface{}, 1)
+		*requests <- model.EventRequest{
+			Id:           "req-14",
+			Action:       "reserve",
+			RestaurantId: "r1",
+			PartySize:    2,
+			Guest:        guest,
+			StartTime:    startTime,
+			Duration:     duration,
+			Response:     response,
+		}
+
+		select {
+		case res := <-response:
+			return res
+		case <-time.After(1 * time.Second):
+			t.Fatal("timeout waiting for response")
+			return nil
+		}
+	}
+
+	t.Run("TurnTimeForPartySize", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockTableRepo := mockRepository.NewMockTableRepository(ctrl)
+		mockReservationRepo := mockRepository.NewMockReservationRepository(ctrl)
+		logger := zap.NewNop()
+
+		processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, logger, event.WithTurnTimes(turnTimes))
+
+		// The table must also be free for the 15 minute buffer either side of the 90 minute turn.
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockTableRepo.EXPECT().FreeTables("r1", startTime.Add(-15*time.Minute), startTime.Add(105*time.Minute)).Return(freeTables).Times(1)
+		mockReservationRepo.EXPECT().CreateReservation(gomock.Any()).DoAndReturn(func(reservation model.Reservation) *model.Reservation {
+			reservation.Id = "res-1"
+			return &reservation
+		}).Times(1)
+		mockTableRepo.EXPECT().ReserveTables(gomock.Any()).Return(nil).Times(1)
+
+		go processor.ProcessRequests()
+
+		res := reserve(requests, 0)
+
+		assert.Equal(t, 90*time.Minute, res.(model.Reservation).Duration)
+	})
+	t.Run("Override", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockTableRepo := mockRepository.NewMockTableRepository(ctrl)
+		mockReservationRepo := mockRepository.NewMockReservationRepository(ctrl)
+		logger := zap.NewNop()
+
+		processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, logger, event.WithTurnTimes(turnTimes))
+
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockTableRepo.EXPECT().FreeTables("r1", startTime.Add(-15*time.Minute), startTime.Add(3*time.Hour+15*time.Minute)).Return(freeTables).Times(1)
+		mockReservationRepo.EXPECT().CreateReservation(gomock.Any()).DoAndReturn(func(reservation model.Reservation) *model.Reservation {
+			reservation.Id = "res-1"
+			return &reservation
+		}).Times(1)
+		mockTableRepo.EXPECT().ReserveTables(gomock.Any()).Return(nil).Times(1)
+
+		go processor.ProcessRequests()
+
+		res := reserve(requests, 3*time.Hour)
+
+		assert.Equal(t, 3*time.Hour, res.(model.Reservation).Duration)
+	})
+}
+
+func TestEventProcessor_Availability(t *testing.T) {
+	startTime := time.Date(2025, 1, 10, 19, 0, 0, 0, time.UTC)
+	duration := 2 * time.Hour
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockTableRepo := mockRepository.NewMockTableRepository(ctrl)
+		mockReservationRepo := mockRepository.NewMockReservationRepository(ctrl)
+		logger := zap.NewNop()
+
+		processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, logger)
+
+		// Only the second slot has a table free; nothing is booked either way.
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockTableRepo.EXPECT().FreeTables("r1", startTime, startTime.Add(duration)).Return([]model.Table{}).Times(1)
+		mockTableRepo.EXPECT().FreeTables("r1", startTime.Add(time.Hour), startTime.Add(time.Hour+duration)).Return([]model.Table{{Id: "T1", Capacity: 4, MinPartySize: 1}}).Times(1)
+
+		go processor.ProcessRequests()
+
+		response := make(chan interface{}, 1)
+		*requests <- model.EventRequest{
+			Id:           "req-15",
+			Action:       "availability",
+			RestaurantId: "r1",
+			PartySize:    2,
+			Duration:     duration,
+			Slots:        []time.Time{startTime, startTime.Add(time.Hour)},
+			Response:     response,
+		}
+
+		select {
+		case res := <-response:
+			assert.Equal(t, []model.Slot{{StartTime: startTime.Add(time.Hour), EndTime: startTime.Add(time.Hour + duration), TableIds: []string{"T1"}}}, res)
+		case <-time.After(1 * time.Second):
+			t.Fatal("timeout waiting for response")
+		}
+	})
+	t.Run("NotInitialized", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockTableRepo := mockRepository.NewMockTableRepository(ctrl)
+		mockReservationRepo := mockRepository.NewMockReservationRepository(ctrl)
+		logger := zap.NewNop()
+
+		processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, logger)
+
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(false).Times(1)
+
+		go processor.ProcessRequests()
+
+		response := make(chan interface{}, 1)
+		*requests <- model.EventRequest{Id: "req-16", Action: "availability", RestaurantId: "r1", PartySize: 2, Slots: []time.Time{startTime}, Response: response}
+
+		select {
+		case res := <-response:
+			assert.EqualError(t, res.(error), "tables has not been initialized")
+		case <-time.After(1 * time.Second):
+			t.Fatal("timeout waiting for response")
+		}
+	})
+}
+
+func TestEventProcessor_WalkIn(t *testing.T) {
+	now := time.Date(2025, 1, 10, 19, 0, 0, 0, time.UTC)
+	duration := 90 * time.Minute
+	guest := model.Guest{Name: "Sam Lee"}
+
+	walkIn := func(requests *chan model.EventRequest) interface{} {
+		response := make(chan interface{}, 1)
+		*requests <- model.EventRequest{Id: "req-17", Action: "walk_in", RestaurantId: "r1", PartySize: 2, Guest: guest, Duration: duration, Response: response}
+
+		select {
+		case res := <-response:
+			return res
+		case <-time.After(1 * time.Second):
+			t.Fatal("timeout waiting for response")
+			return nil
+		}
+	}
+
+	t.Run("Seated", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockTableRepo := mockRepository.NewMockTableRepository(ctrl)
+		mockReservationRepo := mockRepository.NewMockReservationRepository(ctrl)
+		logger := zap.NewNop()
+
+		processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, logger, event.WithClock(&fakeClock{now: now}))
+
+		seated := model.Reservation{RestaurantId: "r1", PartySize: 2, Guest: guest, NumTables: 1, TableIds: []string{"T1"}, StartTime: now, Duration: duration, Status: model.ReservationSeated, WalkIn: true}
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockTableRepo.EXPECT().FreeTables("r1", now, now.Add(duration)).Return([]model.Table{{Id: "T1", Capacity: 2, MinPartySize: 1}}).Times(1)
+		mockTableRepo.EXPECT().ReservationsBetween("r1", time.Time{}, now).Return([]model.Reservation{}).Times(1)
+		mockReservationRepo.EXPECT().CreateReservation(seated).DoAndReturn(func(reservation model.Reservation) *model.Reservation {
+			reservation.Id = "res-1"
+			return &reservation
+		}).Times(1)
+		mockTableRepo.EXPECT().ReserveTables(gomock.Any()).Return(nil).Times(1)
+
+		go processor.ProcessRequests()
+
+		res := walkIn(requests)
+
+		assert.Equal(t, "res-1", res.(model.Reservation).Id)
+		assert.Equal(t, model.ReservationSeated, res.(model.Reservation).Status)
+		assert.True(t, res.(model.Reservation).WalkIn)
+	})
+	t.Run("EstimatedWait", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockTableRepo := mockRepository.NewMockTableRepository(ctrl)
+		mockReservationRepo := mockRepository.NewMockReservationRepository(ctrl)
+		logger := zap.NewNop()
+
+		processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, logger, event.WithClock(&fakeClock{now: now}))
+
+		// T1 frees up at 19:20 and T2 at 19:45, but T1 is booked again from 20:00.
+		releases := []model.Reservation{
+			{Id: "res-1", TableIds: []string{"T2"}, StartTime: now.Add(-75 * time.Minute), Duration: 2 * time.Hour},
+			{Id: "res-2", TableIds: []string{"T1"}, StartTime: now.Add(-100 * time.Minute), Duration: 2 * time.Hour},
+		}
+		t2 := model.Table{Id: "T2", Capacity: 2, MinPartySize: 1}
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockTableRepo.EXPECT().FreeTables("r1", now, now.Add(duration)).Return([]model.Table{}).Times(1)
+		mockTableRepo.EXPECT().ReservationsBetween("r1", now, now.Add(4*time.Hour)).Return(releases).Times(1)
+		mockTableRepo.EXPECT().FreeTables("r1", now.Add(20*time.Minute), now.Add(20*time.Minute+duration)).Return([]model.Table{}).Times(1)
+		mockTableRepo.EXPECT().FreeTables("r1", now.Add(45*time.Minute), now.Add(45*time.Minute+duration)).Return([]model.Table{t2}).Times(1)
+		mockTableRepo.EXPECT().ReservationsBetween("r1", time.Time{}, now).Return([]model.Reservation{}).Times(1)
+
+		go processor.ProcessRequests()
+
+		res := walkIn(requests)
+
+		var waitErr *service.WaitError
+		assert.ErrorAs(t, res.(error), &waitErr)
+		assert.Equal(t, 45*time.Minute, waitErr.EstimatedWait)
+		assert.Equal(t, now.Add(45*time.Minute), waitErr.AvailableAt)
+	})
+	t.Run("NoRelease", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockTableRepo := mockRepository.NewMockTableRepository(ctrl)
+		mockReservationRepo := mockRepository.NewMockReservationRepository(ctrl)
+		logger := zap.NewNop()
+
+		processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, logger, event.WithClock(&fakeClock{now: now}))
+
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockTableRepo.EXPECT().FreeTables("r1", now, now.Add(duration)).Return([]model.Table{}).Times(1)
+		mockTableRepo.EXPECT().ReservationsBetween("r1", now, now.Add(4*time.Hour)).Return([]model.Reservation{}).Times(1)
+
+		go processor.ProcessRequests()
+
+		res := walkIn(requests)
+
+		assert.ErrorIs(t, res.(error), service.ErrNotEnoughTables)
+		var waitErr *service.WaitError
+		assert.False(t, errors.As(res.(error), &waitErr))
+	})
+	t.Run("PartySeatedPastItsEnd", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockTableRepo := mockRepository.NewMockTableRepository(ctrl)
+		mockReservationRepo := mockRepository.NewMockReservationRepository(ctrl)
+		logger := zap.NewNop()
+
+		processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, logger, event.WithClock(&fakeClock{now: now}))
+
+		// T1 was due back at 18:45 but its party has not been completed, so it is not free.
+		overstaying := model.Reservation{Id: "res-1", TableIds: []string{"T1"}, StartTime: now.Add(-2 * time.Hour), Duration: 105 * time.Minute, Status: model.ReservationSeated}
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockTableRepo.EXPECT().FreeTables("r1", now, now.Add(duration)).Return([]model.Table{{Id: "T1", Capacity: 2, MinPartySize: 1}}).Times(1)
+		mockTableRepo.EXPECT().ReservationsBetween("r1", time.Time{}, now).Return([]model.Reservation{overstaying}).Times(1)
+		mockTableRepo.EXPECT().ReservationsBetween("r1", now, now.Add(4*time.Hour)).Return([]model.Reservation{}).Times(1)
+
+		go processor.ProcessRequests()
+
+		res := walkIn(requests)
+
+		assert.ErrorIs(t, res.(error), service.ErrNotEnoughTables)
+	})
+}
+
+func TestEventProcessor_Seating(t *testing.T) {
+	start := time.Date(2025, 1, 10, 19, 0, 0, 0, time.UTC)
+	duration := 90 * time.Minute
+	patio := model.Table{Id: "P1", Capacity: 4, MinPartySize: 1, Section: model.SectionPatio}
+	window := model.Table{Id: "W1", Capacity: 4, MinPartySize: 1, Section: model.SectionMainRoom, Features: []string{model.FeatureWindow}}
+
+	reserve := func(requests *chan model.EventRequest, requirements model.Seating, preferences model.Seating) interface{} {
+		response := make(chan interface{}, 1)
+		*requests <- model.EventRequest{Id: "req-18", Action: "reserve", RestaurantId: "r1", PartySize: 2, StartTime: start, Duration: duration, Requirements: requirements, Preferences: preferences, Response: response}
+
+		select {
+		case res := <-response:
+			return res
+		case <-time.After(1 * time.Second):
+			t.Fatal("timeout waiting for response")
+			return nil
+		}
+	}
+
+	setup := func(t *testing.T) (*mockRepository.MockTableRepository, *mockRepository.MockReservationRepository, *chan model.EventRequest) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+
+		mockTableRepo := mockRepository.NewMockTableRepository(ctrl)
+		mockReservationRepo := mockRepository.NewMockReservationRepository(ctrl)
+		processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, zap.NewNop())
+		go processor.ProcessRequests()
+
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockTableRepo.EXPECT().FreeTables("r1", start, start.Add(duration)).Return([]model.Table{patio, window}).Times(1)
+		return mockTableRepo, mockReservationRepo, requests
+	}
+
+	t.Run("RequirementMet", func(t *testing.T) {
+		mockTableRepo, mockReservationRepo, requests := setup(t)
+		mockReservationRepo.EXPECT().CreateReservation(gomock.Any()).DoAndReturn(func(reservation model.Reservation) *model.Reservation {
+			reservation.Id = "res-1"
+			return &reservation
+		}).Times(1)
+		mockTableRepo.EXPECT().ReserveTables(gomock.Any()).Return(nil).Times(1)
+
+		res := reserve(requests, model.Seating{Features: []string{model.FeatureWindow}}, model.Seating{})
+
+		assert.Equal(t, []string{"W1"}, res.(model.Reservation).TableIds)
+	})
+	t.Run("RequirementNotMet", func(t *testing.T) {
+		_, _, requests := setup(t)
+
+		res := reserve(requests, model.Seating{Section: model.SectionBar}, model.Seating{})
+
+		assert.Equal(t, service.ErrNotEnoughTables, res)
+	})
+	t.Run("PreferenceMet", func(t *testing.T) {
+		mockTableRepo, mockReservationRepo, requests := setup(t)
+		mockReservationRepo.EXPECT().CreateReservation(gomock.Any()).DoAndReturn(func(reservation model.Reservation) *model.Reservation {
+			reservation.Id = "res-1"
+			return &reservation
+		}).Times(1)
+		mockTableRepo.EXPECT().ReserveTables(gomock.Any()).Return(nil).Times(1)
+
+		res := reserve(requests, model.Seating{}, model.Seating{Section: model.SectionPatio})
+
+		assert.Equal(t, []string{"P1"}, res.(model.Reservation).TableIds)
+		assert.Nil(t, res.(model.Reservation).UnmetPreferences)
+	})
+	t.Run("PreferenceNotMet", func(t *testing.T) {
+		mockTableRepo, mockReservationRepo, requests := setup(t)
+		mockReservationRepo.EXPECT().CreateReservation(gomock.Any()).DoAndReturn(func(reservation model.Reservation) *model.Reservation {
+			reservation.Id = "res-1"
+			return &reservation
+		}).Times(1)
+		mockTableRepo.EXPECT().ReserveTables(gomock.Any()).Return(nil).Times(1)
+
+		res := reserve(requests, model.Seating{Section: model.SectionPatio}, model.Seating{Features: []string{model.FeatureWindow, model.FeatureHighChair}})
+
+		assert.Equal(t, []string{"P1"}, res.(model.Reservation).TableIds)
+		assert.Equal(t, []string{model.FeatureWindow, model.FeatureHighChair}, res.(model.Reservation).UnmetPreferences)
+	})
+}
+
+func TestEventProcessor_Sizing(t *testing.T) {
+	start := time.Date(2025, 1, 10, 19, 0, 0, 0, time.UTC)
+	duration := 90 * time.Minute
+	free := []model.Table{
+		{Id: "T1", Capacity: 2, MinPartySize: 1, CombinableWith: []string{"T2"}},
+		{Id: "T2", Capacity: 2, MinPartySize: 1, CombinableWith: []string{"T1", "T3"}},
+		{Id: "T3", Capacity: 2, MinPartySize: 1, CombinableWith: []string{"T2"}},
+	}
+
+	reserve := func(requests *chan model.EventRequest, partySize int) interface{} {
+		response := make(chan interface{}, 1)
+		*requests <- model.EventRequest{Id: "req-19", Action: "reserve", RestaurantId: "r1", PartySize: partySize, StartTime: start, Duration: duration, Response: response}
+
+		select {
+		case res := <-response:
+			return res
+		case <-time.After(1 * time.Second):
+			t.Fatal("timeout waiting for response")
+			return nil
+		}
+	}
+
+	setup := func(t *testing.T, sizing service.SizingPolicy) (*mockRepository.MockTableRepository, *mockRepository.MockReservationRepository, *chan model.EventRequest) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+
+		mockTableRepo := mockRepository.NewMockTableRepository(ctrl)
+		mockReservationRepo := mockRepository.NewMockReservationRepository(ctrl)
+		processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, zap.NewNop(), event.WithSizing(sizing))
+		go processor.ProcessRequests()
+
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		return mockTableRepo, mockReservationRepo, requests
+	}
+
+	t.Run("WithinMaxTables", func(t *testing.T) {
+		mockTableRepo, mockReservationRepo, requests := setup(t, &service.FixedSizing{SeatsPerTable: 2})
+		mockTableRepo.EXPECT().FreeTables("r1", start, start.Add(duration)).Return(free).Times(1)
+		mockReservationRepo.EXPECT().CreateReservation(gomock.Any()).DoAndReturn(func(reservation model.Reservation) *model.Reservation {
+			reservation.Id = "res-1"
+			return &reservation
+		}).Times(1)
+		mockTableRepo.EXPECT().ReserveTables(gomock.Any()).Return(nil).Times(1)
+
+		res := reserve(requests, 6)
+
+		assert.Equal(t, 3, res.(model.Reservation).NumTables)
+	})
+	t.Run("ExceedsMaxTables", func(t *testing.T) {
+		mockTableRepo, _, requests := setup(t, &service.LookupSizing{Rules: []service.PartyTablesRule{{MinPartySize: 1, MaxTables: 1}, {MinPartySize: 5, MaxTables: 2}}})
+		mockTableRepo.EXPECT().FreeTables("r1", start, start.Add(duration)).Return(free).Times(1)
+
+		res := reserve(requests, 6)
+
+		assert.Equal(t, service.ErrNotEnoughTables, res)
+	})
+	t.Run("ExceedsMaxPartySize", func(t *testing.T) {
+		_, _, requests := setup(t, service.PartySizeLimits{Min: 1, Max: 4})
+
+		res := reserve(requests, 6)
+
+		assert.EqualError(t, res.(error), "party size 6 exceeds the maximum of 4")
+	})
+	t.Run("BelowMinPartySize", func(t *testing.T) {
+		_, _, requests := setup(t, service.PartySizeLimits{Min: 2})
+
+		res := reserve(requests, 1)
+
+		assert.EqualError(t, res.(error), "party size 1 is below the minimum of 2")
+	})
+}
+
+func TestEventProcessor_Resize(t *testing.T) {
+	now := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+	dinner := time.Date(2025, 1, 10, 19, 0, 0, 0, time.UTC)
+	tables := []model.Table{
+		{Id: "T1", Capacity: 4, MinPartySize: 1, CombinableWith: []string{"T2"}},
+		{Id: "T2", Capacity: 4, MinPartySize: 1, CombinableWith: []string{"T1"}},
+	}
+
+	resize := func(requests *chan model.EventRequest, tables []model.Table) interface{} {
+		response := make(chan interface{}, 1)
+		*requests <- model.EventRequest{Id: "req-20", Action: "resize", RestaurantId: "r1", Tables: tables, Response: response}
+
+		select {
+		case res := <-response:
+			return res
+		case <-time.After(1 * time.Second):
+			t.Fatal("timeout waiting for response")
+			return nil
+		}
+	}
+
+	setup := func(t *testing.T) (*mockRepository.MockTableRepository, *mockRepository.MockWaitlistRepository, *chan model.EventRequest) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+
+		mockTableRepo := mockRepository.NewMockTableRepository(ctrl)
+		mockReservationRepo := mockRepository.NewMockReservationRepository(ctrl)
+		mockWaitlistRepo := mockRepository.NewMockWaitlistRepository(ctrl)
+		processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, zap.NewNop(), event.WithWaitlist(mockWaitlistRepo), event.WithClock(&fakeClock{now: now}))
+		go processor.ProcessRequests()
+
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		return mockTableRepo, mockWaitlistRepo, requests
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		mockTableRepo, mockWaitlistRepo, requests := setup(t)
+		grown := append(append([]model.Table{}, tables...), model.Table{Id: "T3", Capacity: 2, MinPartySize: 1})
+		mockTableRepo.EXPECT().ReservationsBetween("r1", now, gomock.Any()).Return([]model.Reservation{
+			{Id: "res-1", PartySize: 6, TableIds: []string{"T1", "T2"}, StartTime: dinner, Duration: 2 * time.Hour},
+		}).Times(1)
+		mockTableRepo.EXPECT().ReplaceTables("r1", grown).Return(nil).Times(1)
+		mockWaitlistRepo.EXPECT().WaitingEntries("r1").Return(nil).Times(1)
+
+		res := resize(requests, grown)
+
+		assert.Nil(t, res)
+	})
+	t.Run("RemovedTableReserved", func(t *testing.T) {
+		mockTableRepo, _, requests := setup(t)
+		conflict := model.Reservation{Id: "res-1", PartySize: 2, TableIds: []string{"T2"}, StartTime: dinner, Duration: 2 * time.Hour}
+		mockTableRepo.EXPECT().ReservationsBetween("r1", now, gomock.Any()).Return([]model.Reservation{
+			{Id: "res-2", PartySize: 2, TableIds: []string{"T1"}, StartTime: dinner, Duration: 2 * time.Hour},
+			conflict,
+		}).Times(1)
+
+		res := resize(requests, tables[:1])
+
+		assert.Equal(t, &service.InventoryConflictError{Reservations: []model.Reservation{conflict}}, res)
+	})
+	t.Run("TablesNoLongerSeatParty", func(t *testing.T) {
+		mockTableRepo, _, requests := setup(t)
+		conflict := model.Reservation{Id: "res-1", PartySize: 4, TableIds: []string{"T1"}, StartTime: dinner, Duration: 2 * time.Hour}
+		mockTableRepo.EXPECT().ReservationsBetween("r1", now, gomock.Any()).Return([]model.Reservation{conflict}).Times(1)
+
+		res := resize(requests, []model.Table{{Id: "T1", Capacity: 2, MinPartySize: 1}, tables[1]})
+
+		assert.Equal(t, &service.InventoryConflictError{Reservations: []model.Reservation{conflict}}, res)
+	})
+}
+
+func TestEventProcessor_Blocks(t *testing.T) {
+	now := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+	dinner := time.Date(2025, 1, 10, 19, 0, 0, 0, time.UTC)
+	duration := 90 * time.Minute
+	tables := []model.Table{{Id: "T1", Capacity: 4, MinPartySize: 1}, {Id: "T2", Capacity: 4, MinPartySize: 1}}
+
+	send := func(requests *chan model.EventRequest, req model.EventRequest) interface{} {
+		response := make(chan interface{}, 1)
+		req.Id = "req-21"
+		req.RestaurantId = "r1"
+		req.Response = response
+		*requests <- req
+
+		select {
+		case res := <-response:
+			return res
+		case <-time.After(1 * time.Second):
+			t.Fatal("timeout waiting for response")
+			return nil
+		}
+	}
+
+	setup := func(t *testing.T) (*mockRepository.MockTableRepository, *mockRepository.MockReservationRepository, *mockRepository.MockBlockRepository, *mockRepository.MockWaitlistRepository, *chan model.EventRequest) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+
+		mockTableRepo := mockRepository.NewMockTableRepository(ctrl)
+		mockReservationRepo := mockRepository.NewMockReservationRepository(ctrl)
+		mockBlockRepo := mockRepository.NewMockBlockRepository(ctrl)
+		mockWaitlistRepo := mockRepository.NewMockWaitlistRepository(ctrl)
+		processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, zap.NewNop(), event.WithBlocks(mockBlockRepo), event.WithWaitlist(mockWaitlistRepo), event.WithClock(&fakeClock{now: now}))
+		go processor.ProcessRequests()
+
+		return mockTableRepo, mockReservationRepo, mockBlockRepo, mockWaitlistRepo, requests
+	}
+
+	t.Run("BlockedTableNotAllocated", func(t *testing.T) {
+		mockTableRepo, mockReservationRepo, mockBlockRepo, _, requests := setup(t)
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockTableRepo.EXPECT().FreeTables("r1", dinner, dinner.Add(duration)).Return(tables).Times(1)
+		mockBlockRepo.EXPECT().BlocksBetween("r1", dinner, dinner.Add(duration)).Return([]model.TableBlock{{Id: "blk-1", TableIds: []string{"T1"}, StartTime: now}}).Times(1)
+		mockReservationRepo.EXPECT().CreateReservation(gomock.Any()).DoAndReturn(func(reservation model.Reservation) *model.Reservation {
+			reservation.Id = "res-1"
+			return &reservation
+		}).Times(1)
+		mockTableRepo.EXPECT().ReserveTables(gomock.Any()).Return(nil).Times(1)
+
+		res := send(requests, model.EventRequest{Action: "reserve", PartySize: 2, StartTime: dinner, Duration: duration})
+
+		assert.Equal(t, []string{"T2"}, res.(model.Reservation).TableIds)
+	})
+	t.Run("Block", func(t *testing.T) {
+		mockTableRepo, _, mockBlockRepo, _, requests := setup(t)
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockTableRepo.EXPECT().Tables("r1").Return(tables).Times(1)
+		mockTableRepo.EXPECT().ReservationsBetween("r1", now, gomock.Any()).Return(nil).Times(1)
+		mockBlockRepo.EXPECT().AddBlock(model.TableBlock{RestaurantId: "r1", TableIds: []string{"T1"}, StartTime: now, Reason: "broken chair"}).DoAndReturn(func(block model.TableBlock) *model.TableBlock {
+			block.Id = "blk-1"
+			return &block
+		}).Times(1)
+
+		res := send(requests, model.EventRequest{Action: "block", Block: model.TableBlock{TableIds: []string{"T1"}, Reason: "broken chair"}})
+
+		assert.Equal(t, "blk-1", res.(model.TableBlock).Id)
+		assert.Equal(t, now, res.(model.TableBlock).StartTime)
+	})
+	t.Run("BlockConflict", func(t *testing.T) {
+		mockTableRepo, _, _, _, requests := setup(t)
+		conflict := model.Reservation{Id: "res-1", PartySize: 2, TableIds: []string{"T1"}, StartTime: dinner, Duration: duration}
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockTableRepo.EXPECT().Tables("r1").Return(tables).Times(1)
+		mockTableRepo.EXPECT().ReservationsBetween("r1", dinner, dinner.Add(time.Hour)).Return([]model.Reservation{
+			conflict,
+			{Id: "res-2", PartySize: 2, TableIds: []string{"T2"}, StartTime: dinner, Duration: duration},
+		}).Times(1)
+
+		res := send(requests, model.EventRequest{Action: "block", Block: model.TableBlock{TableIds: []string{"T1"}, StartTime: dinner, EndTime: dinner.Add(time.Hour)}})
+
+		assert.Equal(t, &service.InventoryConflictError{Reservations: []model.Reservation{conflict}}, res)
+	})
+	t.Run("BlockInvalid", func(t *testing.T) {
+		mockTableRepo, _, _, _, requests := setup(t)
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(2)
+		mockTableRepo.EXPECT().Tables("r1").Return(tables).Times(1)
+
+		res := send(requests, model.EventRequest{Action: "block", Block: model.TableBlock{TableIds: []string{"T9"}}})
+		assert.EqualError(t, res.(error), "table T9 not found")
+
+		res = send(requests, model.EventRequest{Action: "block", Block: model.TableBlock{TableIds: []string{"T1"}, StartTime: dinner, EndTime: dinner}})
+		assert.EqualError(t, res.(error), "block end time must be after its start time")
+	})
+	t.Run("Unblock", func(t *testing.T) {
+		_, _, mockBlockRepo, mockWaitlistRepo, requests := setup(t)
+		mockBlockRepo.EXPECT().RemoveBlock("r1", "blk-1").Return(&model.TableBlock{Id: "blk-1", TableIds: []string{"T1"}}, nil).Times(1)
+		mockWaitlistRepo.EXPECT().WaitingEntries("r1").Return(nil).Times(1)
+
+		res := send(requests, model.EventRequest{Action: "unblock", Block: model.TableBlock{Id: "blk-1"}})
+
+		assert.Equal(t, "blk-1", res.(model.TableBlock).Id)
+	})
+	t.Run("NotEnabled", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		processor, requests := event.NewProcessor(mockRepository.NewMockTableRepository(ctrl), mockRepository.NewMockReservationRepository(ctrl), zap.NewNop())
+		go processor.ProcessRequests()
+
+		res := send(requests, model.EventRequest{Action: "unblock", Block: model.TableBlock{Id: "blk-1"}})
+
+		assert.EqualError(t, res.(error), "table blocks are not enabled")
+	})
+}
+
+func TestEventProcessor_Event(t *testing.T) {
+	start := time.Date(2025, 1, 10, 18, 0, 0, 0, time.UTC)
+	duration := 4 * time.Hour
+	guest := model.Guest{Name: "Sam Lee", Phone: "+66812345678"}
+	tables := []model.Table{
+		{Id: "T1", Capacity: 4, MinPartySize: 1, Section: model.SectionMainRoom},
+		{Id: "P1", Capacity: 6, MinPartySize: 1, Section: model.SectionPrivateRoom},
+		{Id: "P2", Capacity: 6, MinPartySize: 1, Section: model.SectionPrivateRoom},
+	}
+
+	send := func(requests *chan model.EventRequest, req model.EventRequest) interface{} {
+		response := make(chan interface{}, 1)
+		req.Id = "req-22"
+		req.RestaurantId = "r1"
+		req.Response = response
+		*requests <- req
+
+		select {
+		case res := <-response:
+			return res
+		case <-time.After(1 * time.Second):
+			t.Fatal("timeout waiting for response")
+			return nil
+		}
+	}
+
+	setup := func(t *testing.T) (*mockRepository.MockTableRepository, *mockRepository.MockReservationRepository, *chan model.EventRequest) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+
+		mockTableRepo := mockRepository.NewMockTableRepository(ctrl)
+		mockReservationRepo := mockRepository.NewMockReservationRepository(ctrl)
+		processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, zap.NewNop(), event.WithSizing(service.PartySizeLimits{Max: 8}))
+		go processor.ProcessRequests()
+
+		return mockTableRepo, mockReservationRepo, requests
+	}
+
+	t.Run("BookSection", func(t *testing.T) {
+		mockTableRepo, mockReservationRepo, requests := setup(t)
+		booked := model.Reservation{RestaurantId: "r1", PartySize: 30, Guest: guest, NumTables: 2, TableIds: []string{"P1", "P2"}, StartTime: start, Duration: duration, Status: model.ReservationConfirmed, Requirements: model.Seating{Section: model.SectionPrivateRoom}, Type: model.ReservationTypeEvent, DepositStatus: model.DepositPending}
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockTableRepo.EXPECT().Tables("r1").Return(tables).Times(1)
+		mockTableRepo.EXPECT().ReservationsBetween("r1", start, start.Add(duration)).Return([]model.Reservation{{Id: "res-9", TableIds: []string{"T1"}, StartTime: start, Duration: time.Hour}}).Times(1)
+		mockReservationRepo.EXPECT().CreateReservation(booked).DoAndReturn(func(reservation model.Reservation) *model.Reservation {
+			reservation.Id = "res-1"
+			return &reservation
+		}).Times(1)
+		mockTableRepo.EXPECT().ReserveTables(gomock.Any()).Return(nil).Times(1)
+
+		res := send(requests, model.EventRequest{Action: "book_event", PartySize: 30, Guest: guest, StartTime: start, Duration: duration, Requirements: model.Seating{Section: model.SectionPrivateRoom}, DepositStatus: model.DepositPending})
+
+		assert.Equal(t, "res-1", res.(model.Reservation).Id)
+		assert.True(t, res.(model.Reservation).IsEvent())
+	})
+	t.Run("BookVenueConflict", func(t *testing.T) {
+		mockTableRepo, _, requests := setup(t)
+		conflict := model.Reservation{Id: "res-9", TableIds: []string{"T1"}, StartTime: start, Duration: time.Hour}
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockTableRepo.EXPECT().Tables("r1").Return(tables).Times(1)
+		mockTableRepo.EXPECT().ReservationsBetween("r1", start, start.Add(duration)).Return([]model.Reservation{conflict}).Times(1)
+
+		res := send(requests, model.EventRequest{Action: "book_event", PartySize: 60, Guest: guest, StartTime: start, Duration: duration})
+
+		assert.Equal(t, &service.InventoryConflictError{Reservations: []model.Reservation{conflict}}, res)
+	})
+	t.Run("EmptySection", func(t *testing.T) {
+		mockTableRepo, _, requests := setup(t)
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(2)
+		mockTableRepo.EXPECT().Tables("r1").Return(tables).Times(1)
+
+		res := send(requests, model.EventRequest{Action: "book_event", PartySize: 20, StartTime: start, Duration: duration, Requirements: model.Seating{Section: model.SectionPatio}})
+		assert.EqualError(t, res.(error), "section patio has no tables")
+
+		res = send(requests, model.EventRequest{Action: "book_event", PartySize: 20, StartTime: start, Duration: duration, Requirements: model.Seating{Section: "rooftop"}})
+		assert.EqualError(t, res.(error), "unknown section rooftop")
+	})
+	t.Run("ModifyHeadcount", func(t *testing.T) {
+		mockTableRepo, mockReservationRepo, requests := setup(t)
+		original := model.Reservation{Id: "res-1", RestaurantId: "r1", PartySize: 30, TableIds: []string{"P1", "P2"}, StartTime: start, Duration: duration, Status: model.ReservationConfirmed, Type: model.ReservationTypeEvent}
+		modified := original
+		modified.PartySize = 45
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockReservationRepo.EXPECT().FindReservationById("r1", "res-1").Return(&original, nil).Times(1)
+		mockReservationRepo.EXPECT().UpdateReservation(modified).Return(nil).Times(1)
+
+		res := send(requests, model.EventRequest{Action: "modify", ResID: "res-1", PartySize: 45})
+
+		assert.Equal(t, modified, res)
+	})
+	t.Run("UpdateDeposit", func(t *testing.T) {
+		_, mockReservationRepo, requests := setup(t)
+		mockReservationRepo.EXPECT().FindReservationById("r1", "res-1").Return(&model.Reservation{Id: "res-1", Status: model.ReservationConfirmed, Type: model.ReservationTypeEvent, DepositStatus: model.DepositPending}, nil).Times(1)
+		mockReservationRepo.EXPECT().UpdateReservation(model.Reservation{Id: "res-1", Status: model.ReservationConfirmed, Type: model.ReservationTypeEvent, DepositStatus: model.DepositPaid}).Return(nil).Times(1)
+
+		res := send(requests, model.EventRequest{Action: "update_deposit", ResID: "res-1", DepositStatus: model.DepositPaid})
+
+		assert.Equal(t, model.DepositPaid, res.(model.Reservation).DepositStatus)
+	})
+	t.Run("UpdateDepositNotEvent", func(t *testing.T) {
+		_, mockReservationRepo, requests := setup(t)
+		mockReservationRepo.EXPECT().FindReservationById("r1", "res-1").Return(&model.Reservation{Id: "res-1", Status: model.ReservationConfirmed}, nil).Times(1)
+
+		res := send(requests, model.EventRequest{Action: "update_deposit", ResID: "res-1", DepositStatus: model.DepositPaid})
+
+		assert.EqualError(t, res.(error), "deposits are only tracked on event bookings")
+	})
+}
+
+func TestEventProcessor_Series(t *testing.T) {
+	start := time.Date(2025, 1, 10, 19, 0, 0, 0, time.UTC)
+	next := start.AddDate(0, 0, 7)
+	duration := 2 * time.Hour
+	guest := model.Guest{Name: "Alex Tan", Phone: "+66812345678"}
+	tables := []model.Table{{Id: "T1", Capacity: 4, MinPartySize: 1}}
+
+	send := func(requests *chan model.EventRequest, req model.EventRequest) interface{} {
+		response := make(chan interface{}, 1)
+		req.Id = "req-23"
+		req.RestaurantId = "r1"
+		req.Response = response
+		*requests <- req
+
+		select {
+		case res := <-response:
+			return res
+		case <-time.After(1 * time.Second):
+			t.Fatal("timeout waiting for response")
+			return nil
+		}
+	}
+
+	setup := func(t *testing.T, opts ...event.Option) (*mockRepository.MockTableRepository, *mockRepository.MockReservationRepository, *chan model.EventRequest) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+
+		mockTableRepo := mockRepository.NewMockTableRepository(ctrl)
+		mockReservationRepo := mockRepository.NewMockReservationRepository(ctrl)
+		opts = append([]event.Option{event.WithClock(&fakeClock{now: start.Add(-time.Hour)})}, opts...)
+		processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, zap.NewNop(), opts...)
+		go processor.ProcessRequests()
+
+		return mockTableRepo, mockReservationRepo, requests
+	}
+
+	t.Run("ReserveSeries", func(t *testing.T) {
+		mockTableRepo, mockReservationRepo, requests := setup(t)
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockTableRepo.EXPECT().FreeTables("r1", start, start.Add(duration)).Return(tables).Times(1)
+		mockTableRepo.EXPECT().FreeTables("r1", next, next.Add(duration)).Return(tables).Times(1)
+		mockTableRepo.EXPECT().ReservationsBetween("r1", time.Time{}, start.Add(-time.Hour)).Return([]model.Reservation{}).Times(1)
+		for _, occurrence := range []time.Time{start, next} {
+			mockReservationRepo.EXPECT().CreateReservation(model.Reservation{RestaurantId: "r1", PartySize: 2, Guest: guest, NumTables: 1, TableIds: []string{"T1"}, StartTime: occurrence, Duration: duration, Status: model.ReservationConfirmed, SeriesId: "series-1"}).DoAndReturn(func(reservation model.Reservation) *model.Reservation {
+				reservation.Id = "res-" + reservation.StartTime.Format("0102")
+				return &reservation
+			}).Times(1)
+		}
+		mockTableRepo.EXPECT().ReserveTables(gomock.Any()).Return(nil).Times(2)
+
+		res := send(requests, model.EventRequest{Action: "reserve_series", PartySize: 2, Guest: guest, Duration: duration, Slots: []time.Time{start, next}, SeriesId: "series-1"})
+
+		occurrences := res.([]model.Reservation)
+		assert.Len(t, occurrences, 2)
+		assert.Equal(t, "res-0110", occurrences[0].Id)
+		assert.Equal(t, "res-0117", occurrences[1].Id)
+	})
+	t.Run("ReportConflicts", func(t *testing.T) {
+		mockTableRepo, _, requests := setup(t)
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockTableRepo.EXPECT().FreeTables("r1", start, start.Add(duration)).Return(tables).Times(1)
+		mockTableRepo.EXPECT().FreeTables("r1", next, next.Add(duration)).Return([]model.Table{}).Times(1)
+		mockTableRepo.EXPECT().ReservationsBetween("r1", time.Time{}, start.Add(-time.Hour)).Return([]model.Reservation{}).Times(1)
+
+		// Nothing is booked when any occurrence conflicts.
+		res := send(requests, model.EventRequest{Action: "reserve_series", PartySize: 2, Guest: guest, Duration: duration, Slots: []time.Time{start, next}, SeriesId: "series-1"})
+
+		assert.Equal(t, &service.RecurrenceConflictError{Occurrences: []service.OccurrenceConflict{{StartTime: next, Reason: "not enough tables available"}}}, res)
+	})
+	t.Run("OverlappingOccurrences", func(t *testing.T) {
+		mockTableRepo, _, requests := setup(t)
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+
+		res := send(requests, model.EventRequest{Action: "reserve_series", PartySize: 2, Duration: 8 * 24 * time.Hour, Slots: []time.Time{start, next}, SeriesId: "series-1"})
+
+		assert.EqualError(t, res.(error), "reservation must end before its next occurrence")
+	})
+	t.Run("CancelSeries", func(t *testing.T) {
+		mockTableRepo, mockReservationRepo, requests := setup(t)
+		past := model.Reservation{Id: "res-0", RestaurantId: "r1", StartTime: start.AddDate(0, 0, -7), Duration: duration, Status: model.ReservationCompleted, SeriesId: "series-1"}
+		upcoming := model.Reservation{Id: "res-1", RestaurantId: "r1", StartTime: start, Duration: duration, Status: model.ReservationConfirmed, SeriesId: "series-1"}
+		cancelled := upcoming
+		cancelled.Status = model.ReservationCancelled
+		cancelled.Cancellation = &model.Cancellation{CancelledAt: start.Add(-time.Hour)}
+		mockReservationRepo.EXPECT().FindReservationsBySeriesId("r1", "series-1").Return([]model.Reservation{past, upcoming}).Times(1)
+		mockTableRepo.EXPECT().CancelReservedTable("r1", "res-1").Return(nil).Times(1)
+		mockReservationRepo.EXPECT().UpdateReservation(cancelled).Return(nil).Times(1)
+
+		res := send(requests, model.EventRequest{Action: "cancel_series", SeriesId: "series-1"})
+
+		assert.Equal(t, service.SeriesCancellation{Cancelled: []model.Reservation{cancelled}, Failed: []service.OccurrenceFailure{}}, res)
+	})
+	t.Run("CancelSeriesUnderPolicy", func(t *testing.T) {
+		policy := model.CancellationPolicy{Cutoff: 24 * time.Hour, Fee: 500}
+		late := model.Reservation{Id: "res-1", RestaurantId: "r1", StartTime: start, Duration: duration, Status: model.ReservationConfirmed, SeriesId: "series-1"}
+		early := model.Reservation{Id: "res-2", RestaurantId: "r1", StartTime: next, Duration: duration, Status: model.ReservationConfirmed, SeriesId: "series-1"}
+
+		for _, waive := range []bool{false, true} {
+			mockTableRepo, mockReservationRepo, requests := setup(t, event.WithCancellationPolicy(policy))
+			// Only the occurrence inside the cutoff is charged, unless staff waive the fee.
+			lateCancelled := late
+			lateCancelled.Status = model.ReservationCancelled
+			lateCancelled.Cancellation = &model.Cancellation{Policy: policy, CancelledAt: start.Add(-time.Hour), FreeUntil: start.Add(-24 * time.Hour), Fee: 500}
+			if waive {
+				lateCancelled.Cancellation.Fee = 0
+				lateCancelled.Cancellation.Waived = true
+			}
+			earlyCancelled := early
+			earlyCancelled.Status = model.ReservationCancelled
+			earlyCancelled.Cancellation = &model.Cancellation{Policy: policy, CancelledAt: start.Add(-time.Hour), FreeUntil: next.Add(-24 * time.Hour)}
+			mockReservationRepo.EXPECT().FindReservationsBySeriesId("r1", "series-1").Return([]model.Reservation{late, early}).Times(1)
+			mockTableRepo.EXPECT().CancelReservedTable("r1", gomock.Any()).Return(nil).Times(2)
+			mockReservationRepo.EXPECT().UpdateReservation(lateCancelled).Return(nil).Times(1)
+			mockReservationRepo.EXPECT().UpdateReservation(earlyCancelled).Return(nil).Times(1)
+
+			res := send(requests, model.EventRequest{Action: "cancel_series", SeriesId: "series-1", WaiveFee: waive})
+
+			assert.Equal(t, service.SeriesCancellation{Cancelled: []model.Reservation{lateCancelled, earlyCancelled}, Failed: []service.OccurrenceFailure{}}, res)
+		}
+	})
+	t.Run("CancelUnknownSeries", func(t *testing.T) {
+		_, mockReservationRepo, requests := setup(t)
+		mockReservationRepo.EXPECT().FindReservationsBySeriesId("r1", "series-9").Return([]model.Reservation{}).Times(1)
+
+		res := send(requests, model.EventRequest{Action: "cancel_series", SeriesId: "series-9"})
+
+		assert.EqualError(t, res.(error), "reservation series not found")
+	})
+}
+
+func TestEventProcessor_Pacing(t *testing.T) {
+	// 10 January 2025 is a Friday; dinner paces arrivals in 15 minute intervals from 17:00.
+	startTime := time.Date(2025, 1, 10, 19, 5, 0, 0, time.UTC)
+	from, to := time.Date(2025, 1, 10, 19, 0, 0, 0, time.UTC), time.Date(2025, 1, 10, 19, 15, 0, 0, time.UTC)
+	duration := 2 * time.Hour
+	freeTables := []model.Table{{Id: "T1", Capacity: 4, MinPartySize: 1}, {Id: "T2", Capacity: 4, MinPartySize: 1}}
+	schedule := &model.Schedule{
+		RestaurantId: "r1",
+		Shifts: []model.Shift{
+			{Name: "Dinner", Weekday: time.Friday, Opens: 17 * time.Hour, Closes: 23 * time.Hour, Pacing: model.Pacing{Interval: 15 * time.Minute, MaxCovers: 6, MaxParties: 2}},
+		},
+	}
+
+	send := func(requests *chan model.EventRequest, req model.EventRequest) interface{} {
+		response := make(chan interface{}, 1)
+		req.Id = "req-24"
+		req.RestaurantId = "r1"
+		req.Response = response
+		*requests <- req
+
+		select {
+		case res := <-response:
+			return res
+		case <-time.After(1 * time.Second):
+			t.Fatal("timeout waiting for response")
+			return nil
+		}
+	}
+
+	setup := func(t *testing.T) (*mockRepository.MockTableRepository, *mockRepository.MockReservationRepository, *chan model.EventRequest) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+
+		mockTableRepo := mockRepository.NewMockTableRepository(ctrl)
+		mockReservationRepo := mockRepository.NewMockReservationRepository(ctrl)
+		mockScheduleRepo := mockRepository.NewMockScheduleRepository(ctrl)
+		mockScheduleRepo.EXPECT().FindScheduleByRestaurantId("r1").Return(schedule, nil).AnyTimes()
+		processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, zap.NewNop(), event.WithSchedule(mockScheduleRepo))
+		go processor.ProcessRequests()
+
+		return mockTableRepo, mockReservationRepo, requests
+	}
+
+	t.Run("MaxCovers", func(t *testing.T) {
+		mockTableRepo, _, requests := setup(t)
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockTableRepo.EXPECT().FreeTables("r1", startTime, startTime.Add(duration)).Return(freeTables).Times(1)
+		mockTableRepo.EXPECT().ReservationsBetween("r1", from, to).Return([]model.Reservation{
+			{Id: "res-1", PartySize: 4, TableIds: []string{"T3"}, StartTime: from, Duration: duration},
+			// Parties seated before the interval and events do not count towards it.
+			{Id: "res-2", PartySize: 4, TableIds: []string{"T4"}, StartTime: from.Add(-time.Hour), Duration: duration},
+			{Id: "res-3", PartySize: 30, TableIds: []string{"P1"}, StartTime: from, Duration: duration, Type: model.ReservationTypeEvent},
+		}).Times(1)
+
+		res := send(requests, model.EventRequest{Action: "reserve", PartySize: 3, StartTime: startTime, Duration: duration})
+
+		assert.ErrorIs(t, res.(error), model.ErrPacingLimitReached)
+		assert.EqualError(t, res.(error), "pacing limit reached for arrivals between 19:00 and 19:15")
+	})
+	t.Run("MaxParties", func(t *testing.T) {
+		mockTableRepo, _, requests := setup(t)
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockTableRepo.EXPECT().FreeTables("r1", startTime, startTime.Add(duration)).Return(freeTables).Times(1)
+		mockTableRepo.EXPECT().ReservationsBetween("r1", from, to).Return([]model.Reservation{
+			{Id: "res-1", PartySize: 1, TableIds: []string{"T3"}, StartTime: from, Duration: duration},
+			{Id: "res-2", PartySize: 1, TableIds: []string{"T4"}, StartTime: from.Add(10 * time.Minute), Duration: duration},
+		}).Times(1)
+
+		res := send(requests, model.EventRequest{Action: "reserve", PartySize: 1, StartTime: startTime, Duration: duration})
+
+		assert.ErrorIs(t, res.(error), model.ErrPacingLimitReached)
+	})
+	t.Run("WithinLimits", func(t *testing.T) {
+		mockTableRepo, mockReservationRepo, requests := setup(t)
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockTableRepo.EXPECT().FreeTables("r1", startTime, startTime.Add(duration)).Return(freeTables).Times(1)
+		mockTableRepo.EXPECT().ReservationsBetween("r1", from, to).Return([]model.Reservation{
+			{Id: "res-1", PartySize: 4, TableIds: []string{"T3"}, StartTime: from, Duration: duration},
+		}).Times(1)
+		mockReservationRepo.EXPECT().CreateReservation(gomock.Any()).DoAndReturn(func(reservation model.Reservation) *model.Reservation {
+			reservation.Id = "res-9"
+			return &reservation
+		}).Times(1)
+		mockTableRepo.EXPECT().ReserveTables(gomock.Any()).Return(nil).Times(1)
+
+		res := send(requests, model.EventRequest{Action: "reserve", PartySize: 2, StartTime: startTime, Duration: duration})
+
+		assert.Equal(t, "res-9", res.(model.Reservation).Id)
+	})
+	t.Run("ModifyCountsOwnPartyOnce", func(t *testing.T) {
+		mockTableRepo, mockReservationRepo, requests := setup(t)
+		original := model.Reservation{Id: "res-1", RestaurantId: "r1", PartySize: 3, NumTables: 1, TableIds: []string{"T1"}, StartTime: startTime, Duration: duration, Status: model.ReservationConfirmed}
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockReservationRepo.EXPECT().FindReservationById("r1", "res-1").Return(&original, nil).Times(1)
+		mockTableRepo.EXPECT().Tables("r1").Return(freeTables).Times(1)
+		mockTableRepo.EXPECT().FreeTables("r1", startTime, startTime.Add(duration)).Return(freeTables[1:]).Times(1)
+		mockTableRepo.EXPECT().ReservationsBetween("r1", from, to).Return([]model.Reservation{original}).Times(1)
+		mockTableRepo.EXPECT().CancelReservedTable("r1", "res-1").Return(nil).Times(1)
+		mockTableRepo.EXPECT().ReserveTables(gomock.Any()).Return(nil).Times(1)
+		mockReservationRepo.EXPECT().UpdateReservation(gomock.Any()).Return(nil).Times(1)
+
+		// Counting the party's own booking again would make 7 covers.
+		res := send(requests, model.EventRequest{Action: "modify", ResID: "res-1", PartySize: 4})
+
+		assert.Equal(t, 4, res.(model.Reservation).PartySize)
+	})
+	t.Run("ModifyOverbookedInFullWindow", func(t *testing.T) {
+		mockTableRepo, mockReservationRepo, requests := setup(t)
+		// The overbooked party holds no tables, so only its id tells its own booking apart.
+		original := model.Reservation{Id: "res-1", RestaurantId: "r1", PartySize: 4, StartTime: startTime, Duration: duration, Status: model.ReservationConfirmed, Overbooked: true, OverbookedTables: 1}
+		other := model.Reservation{Id: "res-2", RestaurantId: "r1", PartySize: 2, NumTables: 1, TableIds: []string{"T1"}, StartTime: from, Duration: duration, Status: model.ReservationConfirmed}
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockReservationRepo.EXPECT().FindReservationById("r1", "res-1").Return(&original, nil).Times(1)
+		mockTableRepo.EXPECT().Tables("r1").Return(freeTables).Times(1)
+		mockTableRepo.EXPECT().FreeTables("r1", startTime, startTime.Add(duration)).Return(freeTables[1:]).Times(1)
+		mockTableRepo.EXPECT().ReservationsBetween("r1", from, to).Return([]model.Reservation{other, original}).Times(1)
+		mockTableRepo.EXPECT().CancelReservedTable("r1", "res-1").Return(nil).Times(1)
+		mockTableRepo.EXPECT().ReserveTables(gomock.Any()).Return(nil).Times(1)
+		mockReservationRepo.EXPECT().UpdateReservation(gomock.Any()).Return(nil).Times(1)
+
+		// The window is at both its cover and party caps; shrinking the party must still fit.
+		res := send(requests, model.EventRequest{Action: "modify", ResID: "res-1", PartySize: 3})
+
+		assert.Equal(t, 3, res.(model.Reservation).PartySize)
+		assert.Equal(t, []string{"T2"}, res.(model.Reservation).TableIds)
+		assert.False(t, res.(model.Reservation).Overbooked)
+	})
+	t.Run("Availability", func(t *testing.T) {
+		mockTableRepo, _, requests := setup(t)
+		next := to
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockTableRepo.EXPECT().FreeTables("r1", from, from.Add(duration)).Return(freeTables).Times(1)
+		mockTableRepo.EXPECT().FreeTables("r1", next, next.Add(duration)).Return(freeTables).Times(1)
+		mockTableRepo.EXPECT().ReservationsBetween("r1", from, to).Return([]model.Reservation{
+			{Id: "res-1", PartySize: 6, TableIds: []string{"T3"}, StartTime: from, Duration: duration},
+		}).Times(1)
+		mockTableRepo.EXPECT().ReservationsBetween("r1", next, next.Add(15*time.Minute)).Return([]model.Reservation{}).Times(1)
+
+		res := send(requests, model.EventRequest{Action: "availability", PartySize: 2, Duration: duration, Slots: []time.Time{from, next}})
+
+		assert.Equal(t, []model.Slot{{StartTime: next, EndTime: next.Add(duration), TableIds: []string{"T1"}}}, res)
+	})
+}
+
+func TestEventProcessor_BookingWindow(t *testing.T) {
+	now := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+	duration := 2 * time.Hour
+	freeTables := []model.Table{{Id: "T1", Capacity: 4, MinPartySize: 1}}
+
+	send := func(requests *chan model.EventRequest, req model.EventRequest) interface{} {
+		response := make(chan interface{}, 1)
+		req.Id = "req-25"
+		req.RestaurantId = "r1"
+		req.Response = response
+		*requests <- req
+
+		select {
+		case res := <-response:
+			return res
+		case <-time.After(1 * time.Second):
+			t.Fatal("timeout waiting for response")
+			return nil
+		}
+	}
+
+	setup := func(t *testing.T) (*mockRepository.MockTableRepository, *mockRepository.MockReservationRepository, *chan model.EventRequest) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+
+		mockTableRepo := mockRepository.NewMockTableRepository(ctrl)
+		mockReservationRepo := mockRepository.NewMockReservationRepository(ctrl)
+		processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, zap.NewNop(), event.WithClock(&fakeClock{now: now}),
+			event.WithBookingWindow(model.ChannelPublic, model.BookingWindow{MinLeadTime: 2 * time.Hour, MaxAdvance: 60 * 24 * time.Hour}),
+			event.WithBookingWindow(model.ChannelStaff, model.BookingWindow{MaxAdvance: 365 * 24 * time.Hour}))
+		go processor.ProcessRequests()
+
+		return mockTableRepo, mockReservationRepo, requests
+	}
+
+	t.Run("PublicTooSoon", func(t *testing.T) {
+		mockTableRepo, _, requests := setup(t)
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+
+		res := send(requests, model.EventRequest{Action: "reserve", PartySize: 2, StartTime: now.Add(time.Hour), Duration: duration, Channel: model.ChannelPublic})
+
+		assert.ErrorIs(t, res.(error), model.ErrBookingTooSoon)
+		assert.EqualError(t, res.(error), "booking is too close to its start time, bookings must be made at least 2 hours ahead")
+	})
+	t.Run("PublicTooFarAhead", func(t *testing.T) {
+		mockTableRepo, _, requests := setup(t)
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+
+		res := send(requests, model.EventRequest{Action: "reserve", PartySize: 2, StartTime: now.AddDate(0, 0, 61), Duration: duration, Channel: model.ChannelPublic})
+
+		assert.ErrorIs(t, res.(error), model.ErrBookingTooFarAhead)
+		assert.EqualError(t, res.(error), "booking is too far ahead, bookings open 60 days ahead")
+	})
+	t.Run("StaffWindow", func(t *testing.T) {
+		mockTableRepo, mockReservationRepo, requests := setup(t)
+		start := now.Add(time.Hour)
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(2)
+		mockTableRepo.EXPECT().FreeTables("r1", start, start.Add(duration)).Return(freeTables).Times(1)
+		mockTableRepo.EXPECT().ReservationsBetween("r1", time.Time{}, now).Return([]model.Reservation{}).Times(1)
+		mockReservationRepo.EXPECT().CreateReservation(gomock.Any()).DoAndReturn(func(reservation model.Reservation) *model.Reservation {
+			reservation.Id = "res-1"
+			return &reservation
+		}).Times(1)
+		mockTableRepo.EXPECT().ReserveTables(gomock.Any()).Return(nil).Times(1)
+
+		// Staff may book within the public lead time; requests without a channel are staff bookings.
+		res := send(requests, model.EventRequest{Action: "reserve", PartySize: 2, StartTime: start, Duration: duration})
+		assert.Equal(t, "res-1", res.(model.Reservation).Id)
+
+		res = send(requests, model.EventRequest{Action: "hold", PartySize: 2, StartTime: now.AddDate(2, 0, 0), Duration: duration, Channel: model.ChannelStaff})
+		assert.ErrorIs(t, res.(error), model.ErrBookingTooFarAhead)
+	})
+	t.Run("Series", func(t *testing.T) {
+		mockTableRepo, _, requests := setup(t)
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+
+		// The last occurrence falls more than a year ahead.
+		start := now.Add(6 * time.Hour)
+		res := send(requests, model.EventRequest{Action: "reserve_series", PartySize: 2, Duration: duration, Slots: []time.Time{start, start.AddDate(0, 0, 371)}, SeriesId: "series-1", Channel: model.ChannelStaff})
+
+		assert.ErrorIs(t, res.(error), model.ErrBookingTooFarAhead)
+	})
+	t.Run("Availability", func(t *testing.T) {
+		mockTableRepo, _, requests := setup(t)
+		later := now.Add(2 * time.Hour)
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockTableRepo.EXPECT().FreeTables("r1", later, later.Add(duration)).Return(freeTables).Times(1)
+		mockTableRepo.EXPECT().ReservationsBetween("r1", time.Time{}, now).Return([]model.Reservation{}).Times(1)
+
+		res := send(requests, model.EventRequest{Action: "availability", PartySize: 2, Duration: duration, Slots: []time.Time{now.Add(time.Hour), later}, Channel: model.ChannelPublic})
+
+		assert.Equal(t, []model.Slot{{StartTime: later, EndTime: later.Add(duration), TableIds: []string{"T1"}}}, res)
+	})
+}
+
+func TestEventProcessor_CancellationPolicy(t *testing.T) {
+	startTime := time.Date(2025, 1, 10, 19, 0, 0, 0, time.UTC)
+	policy := model.CancellationPolicy{Cutoff: 24 * time.Hour, Fee: 500, ForfeitDeposit: true}
+
+	cancel := func(t *testing.T, now time.Time, reservation model.Reservation, waive bool) model.Reservation {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+
+		mockTableRepo := mockRepository.NewMockTableRepository(ctrl)
+		mockReservationRepo := mockRepository.NewMockReservationRepository(ctrl)
+		processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, zap.NewNop(), event.WithClock(&fakeClock{now: now}), event.WithCancellationPolicy(policy))
+		go processor.ProcessRequests()
+
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockReservationRepo.EXPECT().FindReservationById("r1", reservation.Id).Return(&reservation, nil).Times(1)
+		mockTableRepo.EXPECT().CancelReservedTable("r1", reservation.Id).Return(nil).Times(1)
+		mockReservationRepo.EXPECT().UpdateReservation(gomock.Any()).Return(nil).Times(1)
+
+		response := make(chan interface{}, 1)
+		*requests <- model.EventRequest{Id: "req-26", Action: "cancel", RestaurantId: "r1", ResID: reservation.Id, WaiveFee: waive, Response: response}
+
+		select {
+		case res := <-response:
+			return res.(model.Reservation)
+		case <-time.After(1 * time.Second):
+			t.Fatal("timeout waiting for response")
+			return model.Reservation{}
+		}
+	}
+
+	t.Run("FreeBeforeCutoff", func(t *testing.T) {
+		reservation := cancel(t, startTime.Add(-25*time.Hour), model.Reservation{Id: "res-1", StartTime: startTime, Status: model.ReservationConfirmed}, false)
+
+		assert.Equal(t, model.ReservationCancelled, reservation.Status)
+		assert.Equal(t, &model.Cancellation{Policy: policy, CancelledAt: startTime.Add(-25 * time.Hour), FreeUntil: startTime.Add(-24 * time.Hour)}, reservation.Cancellation)
+		assert.False(t, reservation.Cancellation.FeeApplies())
+	})
+	t.Run("FeeAfterCutoff", func(t *testing.T) {
+		reservation := cancel(t, startTime.Add(-2*time.Hour), model.Reservation{Id: "res-1", StartTime: startTime, Status: model.ReservationConfirmed}, false)
+
+		assert.True(t, reservation.Cancellation.FeeApplies())
+		assert.Equal(t, 500, reservation.Cancellation.Fee)
+		assert.False(t, reservation.Cancellation.DepositForfeited)
+	})
+	t.Run("DepositForfeited", func(t *testing.T) {
+		booking := model.Reservation{Id: "evt-1", StartTime: startTime, Status: model.ReservationConfirmed, Type: model.ReservationTypeEvent, DepositStatus: model.DepositPaid}
+		reservation := cancel(t, startTime.Add(-2*time.Hour), booking, false)
+
+		// The forfeited deposit stands in for the fee.
+		assert.True(t, reservation.Cancellation.FeeApplies())
+		assert.True(t, reservation.Cancellation.DepositForfeited)
+		assert.Zero(t, reservation.Cancellation.Fee)
+		assert.Equal(t, model.DepositForfeited, reservation.DepositStatus)
+	})
+	t.Run("StaffOverride", func(t *testing.T) {
+		booking := model.Reservation{Id: "evt-1", StartTime: startTime, Status: model.ReservationConfirmed, Type: model.ReservationTypeEvent, DepositStatus: model.DepositPaid}
+		reservation := cancel(t, startTime.Add(-2*time.Hour), booking, true)
+
+		assert.False(t, reservation.Cancellation.FeeApplies())
+		assert.True(t, reservation.Cancellation.Waived)
+		assert.Equal(t, model.DepositPaid, reservation.DepositStatus)
+	})
+	t.Run("HoldReleasedFree", func(t *testing.T) {
+		reservation := cancel(t, startTime.Add(-2*time.Hour), model.Reservation{Id: "res-1", StartTime: startTime, Status: model.ReservationHeld}, false)
+
+		assert.False(t, reservation.Cancellation.FeeApplies())
+		assert.False(t, reservation.Cancellation.Waived)
+	})
+}
+
+func TestEventProcessor_Payments(t *testing.T) {
+	now := time.Date(2025, 1, 9, 12, 0, 0, 0, time.UTC)
+	startTime := time.Date(2025, 1, 10, 19, 0, 0, 0, time.UTC)
+	duration := 2 * time.Hour
+	freeTables := []model.Table{{Id: "T1", Capacity: 4, MinPartySize: 1}, {Id: "T2", Capacity: 4, MinPartySize: 1, CombinableWith: []string{"T1"}}}
+	policy := model.DepositPolicy{MinPartySize: 6, Dates: []string{"2025-12-24"}, AmountPerGuest: 1000, PaymentTimeout: 15 * time.Minute}
+	pending := model.Reservation{Id: "res-1", RestaurantId: "r1", PartySize: 6, NumTables: 2, TableIds: []string{"T1", "T2"}, StartTime: startTime, Duration: duration, Status: model.ReservationPendingPayment, DepositStatus: model.DepositPending, Deposit: 6000, PaymentDueAt: now.Add(15 * time.Minute)}
+
+	send := func(requests *chan model.EventRequest, req model.EventRequest) interface{} {
+		response := make(chan interface{}, 1)
+		req.Id = "req-27"
+		req.RestaurantId = "r1"
+		req.Response = response
+		*requests <- req
+
+		select {
+		case res := <-response:
+			return res
+		case <-time.After(1 * time.Second):
+			t.Fatal("timeout waiting for response")
+			return nil
+		}
+	}
+
+	setup := func(t *testing.T, clock *fakeClock, opts ...event.Option) (*mockRepository.MockTableRepository, *mockRepository.MockReservationRepository, *serviceMock.MockPaymentGateway, *chan model.EventRequest) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+
+		mockTableRepo := mockRepository.NewMockTableRepository(ctrl)
+		mockReservationRepo := mockRepository.NewMockReservationRepository(ctrl)
+		mockGateway := serviceMock.NewMockPaymentGateway(ctrl)
+		processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, zap.NewNop(), append([]event.Option{event.WithClock(clock), event.WithPayments(mockGateway, policy)}, opts...)...)
+		go processor.ProcessRequests()
+
+		return mockTableRepo, mockReservationRepo, mockGateway, requests
+	}
+	expectBooking := func(mockTableRepo *mockRepository.MockTableRepository, mockReservationRepo *mockRepository.MockReservationRepository, start time.Time) {
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockTableRepo.EXPECT().FreeTables("r1", start, start.Add(duration)).Return(freeTables).Times(1)
+		mockReservationRepo.EXPECT().CreateReservation(gomock.Any()).DoAndReturn(func(reservation model.Reservation) *model.Reservation {
+			reservation.Id = "res-1"
+			return &reservation
+		}).Times(1)
+		mockTableRepo.EXPECT().ReserveTables(gomock.Any()).Return(nil).Times(1)
+	}
+
+	t.Run("DepositRequired", func(t *testing.T) {
+		testCases := []struct {
+			name      string
+			partySize int
+			start     time.Time
+			status    model.ReservationStatus
+			deposit   int
+		}{
+			{name: "LargeParty", partySize: 6, start: startTime, status: model.ReservationPendingPayment, deposit: 6000},
+			{name: "Holiday", partySize: 2, start: time.Date(2025, 12, 24, 19, 0, 0, 0, time.UTC), status: model.ReservationPendingPayment, deposit: 2000},
+			{name: "NotRequired", partySize: 2, start: startTime, status: model.ReservationConfirmed},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				mockTableRepo, mockReservationRepo, _, requests := setup(t, &fakeClock{now: now})
+				expectBooking(mockTableRepo, mockReservationRepo, tc.start)
+
+				res := send(requests, model.EventRequest{Action: "reserve", PartySize: tc.partySize, StartTime: tc.start, Duration: duration})
+
+				reservation := res.(model.Reservation)
+				assert.Equal(t, tc.status, reservation.Status)
+				assert.Equal(t, tc.deposit, reservation.Deposit)
+				if tc.deposit > 0 {
+					assert.Equal(t, model.DepositPending, reservation.DepositStatus)
+					assert.Equal(t, now.Add(15*time.Minute), reservation.PaymentDueAt)
+				}
+			})
+		}
+	})
+	t.Run("Pay", func(t *testing.T) {
+		_, mockReservationRepo, mockGateway, requests := setup(t, &fakeClock{now: now})
+		booking := pending
+		paid := pending
+		paid.Status = model.ReservationConfirmed
+		paid.DepositStatus = model.DepositPaid
+		paid.PaymentId = "pay-1"
+		paid.PaymentDueAt = time.Time{}
+
+		mockReservationRepo.EXPECT().FindReservationById("r1", "res-1").Return(&booking, nil).Times(1)
+		mockGateway.EXPECT().Charge("tok_visa", 6000, "res-1").Return("pay-1", nil).Times(1)
+		mockReservationRepo.EXPECT().UpdateReservation(paid).Return(nil).Times(1)
+
+		res := send(requests, model.EventRequest{Action: "pay", ResID: "res-1", PaymentToken: "tok_visa"})
+
+		assert.Equal(t, paid, res)
+	})
+	t.Run("Declined", func(t *testing.T) {
+		mockTableRepo, mockReservationRepo, mockGateway, requests := setup(t, &fakeClock{now: now})
+		booking := pending
+		failed := pending
+		failed.Status = model.ReservationPaymentFailed
+
+		mockReservationRepo.EXPECT().FindReservationById("r1", "res-1").Return(&booking, nil).Times(1)
+		mockGateway.EXPECT().Charge("tok_declined", 6000, "res-1").Return("", service.ErrPaymentDeclined).Times(1)
+		mockTableRepo.EXPECT().CancelReservedTable("r1", "res-1").Return(nil).Times(1)
+		mockReservationRepo.EXPECT().UpdateReservation(failed).Return(nil).Times(1)
+
+		res := send(requests, model.EventRequest{Action: "pay", ResID: "res-1", PaymentToken: "tok_declined"})
+
+		assert.ErrorIs(t, res.(error), service.ErrPaymentDeclined)
+		assert.EqualError(t, res.(error), "payment declined, the tables have been released")
+	})
+	t.Run("GatewayError", func(t *testing.T) {
+		_, mockReservationRepo, mockGateway, requests := setup(t, &fakeClock{now: now})
+		booking := pending
+
+		// The booking keeps its tables so the guest can try again.
+		mockReservationRepo.EXPECT().FindReservationById("r1", "res-1").Return(&booking, nil).Times(1)
+		mockGateway.EXPECT().Charge("tok_visa", 6000, "res-1").Return("", errors.New("gateway unavailable")).Times(1)
+
+		res := send(requests, model.EventRequest{Action: "pay", ResID: "res-1", PaymentToken: "tok_visa"})
+
+		assert.EqualError(t, res.(error), "gateway unavailable")
+	})
+	t.Run("Timeout", func(t *testing.T) {
+		clock := &fakeClock{now: now}
+		mockTableRepo, mockReservationRepo, _, requests := setup(t, clock)
+		expectBooking(mockTableRepo, mockReservationRepo, startTime)
+		expired := pending
+		expired.Status = model.ReservationExpired
+		gomock.InOrder(
+			mockReservationRepo.EXPECT().FindReservationById("r1", "res-1").Return(&model.Reservation{Id: "res-1", Status: model.ReservationPendingPayment}, nil),
+			mockTableRepo.EXPECT().CancelReservedTable("r1", "res-1").Return(nil),
+			mockReservationRepo.EXPECT().UpdateReservation(model.Reservation{Id: "res-1", Status: model.ReservationExpired}).Return(nil),
+			mockReservationRepo.EXPECT().FindReservationById("r1", "res-1").Return(&expired, nil),
+		)
+
+		res := send(requests, model.EventRequest{Action: "reserve", PartySize: 6, StartTime: startTime, Duration: duration})
+		assert.Equal(t, model.ReservationPendingPayment, res.(model.Reservation).Status)
+
+		// The guest does not pay before the deadline.
+		clock.Advance(15 * time.Minute)
+
+		res = send(requests, model.EventRequest{Action: "pay", ResID: "res-1", PaymentToken: "tok_visa"})
+		assert.EqualError(t, res.(error), "cannot pay the deposit of a expired reservation")
+	})
+	t.Run("ConfirmWithoutPayment", func(t *testing.T) {
+		mockTableRepo, mockReservationRepo, _, requests := setup(t, &fakeClock{now: now})
+		booking := pending
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockReservationRepo.EXPECT().FindReservationById("r1", "res-1").Return(&booking, nil).Times(1)
+
+		res := send(requests, model.EventRequest{Action: "confirm", ResID: "res-1"})
+
+		assert.EqualError(t, res.(error), "reservation is awaiting its deposit")
+	})
+	t.Run("RefundOnCancel", func(t *testing.T) {
+		mockTableRepo, mockReservationRepo, mockGateway, requests := setup(t, &fakeClock{now: now})
+		booking := model.Reservation{Id: "res-1", StartTime: startTime, Status: model.ReservationConfirmed, Deposit: 6000, DepositStatus: model.DepositPaid, PaymentId: "pay-1"}
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockReservationRepo.EXPECT().FindReservationById("r1", "res-1").Return(&booking, nil).Times(1)
+		mockGateway.EXPECT().Refund("pay-1", 6000).Return(nil).Times(1)
+		mockTableRepo.EXPECT().CancelReservedTable("r1", "res-1").Return(nil).Times(1)
+		mockReservationRepo.EXPECT().UpdateReservation(gomock.Any()).Return(nil).Times(1)
+
+		res := send(requests, model.EventRequest{Action: "cancel", ResID: "res-1"})
+
+		reservation := res.(model.Reservation)
+		assert.Equal(t, model.DepositRefunded, reservation.DepositStatus)
+		assert.Equal(t, 6000, reservation.Cancellation.Refunded)
+	})
+	t.Run("RefundFailed", func(t *testing.T) {
+		mockTableRepo, mockReservationRepo, mockGateway, requests := setup(t, &fakeClock{now: now})
+		booking := model.Reservation{Id: "res-1", StartTime: startTime, Status: model.ReservationConfirmed, Deposit: 6000, DepositStatus: model.DepositPaid, PaymentId: "pay-1"}
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockReservationRepo.EXPECT().FindReservationById("r1", "res-1").Return(&booking, nil).Times(1)
+		mockGateway.EXPECT().Refund("pay-1", 6000).Return(errors.New("gateway unavailable")).Times(1)
+
+		// The reservation is only cancelled once its deposit is back with the guest.
+		res := send(requests, model.EventRequest{Action: "cancel", ResID: "res-1"})
+
+		assert.EqualError(t, res.(error), "gateway unavailable")
+	})
+	t.Run("SeriesRefundFailed", func(t *testing.T) {
+		mockTableRepo, mockReservationRepo, mockGateway, requests := setup(t, &fakeClock{now: now})
+		first := model.Reservation{Id: "res-1", RestaurantId: "r1", StartTime: startTime, Status: model.ReservationConfirmed, Deposit: 6000, DepositStatus: model.DepositPaid, PaymentId: "pay-1", SeriesId: "series-1"}
+		second := model.Reservation{Id: "res-2", RestaurantId: "r1", StartTime: startTime.AddDate(0, 0, 7), Status: model.ReservationConfirmed, Deposit: 6000, DepositStatus: model.DepositPaid, PaymentId: "pay-2", SeriesId: "series-1"}
+		mockReservationRepo.EXPECT().FindReservationsBySeriesId("r1", "series-1").Return([]model.Reservation{first, second}).Times(1)
+		mockGateway.EXPECT().Refund("pay-1", 6000).Return(errors.New("gateway unavailable")).Times(1)
+		mockGateway.EXPECT().Refund("pay-2", 6000).Return(nil).Times(1)
+		// Only the refunded occurrence is cancelled; the other keeps its tables and its deposit.
+		mockTableRepo.EXPECT().CancelReservedTable("r1", "res-2").Return(nil).Times(1)
+		mockReservationRepo.EXPECT().UpdateReservation(gomock.Any()).Return(nil).Times(1)
+
+		res := send(requests, model.EventRequest{Action: "cancel_series", SeriesId: "series-1"})
+
+		result := res.(service.SeriesCancellation)
+		assert.Equal(t, []service.OccurrenceFailure{{ReservationId: "res-1", StartTime: startTime, Reason: "gateway unavailable"}}, result.Failed)
+		assert.Len(t, result.Cancelled, 1)
+		assert.Equal(t, "res-2", result.Cancelled[0].Id)
+		assert.Equal(t, model.DepositRefunded, result.Cancelled[0].DepositStatus)
+	})
+	t.Run("ForfeitedDepositKept", func(t *testing.T) {
+		mockTableRepo, mockReservationRepo, _, requests := setup(t, &fakeClock{now: now}, event.WithCancellationPolicy(model.CancellationPolicy{Cutoff: 48 * time.Hour, ForfeitDeposit: true}))
+		booking := model.Reservation{Id: "res-1", StartTime: startTime, Status: model.ReservationConfirmed, Deposit: 6000, DepositStatus: model.DepositPaid, PaymentId: "pay-1"}
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockReservationRepo.EXPECT().FindReservationById("r1", "res-1").Return(&booking, nil).Times(1)
+		mockTableRepo.EXPECT().CancelReservedTable("r1", "res-1").Return(nil).Times(1)
+		mockReservationRepo.EXPECT().UpdateReservation(gomock.Any()).Return(nil).Times(1)
+
+		res := send(requests, model.EventRequest{Action: "cancel", ResID: "res-1"})
+
+		reservation := res.(model.Reservation)
+		assert.Equal(t, model.DepositForfeited, reservation.DepositStatus)
+		assert.Zero(t, reservation.Cancellation.Refunded)
+	})
+	t.Run("ConfirmHoldNeedsDeposit", func(t *testing.T) {
+		mockTableRepo, mockReservationRepo, _, requests := setup(t, &fakeClock{now: now})
+		held := model.Reservation{Id: "res-1", RestaurantId: "r1", PartySize: 6, NumTables: 2, TableIds: []string{"T1", "T2"}, StartTime: startTime, Duration: duration, Status: model.ReservationHeld, HoldExpiresAt: now.Add(10 * time.Minute)}
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockReservationRepo.EXPECT().FindReservationById("r1", "res-1").Return(&held, nil).Times(1)
+		mockReservationRepo.EXPECT().UpdateReservation(gomock.Any()).Return(nil).Times(1)
+
+		res := send(requests, model.EventRequest{Action: "confirm", ResID: "res-1", Guest: model.Guest{Name: "Alex Tan"}})
+
+		reservation := res.(model.Reservation)
+		assert.Equal(t, model.ReservationPendingPayment, reservation.Status)
+		assert.Equal(t, 6000, reservation.Deposit)
+		assert.Equal(t, now.Add(15*time.Minute), reservation.PaymentDueAt)
+		assert.Equal(t, "Alex Tan", reservation.Guest.Name)
+		assert.True(t, reservation.HoldExpiresAt.IsZero())
+	})
+	t.Run("SeriesNeedsDeposit", func(t *testing.T) {
+		mockTableRepo, mockReservationRepo, _, requests := setup(t, &fakeClock{now: now})
+		next := startTime.AddDate(0, 0, 7)
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockTableRepo.EXPECT().FreeTables("r1", startTime, startTime.Add(duration)).Return(freeTables).Times(1)
+		mockTableRepo.EXPECT().FreeTables("r1", next, next.Add(duration)).Return(freeTables).Times(1)
+		mockReservationRepo.EXPECT().CreateReservation(gomock.Any()).DoAndReturn(func(reservation model.Reservation) *model.Reservation {
+			reservation.Id = "res-" + reservation.StartTime.Format("0102")
+			return &reservation
+		}).Times(2)
+		mockTableRepo.EXPECT().ReserveTables(gomock.Any()).Return(nil).Times(2)
+
+		res := send(requests, model.EventRequest{Action: "reserve_series", PartySize: 6, Duration: duration, Slots: []time.Time{startTime, next}, SeriesId: "series-1"})
+
+		for _, occurrence := range res.([]model.Reservation) {
+			assert.Equal(t, model.ReservationPendingPayment, occurrence.Status)
+			assert.Equal(t, 6000, occurrence.Deposit)
+		}
+	})
+	t.Run("WaitlistPromotionNeedsDeposit", func(t *testing.T) {
+		mockWaitlistRepo := mockRepository.NewMockWaitlistRepository(gomock.NewController(t))
+		mockTableRepo, mockReservationRepo, _, requests := setup(t, &fakeClock{now: now}, event.WithWaitlist(mockWaitlistRepo))
+		booked := model.Reservation{Id: "res-2", RestaurantId: "r1", PartySize: 4, NumTables: 2, TableIds: []string{"T1", "T2"}, StartTime: startTime, Duration: duration, Status: model.ReservationConfirmed}
+		entry := model.WaitlistEntry{Id: "wl-1", RestaurantId: "r1", PartySize: 6, StartTime: startTime, Duration: duration, Status: model.WaitlistWaiting}
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockReservationRepo.EXPECT().FindReservationById("r1", "res-2").Return(&booked, nil).Times(1)
+		mockTableRepo.EXPECT().CancelReservedTable("r1", "res-2").Return(nil).Times(1)
+		mockReservationRepo.EXPECT().UpdateReservation(gomock.Any()).Return(nil).Times(1)
+		mockWaitlistRepo.EXPECT().WaitingEntries("r1").Return([]model.WaitlistEntry{entry}).Times(1)
+		mockTableRepo.EXPECT().FreeTables("r1", startTime, startTime.Add(duration)).Return(freeTables).Times(1)
+		mockReservationRepo.EXPECT().CreateReservation(gomock.Any()).DoAndReturn(func(reservation model.Reservation) *model.Reservation {
+			// The promoted party pays its deposit before the booking is confirmed.
+			assert.Equal(t, model.ReservationPendingPayment, reservation.Status)
+			assert.Equal(t, 6000, reservation.Deposit)
+			reservation.Id = "res-3"
+			return &reservation
+		}).Times(1)
+		mockTableRepo.EXPECT().ReserveTables(gomock.Any()).Return(nil).Times(1)
+		mockWaitlistRepo.EXPECT().UpdateEntry(gomock.Any()).Return(nil).Times(1)
+
+		res := send(requests, model.EventRequest{Action: "cancel", ResID: "res-2"})
+
+		assert.Equal(t, model.ReservationCancelled, res.(model.Reservation).Status)
+	})
+	t.Run("ModifyIntoDeposit", func(t *testing.T) {
+		mockTableRepo, mockReservationRepo, _, requests := setup(t, &fakeClock{now: now})
+		booked := model.Reservation{Id: "res-1", RestaurantId: "r1", PartySize: 4, NumTables: 1, TableIds: []string{"T1"}, StartTime: startTime, Duration: duration, Status: model.ReservationConfirmed}
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockReservationRepo.EXPECT().FindReservationById("r1", "res-1").Return(&booked, nil).Times(1)
+		mockTableRepo.EXPECT().Tables("r1").Return(freeTables).Times(1)
+		mockTableRepo.EXPECT().FreeTables("r1", startTime, startTime.Add(duration)).Return(freeTables[1:]).Times(1)
+		mockTableRepo.EXPECT().CancelReservedTable("r1", "res-1").Return(nil).Times(1)
+		mockTableRepo.EXPECT().ReserveTables(gomock.Any()).Return(nil).Times(1)
+		mockReservationRepo.EXPECT().UpdateReservation(gomock.Any()).Return(nil).Times(1)
+
+		res := send(requests, model.EventRequest{Action: "modify", ResID: "res-1", PartySize: 6})
+
+		reservation := res.(model.Reservation)
+		assert.Equal(t, model.ReservationPendingPayment, reservation.Status)
+		assert.Equal(t, 6000, reservation.Deposit)
+		assert.Equal(t, model.DepositPending, reservation.DepositStatus)
+	})
+}
+
+func TestEventProcessor_Overbooking(t *testing.T) {
+	// 10 January 2025 is a Friday; dinner may overbook half of its four tables.
+	startTime := time.Date(2025, 1, 10, 19, 0, 0, 0, time.UTC)
+	duration := 2 * time.Hour
+	tables := []model.Table{
+		{Id: "T1", Capacity: 4, MinPartySize: 1},
+		{Id: "T2", Capacity: 4, MinPartySize: 1},
+		{Id: "T3", Capacity: 4, MinPartySize: 1},
+		{Id: "T4", Capacity: 4, MinPartySize: 1},
+	}
+	schedule := &model.Schedule{
+		RestaurantId: "r1",
+		Shifts: []model.Shift{
+			{Name: "Dinner", Weekday: time.Friday, Opens: 17 * time.Hour, Closes: 23 * time.Hour, Overbooking: model.Overbooking{Percent: 50}},
+		},
+	}
+
+	send := func(requests *chan model.EventRequest, req model.EventRequest) interface{} {
+		response := make(chan interface{}, 1)
+		req.Id = "req-25"
+		req.RestaurantId = "r1"
+		req.Response = response
+		*requests <- req
+
+		select {
+		case res := <-response:
+			return res
+		case <-time.After(1 * time.Second):
+			t.Fatal("timeout waiting for response")
+			return nil
+		}
+	}
+
+	setup := func(t *testing.T) (*mockRepository.MockTableRepository, *mockRepository.MockReservationRepository, *chan model.EventRequest) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+
+		mockTableRepo := mockRepository.NewMockTableRepository(ctrl)
+		mockReservationRepo := mockRepository.NewMockReservationRepository(ctrl)
+		mockScheduleRepo := mockRepository.NewMockScheduleRepository(ctrl)
+		mockScheduleRepo.EXPECT().FindScheduleByRestaurantId("r1").Return(schedule, nil).AnyTimes()
+		processor, requests := event.NewProcessor(mockTableRepo, mockReservationRepo, zap.NewNop(), event.WithSchedule(mockScheduleRepo))
+		go processor.ProcessRequests()
+
+		return mockTableRepo, mockReservationRepo, requests
+	}
+
+	t.Run("WithinAllowance", func(t *testing.T) {
+		mockTableRepo, mockReservationRepo, requests := setup(t)
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockTableRepo.EXPECT().FreeTables("r1", startTime, startTime.Add(duration)).Return([]model.Table{}).Times(1)
+		mockTableRepo.EXPECT().Tables("r1").Return(tables).Times(1)
+		mockTableRepo.EXPECT().TotalTables("r1").Return(4).Times(1)
+		mockTableRepo.EXPECT().ReservationsBetween("r1", startTime, startTime.Add(duration)).Return([]model.Reservation{
+			{Id: "res-1", PartySize: 2, TableIds: []string{"T1"}, StartTime: startTime, Duration: duration},
+			{Id: "res-5", PartySize: 4, StartTime: startTime, Duration: duration, Overbooked: true, OverbookedTables: 1},
+		}).Times(1)
+		mockReservationRepo.EXPECT().CreateReservation(gomock.Any()).DoAndReturn(func(reservation model.Reservation) *model.Reservation {
+			reservation.Id = "res-9"
+			return &reservation
+		}).Times(1)
+		mockTableRepo.EXPECT().ReserveTables(gomock.Any()).Return(nil).Times(1)
+
+		res := send(requests, model.EventRequest{Action: "reserve", PartySize: 2, StartTime: startTime, Duration: duration})
+
+		reservation := res.(model.Reservation)
+		assert.Equal(t, model.ReservationConfirmed, reservation.Status)
+		assert.True(t, reservation.Overbooked)
+		assert.Equal(t, 1, reservation.OverbookedTables)
+		assert.Zero(t, reservation.NumTables)
+		assert.Empty(t, reservation.TableIds)
+	})
+	t.Run("AllowanceUsedUp", func(t *testing.T) {
+		mockTableRepo, _, requests := setup(t)
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockTableRepo.EXPECT().FreeTables("r1", startTime, startTime.Add(duration)).Return([]model.Table{}).Times(1)
+		mockTableRepo.EXPECT().Tables("r1").Return(tables).Times(1)
+		mockTableRepo.EXPECT().TotalTables("r1").Return(4).Times(1)
+		mockTableRepo.EXPECT().ReservationsBetween("r1", startTime, startTime.Add(duration)).Return([]model.Reservation{
+			{Id: "res-5", PartySize: 8, StartTime: startTime, Duration: duration, Overbooked: true, OverbookedTables: 2},
+		}).Times(1)
+
+		res := send(requests, model.EventRequest{Action: "reserve", PartySize: 2, StartTime: startTime, Duration: duration})
+
+		assert.ErrorIs(t, res.(error), service.ErrNotEnoughTables)
+	})
+	t.Run("HoldNotOverbooked", func(t *testing.T) {
+		mockTableRepo, _, requests := setup(t)
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockTableRepo.EXPECT().FreeTables("r1", startTime, startTime.Add(duration)).Return([]model.Table{}).Times(1)
+
+		res := send(requests, model.EventRequest{Action: "hold", PartySize: 2, StartTime: startTime, Duration: duration})
+
+		assert.ErrorIs(t, res.(error), service.ErrNotEnoughTables)
+	})
+	t.Run("ModifyOntoFreeTables", func(t *testing.T) {
+		mockTableRepo, mockReservationRepo, requests := setup(t)
+		original := model.Reservation{Id: "res-5", RestaurantId: "r1", PartySize: 2, StartTime: startTime, Duration: duration, Status: model.ReservationConfirmed, Overbooked: true, OverbookedTables: 1}
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockReservationRepo.EXPECT().FindReservationById("r1", "res-5").Return(&original, nil).Times(1)
+		mockTableRepo.EXPECT().Tables("r1").Return(tables).Times(1)
+		mockTableRepo.EXPECT().FreeTables("r1", startTime, startTime.Add(duration)).Return(tables[3:]).Times(1)
+		mockTableRepo.EXPECT().CancelReservedTable("r1", "res-5").Return(nil).Times(1)
+		mockTableRepo.EXPECT().ReserveTables(gomock.Any()).Return(nil).Times(1)
+		mockReservationRepo.EXPECT().UpdateReservation(gomock.Any()).Return(nil).Times(1)
+
+		res := send(requests, model.EventRequest{Action: "modify", ResID: "res-5", PartySize: 3})
+
+		reservation := res.(model.Reservation)
+		assert.False(t, reservation.Overbooked)
+		assert.Zero(t, reservation.OverbookedTables)
+		assert.Equal(t, []string{"T4"}, reservation.TableIds)
+	})
+	t.Run("ResizeReseatsOverbooked", func(t *testing.T) {
+		mockTableRepo, mockReservationRepo, requests := setup(t)
+		overbooked := model.Reservation{Id: "res-5", RestaurantId: "r1", PartySize: 2, StartTime: startTime, Duration: duration, Status: model.ReservationConfirmed, Overbooked: true, OverbookedTables: 1}
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		// An overbooked reservation holds no tables, so no inventory change conflicts with it.
+		mockTableRepo.EXPECT().ReservationsBetween("r1", gomock.Any(), gomock.Any()).Return([]model.Reservation{overbooked}).Times(2)
+		mockTableRepo.EXPECT().ReplaceTables("r1", tables).Return(nil).Times(1)
+		// The added table goes to the overbooked party.
+		mockTableRepo.EXPECT().FreeTables("r1", startTime, startTime.Add(duration)).Return(tables[3:]).Times(1)
+		reseated := overbooked
+		reseated.NumTables = 1
+		reseated.TableIds = []string{"T4"}
+		reseated.Overbooked = false
+		reseated.OverbookedTables = 0
+		mockTableRepo.EXPECT().ReserveTables(reseated).Return(nil).Times(1)
+		mockReservationRepo.EXPECT().UpdateReservation(reseated).Return(nil).Times(1)
+
+		res := send(requests, model.EventRequest{Action: "resize", Tables: tables})
+
+		assert.Nil(t, res)
+	})
+	t.Run("SeatOverbooked", func(t *testing.T) {
+		mockTableRepo, mockReservationRepo, requests := setup(t)
+		overbooked := model.Reservation{Id: "res-5", RestaurantId: "r1", PartySize: 2, StartTime: startTime, Duration: duration, Status: model.ReservationConfirmed, Overbooked: true, OverbookedTables: 1}
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockReservationRepo.EXPECT().FindReservationById("r1", "res-5").Return(&overbooked, nil).Times(1)
+		mockTableRepo.EXPECT().FreeTables("r1", startTime, startTime.Add(duration)).Return(tables[2:]).Times(1)
+		mockTableRepo.EXPECT().ReserveTables(gomock.Any()).Return(nil).Times(1)
+		mockReservationRepo.EXPECT().UpdateReservation(gomock.Any()).Return(nil).Times(1)
+
+		res := send(requests, model.EventRequest{Action: "seat", ResID: "res-5"})
+
+		reservation := res.(model.Reservation)
+		assert.Equal(t, model.ReservationSeated, reservation.Status)
+		assert.False(t, reservation.Overbooked)
+		assert.Equal(t, 1, reservation.NumTables)
+		assert.Len(t, reservation.TableIds, 1)
+	})
+	t.Run("SeatOverbookedWithoutFreeTable", func(t *testing.T) {
+		mockTableRepo, mockReservationRepo, requests := setup(t)
+		overbooked := model.Reservation{Id: "res-5", RestaurantId: "r1", PartySize: 2, StartTime: startTime, Duration: duration, Status: model.ReservationConfirmed, Overbooked: true, OverbookedTables: 1}
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockReservationRepo.EXPECT().FindReservationById("r1", "res-5").Return(&overbooked, nil).Times(1)
+		mockTableRepo.EXPECT().FreeTables("r1", startTime, startTime.Add(duration)).Return([]model.Table{}).Times(1)
+
+		res := send(requests, model.EventRequest{Action: "seat", ResID: "res-5"})
+
+		assert.ErrorIs(t, res.(error), service.ErrNotEnoughTables)
+		assert.EqualError(t, res.(error), "not enough tables available to seat the overbooked reservation")
+	})
+	t.Run("CancelReseatsOverbooked", func(t *testing.T) {
+		mockTableRepo, mockReservationRepo, requests := setup(t)
+		booked := model.Reservation{Id: "res-1", RestaurantId: "r1", PartySize: 2, NumTables: 1, TableIds: []string{"T1"}, StartTime: startTime, Duration: duration, Status: model.ReservationConfirmed}
+		overbooked := model.Reservation{Id: "res-5", RestaurantId: "r1", PartySize: 2, StartTime: startTime, Duration: duration, Status: model.ReservationConfirmed, Overbooked: true, OverbookedTables: 1}
+		mockTableRepo.EXPECT().IsTableInitialized("r1").Return(true).Times(1)
+		mockReservationRepo.EXPECT().FindReservationById("r1", "res-1").Return(&booked, nil).Times(1)
+		mockTableRepo.EXPECT().CancelReservedTable("r1", "res-1").Return(nil).Times(1)
+		mockReservationRepo.EXPECT().UpdateReservation(gomock.Any()).DoAndReturn(func(reservation model.Reservation) error {
+			assert.Equal(t, "res-1", reservation.Id)
+			return nil
+		}).Times(1)
+		mockTableRepo.EXPECT().ReservationsBetween("r1", gomock.Any(), gomock.Any()).Return([]model.Reservation{overbooked}).Times(1)
+		mockTableRepo.EXPECT().FreeTables("r1", startTime, startTime.Add(duration)).Return(tables[:1]).Times(1)
+		mockTableRepo.EXPECT().ReserveTables(gomock.Any()).DoAndReturn(func(reservation model.Reservation) error {
+			assert.Equal(t, "res-5", reservation.Id)
+			assert.Equal(t, []string{"T1"}, reservation.TableIds)
+			assert.False(t, reservation.Overbooked)
+			return nil
+		}).Times(1)
+		mockReservationRepo.EXPECT().UpdateReservation(gomock.Any()).DoAndReturn(func(reservation model.Reservation) error {
+			assert.Equal(t, "res-5", reservation.Id)
+			return nil
+		}).Times(1)
+
+		res := send(requests, model.EventRequest{Action: "cancel", ResID: "res-1"})
+
+		assert.Equal(t, model.ReservationCancelled, res.(model.Reservation).Status)
+	})
 }
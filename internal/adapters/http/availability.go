@@ -0,0 +1,97 @@
+package http
+
+import (
+	"errors"
+	"github.com/bossncn/go-common/http/echo/response"
+	"github.com/bossncn/go-common/http/model"
+	"github.com/bossncn/go-common/http/model/error_code"
+	"github.com/bossncn/restaurant-reservation-service/internal/adapters/dto"
+	coreModel "github.com/bossncn/restaurant-reservation-service/internal/core/model"
+	"github.com/bossncn/restaurant-reservation-service/internal/core/service"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+	"time"
+)
+
+type AvailabilityHandler struct {
+	logger              *zap.Logger
+	availabilityService service.AvailabilityService
+}
+
+func NewAvailabilityHandler(logger *zap.Logger, service *Service) *AvailabilityHandler {
+	return &AvailabilityHandler{
+		logger:              logger,
+		availabilityService: service.AvailabilityService,
+	}
+}
+
+func (handler *AvailabilityHandler) RegisterRoutes(publicRoute *echo.Group) {
+	publicRoute.GET("/availability", handler.SearchAvailability)
+}
+
+// SearchAvailability
+// @Summary Search availability
+// @Description Lists the start times between from and to, every 15 minutes in the restaurant's time zone, at which the party could book, with the tables it would be seated at. Nothing is booked. Without from and to the whole day is searched; with only from, just that time. Times outside the public booking window are not offered. When none of the requested times are bookable, up to three of the nearest bookable times that day are returned as alternatives.
+// @Tags Availability
+// @Produce json
+// @Param restaurantId path string true "Restaurant ID"
+// @Param party_size query int true "Number of customers in the group."
+// @Param date query string true "Day to search (YYYY-MM-DD)."
+// @Param from query string false "Earliest start time (HH:MM)."
+// @Param to query string false "Latest start time (HH:MM)."
+// @Param duration_minutes query int false "Overrides the turn time for the party size."
+// @Param section query string false "Only offer tables in this section."
+// @Param features query []string false "Only offer tables with every one of these features."
+// @Success 200 {object} model.Response{data=dto.AvailabilityResponse} "Bookable slots."
+// @Failure 400 {object} model.Response{data=[]dto.FieldErrorResponse} "Invalid search."
+// @Router /public/restaurants/{restaurantId}/availability [get]
+func (handler *AvailabilityHandler) SearchAvailability(ctx echo.Context) error {
+	var req dto.AvailabilityRequest
+	if err := ctx.Bind(&req); err != nil {
+		handler.logger.Error("Failed to bind request", zap.Error(err))
+		return response.Response(ctx, nil, errors.New(error_code.InvalidRequest))
+	}
+
+	if fieldErrors := validateRequest(req); fieldErrors != nil {
+		handler.logger.Error("Invalid availability request", zap.Any("fieldErrors", fieldErrors))
+		return response.Response(ctx, model.CreateError(error_code.InvalidRequest, fieldErrors), errors.New(error_code.InvalidRequest))
+	}
+
+	query := coreModel.AvailabilityQuery{
+		RestaurantId: ctx.Param("restaurantId"),
+		PartySize:    req.PartySize,
+		Date:         req.Date,
+		To:           24*time.Hour - service.SlotInterval,
+		Duration:     time.Duration(req.DurationMinutes) * time.Minute,
+		Requirements: coreModel.Seating{Section: req.Section, Features: req.Features},
+		Channel:      coreModel.ChannelPublic,
+	}
+	if req.From != "" {
+		query.From = parseTimeOfDay(req.From)
+		query.To = query.From
+	}
+	if req.To != "" {
+		query.To = parseTimeOfDay(req.To)
+	}
+
+	availability, err := handler.availabilityService.SearchAvailability(query)
+	if err != nil {
+		handler.logger.Error("Failed to search availability", zap.Error(err))
+		return response.Response(ctx, model.CreateError(error_code.InvalidRequest, err.Error()), err)
+	}
+
+	return response.Response(ctx, dto.AvailabilityResponse{
+		PartySize:    req.PartySize,
+		Date:         req.Date,
+		Slots:        slotResponses(availability.Slots),
+		Alternatives: slotResponses(availability.Alternatives),
+	}, nil)
+}
+
+func slotResponses(slots []coreModel.Slot) []dto.SlotResponse {
+	responses := make([]dto.SlotResponse, 0, len(slots))
+	for _, slot := range slots {
+		responses = append(responses, dto.SlotResponse{StartTime: slot.StartTime, EndTime: slot.EndTime, TableIds: slot.TableIds})
+	}
+	return responses
+}
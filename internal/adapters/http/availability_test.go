@@ -0,0 +1,141 @@
+package http_test
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/bossncn/go-common/http/model"
+	"github.com/bossncn/restaurant-reservation-service/internal/adapters/http"
+	coreModel "github.com/bossncn/restaurant-reservation-service/internal/core/model"
+	serviceMock "github.com/bossncn/restaurant-reservation-service/internal/core/service/mock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	netHttp "net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestAvailabilityHandler(t *testing.T) {
+	startTime := time.Date(2025, 1, 10, 19, 0, 0, 0, time.UTC)
+
+	newContext := func(query string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(netHttp.MethodGet, "/availability?"+query, nil)
+		rec := httptest.NewRecorder()
+		e := echo.New()
+		ctx := e.NewContext(req, rec)
+		ctx.SetParamNames("restaurantId")
+		ctx.SetParamValues("r1")
+		return ctx, rec
+	}
+
+	t.Run("SearchAvailability", func(t *testing.T) {
+		t.Run("Success", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock dependencies
+			mockAvailabilityService := serviceMock.NewMockAvailabilityService(ctrl)
+			logger := zap.NewNop()
+			handler := http.NewAvailabilityHandler(logger, &http.Service{AvailabilityService: mockAvailabilityService})
+
+			// Set up Echo mock context
+			ctx, rec := newContext("party_size=4&date=2025-01-10&from=18:00&to=20:30")
+
+			// Mock behavior
+			query := coreModel.AvailabilityQuery{RestaurantId: "r1", PartySize: 4, Date: "2025-01-10", From: 18 * time.Hour, To: 20*time.Hour + 30*time.Minute, Channel: coreModel.ChannelPublic}
+			slot := coreModel.Slot{StartTime: startTime, EndTime: startTime.Add(2 * time.Hour), TableIds: []string{"T1", "T2"}}
+			mockAvailabilityService.EXPECT().SearchAvailability(query).Return(&coreModel.Availability{Slots: []coreModel.Slot{slot}, Alternatives: []coreModel.Slot{}}, nil).Times(1)
+
+			// Execute handler
+			err := handler.SearchAvailability(ctx)
+
+			// Assertions
+			assert.NoError(t, err)
+			assert.Equal(t, netHttp.StatusOK, rec.Code)
+
+			var res model.Response
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			slots := res.Data.(map[string]interface{})["slots"].([]interface{})
+			assert.Len(t, slots, 1)
+			assert.Equal(t, "2025-01-10T19:00:00Z", slots[0].(map[string]interface{})["start_time"])
+			assert.Equal(t, []interface{}{"T1", "T2"}, slots[0].(map[string]interface{})["table_ids"])
+			assert.Empty(t, res.Data.(map[string]interface{})["alternatives"])
+		})
+		t.Run("DefaultRange", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock dependencies
+			mockAvailabilityService := serviceMock.NewMockAvailabilityService(ctrl)
+			logger := zap.NewNop()
+			handler := http.NewAvailabilityHandler(logger, &http.Service{AvailabilityService: mockAvailabilityService})
+
+			// Mock behavior
+			wholeDay := coreModel.AvailabilityQuery{RestaurantId: "r1", PartySize: 2, Date: "2025-01-10", To: 23*time.Hour + 45*time.Minute, Channel: coreModel.ChannelPublic}
+			singleTime := coreModel.AvailabilityQuery{RestaurantId: "r1", PartySize: 2, Date: "2025-01-10", From: 19 * time.Hour, To: 19 * time.Hour, Channel: coreModel.ChannelPublic}
+			mockAvailabilityService.EXPECT().SearchAvailability(wholeDay).Return(&coreModel.Availability{}, nil).Times(1)
+			mockAvailabilityService.EXPECT().SearchAvailability(singleTime).Return(&coreModel.Availability{}, nil).Times(1)
+
+			// Execute handler
+			ctx, rec := newContext("party_size=2&date=2025-01-10")
+			assert.NoError(t, handler.SearchAvailability(ctx))
+			assert.Equal(t, netHttp.StatusOK, rec.Code)
+
+			ctx, rec = newContext("party_size=2&date=2025-01-10&from=19:00")
+			assert.NoError(t, handler.SearchAvailability(ctx))
+			assert.Equal(t, netHttp.StatusOK, rec.Code)
+		})
+		t.Run("InvalidQuery", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock dependencies
+			mockAvailabilityService := serviceMock.NewMockAvailabilityService(ctrl)
+			logger := zap.NewNop()
+			handler := http.NewAvailabilityHandler(logger, &http.Service{AvailabilityService: mockAvailabilityService})
+
+			// Set up Echo mock context
+			ctx, rec := newContext("date=10/01/2025&from=7pm")
+
+			// Execute handler
+			err := handler.SearchAvailability(ctx)
+
+			// Assertions
+			assert.NoError(t, err)
+			assert.Equal(t, netHttp.StatusBadRequest, rec.Code)
+
+			var res model.Response
+			_ = json.Unmarshal(rec.Body.Bytes(), &res)
+			fieldErrors := res.Data.([]interface{})
+			assert.Len(t, fieldErrors, 3)
+			assert.Equal(t, "party_size", fieldErrors[0].(map[string]interface{})["field"])
+			assert.Equal(t, "date", fieldErrors[1].(map[string]interface{})["field"])
+			assert.Equal(t, "from", fieldErrors[2].(map[string]interface{})["field"])
+		})
+		t.Run("ServiceError", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock dependencies
+			mockAvailabilityService := serviceMock.NewMockAvailabilityService(ctrl)
+			logger := zap.NewNop()
+			handler := http.NewAvailabilityHandler(logger, &http.Service{AvailabilityService: mockAvailabilityService})
+
+			// Set up Echo mock context
+			ctx, rec := newContext("party_size=2&date=2025-01-10&from=20:00&to=18:00")
+
+			// Mock behavior
+			mockAvailabilityService.EXPECT().SearchAvailability(gomock.Any()).Return(nil, errors.New("from must not be after to")).Times(1)
+
+			// Execute handler
+			err := handler.SearchAvailability(ctx)
+
+			// Assertions
+			assert.NoError(t, err)
+			assert.Equal(t, netHttp.StatusBadRequest, rec.Code)
+		})
+	})
+}
@@ -0,0 +1,36 @@
+package http
+
+import (
+	"errors"
+	"github.com/bossncn/go-common/http/model/error_code"
+	coreModel "github.com/bossncn/restaurant-reservation-service/internal/core/model"
+	"github.com/bossncn/restaurant-reservation-service/internal/core/service"
+)
+
+// Error codes for booking rule violations and declined payments, so clients can tell them apart
+// from other invalid requests.
+const (
+	BookingTooSoon     = "ERR01001"
+	BookingTooFarAhead = "ERR01002"
+	PaymentDeclined    = "ERR01003"
+	RestaurantClosed   = "ERR01004"
+	PacingLimitReached = "ERR01005"
+)
+
+// errorCode returns the error code reported for a failed booking.
+func errorCode(err error) string {
+	switch {
+	case errors.Is(err, coreModel.ErrBookingTooSoon):
+		return BookingTooSoon
+	case errors.Is(err, coreModel.ErrBookingTooFarAhead):
+		return BookingTooFarAhead
+	case errors.Is(err, service.ErrPaymentDeclined):
+		return PaymentDeclined
+	case errors.Is(err, coreModel.ErrRestaurantClosed):
+		return RestaurantClosed
+	case errors.Is(err, coreModel.ErrPacingLimitReached):
+		return PacingLimitReached
+	default:
+		return error_code.InvalidRequest
+	}
+}
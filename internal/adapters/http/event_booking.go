@@ -0,0 +1,85 @@
+package http
+
+import (
+	"errors"
+	"github.com/bossncn/go-common/http/echo/response"
+	"github.com/bossncn/go-common/http/model"
+	"github.com/bossncn/go-common/http/model/error_code"
+	"github.com/bossncn/restaurant-reservation-service/internal/adapters/dto"
+	coreModel "github.com/bossncn/restaurant-reservation-service/internal/core/model"
+	"github.com/bossncn/restaurant-reservation-service/internal/core/service"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+// BookEvent
+// @Summary Book a private event
+// @Description Books a whole section, or the whole venue when no section is given, for a private event or buyout. The event is stored as a reservation of type event holding every table in scope, so regular bookings overlapping it are turned away. It is refused, listing the conflicting reservations, when any of those tables is already booked during the event.
+// @Tags Reservation
+// @Accept json
+// @Produce json
+// @Param restaurantId path string true "Restaurant ID"
+// @Param request body dto.EventBookingRequest true "Headcount, contact, section, time range and deposit status of the event."
+// @Success 200 {object} model.Response{data=dto.ReservationResponse} "Event booked"
+// @Failure 400 {object} model.Response{data=dto.InventoryConflictResponse} "Tables are already booked during the event"
+// @Router /secure/restaurants/{restaurantId}/events [post]
+func (handler *ReservationHandler) BookEvent(ctx echo.Context) error {
+	var req dto.EventBookingRequest
+	if err := ctx.Bind(&req); err != nil {
+		handler.logger.Error("Failed to bind request", zap.Error(err))
+		return response.Response(ctx, nil, errors.New(error_code.InvalidRequest))
+	}
+
+	if fieldErrors := validateRequest(req); fieldErrors != nil {
+		handler.logger.Error("Invalid event booking request", zap.Any("fieldErrors", fieldErrors))
+		return response.Response(ctx, model.CreateError(error_code.InvalidRequest, fieldErrors), errors.New(error_code.InvalidRequest))
+	}
+
+	contact := coreModel.Guest{Name: req.Contact.Name, Phone: req.Contact.Phone, Email: req.Contact.Email, Notes: req.Contact.Notes}
+	reservation, err := handler.reservationService.BookEvent(ctx.Param("restaurantId"), req.Headcount, contact, req.Section, req.StartTime, req.EndTime, coreModel.DepositStatus(req.DepositStatus))
+
+	var conflictErr *service.InventoryConflictError
+	if errors.As(err, &conflictErr) {
+		handler.logger.Info("Event booking conflicts with reservations", zap.Int("reservations", len(conflictErr.Reservations)))
+		return response.Response(ctx, model.CreateError(error_code.InvalidRequest, inventoryConflictResponse(conflictErr)), err)
+	}
+	if err != nil {
+		handler.logger.Error("Failed to book event", zap.Error(err))
+		return response.Response(ctx, model.CreateError(error_code.InvalidRequest, err.Error()), err)
+	}
+
+	return response.Response(ctx, handler.reservationResponse(reservation), nil)
+}
+
+// UpdateDepositStatus
+// @Summary Update an event's deposit status
+// @Description Records whether the deposit of an event booking is pending or paid.
+// @Tags Reservation
+// @Accept json
+// @Produce json
+// @Param restaurantId path string true "Restaurant ID"
+// @Param id path string true "Booking ID"
+// @Param request body dto.DepositStatusRequest true "New deposit status"
+// @Success 200 {object} model.Response{data=dto.ReservationResponse} "Deposit status updated"
+// @Failure 400 {object} model.Response{} "Booking not found or not an event"
+// @Router /secure/restaurants/{restaurantId}/events/{id}/deposit [put]
+func (handler *ReservationHandler) UpdateDepositStatus(ctx echo.Context) error {
+	var req dto.DepositStatusRequest
+	if err := ctx.Bind(&req); err != nil {
+		handler.logger.Error("Failed to bind request", zap.Error(err))
+		return response.Response(ctx, nil, errors.New(error_code.InvalidRequest))
+	}
+
+	if fieldErrors := validateRequest(req); fieldErrors != nil {
+		handler.logger.Error("Invalid deposit status request", zap.Any("fieldErrors", fieldErrors))
+		return response.Response(ctx, model.CreateError(error_code.InvalidRequest, fieldErrors), errors.New(error_code.InvalidRequest))
+	}
+
+	reservation, err := handler.reservationService.UpdateDepositStatus(ctx.Param("restaurantId"), ctx.Param("id"), coreModel.DepositStatus(req.Status))
+	if err != nil {
+		handler.logger.Error("Failed to update deposit status", zap.Error(err))
+		return response.Response(ctx, model.CreateError(error_code.InvalidRequest, err.Error()), err)
+	}
+
+	return response.Response(ctx, handler.reservationResponse(reservation), nil)
+}
@@ -0,0 +1,204 @@
+package http_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"github.com/bossncn/go-common/http/model"
+	"github.com/bossncn/restaurant-reservation-service/internal/adapters/dto"
+	"github.com/bossncn/restaurant-reservation-service/internal/adapters/http"
+	coreModel "github.com/bossncn/restaurant-reservation-service/internal/core/model"
+	"github.com/bossncn/restaurant-reservation-service/internal/core/service"
+	serviceMock "github.com/bossncn/restaurant-reservation-service/internal/core/service/mock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	netHttp "net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestEventBookingHandler(t *testing.T) {
+	start := time.Date(2025, 1, 10, 18, 0, 0, 0, time.UTC)
+	end := start.Add(4 * time.Hour)
+	contact := coreModel.Guest{Name: "Sam Lee", Phone: "+66812345678"}
+
+	newContext := func(method string, path string, body string, params ...string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(method, path, bytes.NewReader([]byte(body)))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		e := echo.New()
+		ctx := e.NewContext(req, rec)
+		ctx.SetParamNames(append([]string{"restaurantId"}, params...)...)
+		ctx.SetParamValues("r1", "res-1")
+		return ctx, rec
+	}
+
+	t.Run("BookEvent", func(t *testing.T) {
+		body := `{"headcount": 40, "contact": {"name": "Sam Lee", "phone": "+66812345678"}, "section": "private_room", "start_time": "2025-01-10T18:00:00Z", "end_time": "2025-01-10T22:00:00Z", "deposit_status": "pending"}`
+
+		t.Run("Success", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock dependencies
+			mockReservationService := serviceMock.NewMockReservationService(ctrl)
+			mockTableService := serviceMock.NewMockTableService(ctrl)
+			logger := zap.NewNop()
+			handler := http.NewReservationHandler(logger, &http.Service{
+				ReservationService: mockReservationService,
+				TableService:       mockTableService,
+			})
+
+			// Set up Echo mock context
+			ctx, rec := newContext(netHttp.MethodPost, "/events", body)
+
+			// Mock behavior
+			reservation := &coreModel.Reservation{Id: "res-1", RestaurantId: "r1", PartySize: 40, Guest: contact, NumTables: 2, TableIds: []string{"P1", "P2"}, StartTime: start, Duration: 4 * time.Hour, Status: coreModel.ReservationConfirmed, Requirements: coreModel.Seating{Section: coreModel.SectionPrivateRoom}, Type: coreModel.ReservationTypeEvent, DepositStatus: coreModel.DepositPending}
+			mockReservationService.EXPECT().BookEvent("r1", 40, contact, coreModel.SectionPrivateRoom, start, end, coreModel.DepositPending).Return(reservation, nil).Times(1)
+			mockTableService.EXPECT().AvailableTables("r1", start, end).Return(3).Times(1)
+			mockTableService.EXPECT().Tables("r1").Return([]coreModel.Table{{Id: "P1", Capacity: 6}, {Id: "P2", Capacity: 6}}).Times(1)
+
+			// Execute handler
+			err := handler.BookEvent(ctx)
+
+			// Assertions
+			assert.NoError(t, err)
+			assert.Equal(t, netHttp.StatusOK, rec.Code)
+
+			var res struct {
+				Data dto.ReservationResponse `json:"data"`
+			}
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, "event", res.Data.Type)
+			assert.Equal(t, "pending", res.Data.DepositStatus)
+			assert.Equal(t, "private_room", res.Data.Requirements.Section)
+			assert.Equal(t, []string{"P1", "P2"}, res.Data.TableIds)
+		})
+		t.Run("ValidationError", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock dependencies
+			mockReservationService := serviceMock.NewMockReservationService(ctrl)
+			logger := zap.NewNop()
+			handler := http.NewReservationHandler(logger, &http.Service{ReservationService: mockReservationService})
+
+			// Set up Echo mock context
+			ctx, rec := newContext(netHttp.MethodPost, "/events", `{"headcount": 0, "contact": {"name": "Sam Lee", "phone": "+66812345678"}, "section": "rooftop", "start_time": "2025-01-10T18:00:00Z", "end_time": "2025-01-10T22:00:00Z"}`)
+
+			// Execute handler
+			err := handler.BookEvent(ctx)
+
+			// Assertions
+			assert.NoError(t, err)
+			assert.Equal(t, netHttp.StatusBadRequest, rec.Code)
+
+			var res struct {
+				Data []dto.FieldErrorResponse `json:"data"`
+			}
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, []dto.FieldErrorResponse{
+				{Field: "headcount", Message: "must be greater than 0"},
+				{Field: "section", Message: "must be one of main_room patio bar private_room"},
+			}, res.Data)
+		})
+		t.Run("Conflict", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock dependencies
+			mockReservationService := serviceMock.NewMockReservationService(ctrl)
+			logger := zap.NewNop()
+			handler := http.NewReservationHandler(logger, &http.Service{ReservationService: mockReservationService})
+
+			// Set up Echo mock context
+			ctx, rec := newContext(netHttp.MethodPost, "/events", body)
+
+			// Mock behavior
+			conflict := coreModel.Reservation{Id: "res-9", PartySize: 4, TableIds: []string{"P1"}, StartTime: start, Duration: 2 * time.Hour}
+			mockReservationService.EXPECT().BookEvent("r1", 40, contact, coreModel.SectionPrivateRoom, start, end, coreModel.DepositPending).Return(nil, &service.InventoryConflictError{Reservations: []coreModel.Reservation{conflict}}).Times(1)
+
+			// Execute handler
+			err := handler.BookEvent(ctx)
+
+			// Assertions
+			assert.NoError(t, err)
+			assert.Equal(t, netHttp.StatusBadRequest, rec.Code)
+
+			var res struct {
+				Data dto.InventoryConflictResponse `json:"data"`
+			}
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, "res-9", res.Data.Reservations[0].BookingId)
+		})
+	})
+	t.Run("UpdateDepositStatus", func(t *testing.T) {
+		t.Run("Success", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock dependencies
+			mockReservationService := serviceMock.NewMockReservationService(ctrl)
+			mockTableService := serviceMock.NewMockTableService(ctrl)
+			logger := zap.NewNop()
+			handler := http.NewReservationHandler(logger, &http.Service{
+				ReservationService: mockReservationService,
+				TableService:       mockTableService,
+			})
+
+			// Set up Echo mock context
+			ctx, rec := newContext(netHttp.MethodPut, "/events/res-1/deposit", `{"status": "paid"}`, "id")
+
+			// Mock behavior
+			reservation := &coreModel.Reservation{Id: "res-1", RestaurantId: "r1", StartTime: start, Duration: 4 * time.Hour, Status: coreModel.ReservationConfirmed, Type: coreModel.ReservationTypeEvent, DepositStatus: coreModel.DepositPaid}
+			mockReservationService.EXPECT().UpdateDepositStatus("r1", "res-1", coreModel.DepositPaid).Return(reservation, nil).Times(1)
+			mockTableService.EXPECT().AvailableTables("r1", start, end).Return(0).Times(1)
+			mockTableService.EXPECT().Tables("r1").Return(nil).Times(1)
+
+			// Execute handler
+			err := handler.UpdateDepositStatus(ctx)
+
+			// Assertions
+			assert.NoError(t, err)
+			assert.Equal(t, netHttp.StatusOK, rec.Code)
+
+			var res model.Response
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, "paid", res.Data.(map[string]interface{})["deposit_status"])
+		})
+		t.Run("ServiceError", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock dependencies
+			mockReservationService := serviceMock.NewMockReservationService(ctrl)
+			logger := zap.NewNop()
+			handler := http.NewReservationHandler(logger, &http.Service{ReservationService: mockReservationService})
+
+			// Set up Echo mock context
+			ctx, rec := newContext(netHttp.MethodPut, "/events/res-1/deposit", `{"status": "paid"}`, "id")
+
+			// Mock behavior
+			mockReservationService.EXPECT().UpdateDepositStatus("r1", "res-1", coreModel.DepositPaid).Return(nil, errors.New("deposits are only tracked on event bookings")).Times(1)
+
+			// Execute handler
+			err := handler.UpdateDepositStatus(ctx)
+
+			// Assertions
+			assert.NoError(t, err)
+			assert.Equal(t, netHttp.StatusBadRequest, rec.Code)
+
+			var res model.Response
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, "deposits are only tracked on event bookings", res.Data)
+		})
+	})
+}
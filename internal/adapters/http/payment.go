@@ -0,0 +1,44 @@
+package http
+
+import (
+	"errors"
+	"github.com/bossncn/go-common/http/echo/response"
+	"github.com/bossncn/go-common/http/model"
+	"github.com/bossncn/go-common/http/model/error_code"
+	"github.com/bossncn/restaurant-reservation-service/internal/adapters/dto"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+// PayDeposit
+// @Summary Pay a booking's deposit
+// @Description Pays the deposit of a booking in pending_payment status and confirms it. Large parties and bookings on holidays must pay a deposit before the payment deadline, or the tables are released. A declined payment fails with code ERR01003 and releases the tables straight away.
+// @Tags Reservation
+// @Accept json
+// @Produce json
+// @Param restaurantId path string true "Restaurant ID"
+// @Param id path string true "Booking ID"
+// @Param request body dto.PaymentRequest true "The payment method to pay with."
+// @Success 200 {object} model.Response{data=dto.ReservationResponse} "Deposit paid and booking confirmed."
+// @Failure 400 {object} model.Response{} "Payment declined, payment deadline passed or booking not awaiting payment."
+// @Router /public/restaurants/{restaurantId}/reservations/{id}/payment [post]
+func (handler *ReservationHandler) PayDeposit(ctx echo.Context) error {
+	var req dto.PaymentRequest
+	if err := ctx.Bind(&req); err != nil {
+		handler.logger.Error("Failed to bind request", zap.Error(err))
+		return response.Response(ctx, nil, errors.New(error_code.InvalidRequest))
+	}
+
+	if fieldErrors := validateRequest(req); fieldErrors != nil {
+		handler.logger.Error("Invalid payment request", zap.Any("fieldErrors", fieldErrors))
+		return response.Response(ctx, model.CreateError(error_code.InvalidRequest, fieldErrors), errors.New(error_code.InvalidRequest))
+	}
+
+	reservation, err := handler.reservationService.PayDeposit(ctx.Param("restaurantId"), ctx.Param("id"), req.Token)
+	if err != nil {
+		handler.logger.Error("Failed to pay deposit", zap.Error(err))
+		return response.Response(ctx, model.CreateError(errorCode(err), err.Error()), err)
+	}
+
+	return response.Response(ctx, handler.reservationResponse(reservation), nil)
+}
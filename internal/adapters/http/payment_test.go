@@ -0,0 +1,140 @@
+package http_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/bossncn/go-common/http/model"
+	"github.com/bossncn/go-common/http/model/error_code"
+	"github.com/bossncn/restaurant-reservation-service/internal/adapters/dto"
+	"github.com/bossncn/restaurant-reservation-service/internal/adapters/http"
+	coreModel "github.com/bossncn/restaurant-reservation-service/internal/core/model"
+	"github.com/bossncn/restaurant-reservation-service/internal/core/service"
+	serviceMock "github.com/bossncn/restaurant-reservation-service/internal/core/service/mock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	netHttp "net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestPaymentHandler(t *testing.T) {
+	startTime := time.Date(2025, 1, 10, 19, 0, 0, 0, time.UTC)
+
+	newContext := func(body string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(netHttp.MethodPost, "/reservations/res-1/payment", bytes.NewReader([]byte(body)))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		e := echo.New()
+		ctx := e.NewContext(req, rec)
+		ctx.SetParamNames("restaurantId", "id")
+		ctx.SetParamValues("r1", "res-1")
+		return ctx, rec
+	}
+
+	t.Run("PayDeposit", func(t *testing.T) {
+		t.Run("Success", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock dependencies
+			mockReservationService := serviceMock.NewMockReservationService(ctrl)
+			mockTableService := serviceMock.NewMockTableService(ctrl)
+			logger := zap.NewNop()
+			handler := http.NewReservationHandler(logger, &http.Service{
+				ReservationService: mockReservationService,
+				TableService:       mockTableService,
+			})
+
+			// Set up Echo mock context
+			ctx, rec := newContext(`{"token": "tok_visa"}`)
+
+			// Mock behavior
+			reservation := &coreModel.Reservation{Id: "res-1", RestaurantId: "r1", PartySize: 6, NumTables: 2, TableIds: []string{"T1", "T2"}, StartTime: startTime, Duration: 2 * time.Hour, Status: coreModel.ReservationConfirmed, Deposit: 6000, DepositStatus: coreModel.DepositPaid, PaymentId: "pay-1"}
+			mockReservationService.EXPECT().PayDeposit("r1", "res-1", "tok_visa").Return(reservation, nil).Times(1)
+			mockTableService.EXPECT().AvailableTables("r1", startTime, startTime.Add(2*time.Hour)).Return(2).Times(1)
+			mockTableService.EXPECT().Tables("r1").Return([]coreModel.Table{{Id: "T1", Capacity: 4}, {Id: "T2", Capacity: 4}}).Times(1)
+
+			// Execute handler
+			err := handler.PayDeposit(ctx)
+
+			// Assertions
+			assert.NoError(t, err)
+			assert.Equal(t, netHttp.StatusOK, rec.Code)
+
+			var res struct {
+				Data dto.ReservationResponse `json:"data"`
+			}
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, "confirmed", res.Data.Status)
+			assert.Equal(t, "paid", res.Data.DepositStatus)
+			assert.Equal(t, 6000, res.Data.DepositAmount)
+			assert.Nil(t, res.Data.PaymentDueAt)
+		})
+		t.Run("Declined", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock dependencies
+			mockReservationService := serviceMock.NewMockReservationService(ctrl)
+			mockTableService := serviceMock.NewMockTableService(ctrl)
+			logger := zap.NewNop()
+			handler := http.NewReservationHandler(logger, &http.Service{
+				ReservationService: mockReservationService,
+				TableService:       mockTableService,
+			})
+
+			// Set up Echo mock context
+			ctx, rec := newContext(`{"token": "tok_declined"}`)
+
+			// Mock behavior
+			declined := fmt.Errorf("%w, the tables have been released", service.ErrPaymentDeclined)
+			mockReservationService.EXPECT().PayDeposit("r1", "res-1", "tok_declined").Return(nil, declined).Times(1)
+
+			// Execute handler
+			err := handler.PayDeposit(ctx)
+
+			// Assertions
+			assert.NoError(t, err)
+			assert.Equal(t, netHttp.StatusBadRequest, rec.Code)
+
+			var res model.Response
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, http.PaymentDeclined, res.Code)
+			assert.Equal(t, "payment declined, the tables have been released", res.Data)
+		})
+		t.Run("ValidationError", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock dependencies
+			mockReservationService := serviceMock.NewMockReservationService(ctrl)
+			mockTableService := serviceMock.NewMockTableService(ctrl)
+			logger := zap.NewNop()
+			handler := http.NewReservationHandler(logger, &http.Service{
+				ReservationService: mockReservationService,
+				TableService:       mockTableService,
+			})
+
+			// Set up Echo mock context
+			ctx, rec := newContext(`{}`)
+
+			// Execute handler
+			err := handler.PayDeposit(ctx)
+
+			// Assertions
+			assert.NoError(t, err)
+			assert.Equal(t, netHttp.StatusBadRequest, rec.Code)
+
+			var res model.Response
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, error_code.InvalidRequest, res.Code)
+		})
+	})
+}
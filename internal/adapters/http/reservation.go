@@ -6,9 +6,12 @@ import (
 	"github.com/bossncn/go-common/http/model"
 	"github.com/bossncn/go-common/http/model/error_code"
 	"github.com/bossncn/restaurant-reservation-service/internal/adapters/dto"
+	coreModel "github.com/bossncn/restaurant-reservation-service/internal/core/model"
 	"github.com/bossncn/restaurant-reservation-service/internal/core/service"
 	"github.com/labstack/echo/v4"
 	"go.uber.org/zap"
+	"math"
+	"time"
 )
 
 type ReservationHandler struct {
@@ -25,56 +28,325 @@ func NewReservationHandler(logger *zap.Logger, service *Service) *ReservationHan
 	}
 }
 
-func (handler *ReservationHandler) RegisterRoutes(_ *echo.Group, secureRoute *echo.Group) {
+func (handler *ReservationHandler) RegisterRoutes(publicRoute *echo.Group, secureRoute *echo.Group) {
+	publicRoute.POST("/reservations", handler.ReserveOnline)
+	publicRoute.POST("/reservations/:id/payment", handler.PayDeposit)
+
+	secureRoute.POST("/holds", handler.HoldTables)
+	secureRoute.POST("/walk-ins", handler.SeatWalkIn)
+	secureRoute.POST("/events", handler.BookEvent)
+	secureRoute.PUT("/events/:id/deposit", handler.UpdateDepositStatus)
+
 	secureReservationGroup := secureRoute.Group("/reservations")
 	secureReservationGroup.POST("", handler.Reserve)
+	secureReservationGroup.POST("/series", handler.ReserveSeries)
+	secureReservationGroup.DELETE("/series/:seriesId", handler.CancelSeries)
+	secureReservationGroup.POST("/:id/confirm", handler.ConfirmReservation)
+	secureReservationGroup.GET("/:id", handler.GetReservation)
+	secureReservationGroup.PATCH("/:id", handler.ModifyReservation)
 	secureReservationGroup.DELETE("/:id", handler.CancelReservation)
+	secureReservationGroup.POST("/:id/seat", handler.SeatReservation)
+	secureReservationGroup.POST("/:id/complete", handler.CompleteReservation)
+	secureReservationGroup.POST("/:id/no-show", handler.MarkNoShow)
 }
 
 // Reserve
 // @Summary Reserve tables
-// @Description Reserves tables for a group of customers.
+// @Description Reserves tables for a group of customers. Bookings that need a deposit are left pending_payment until it is paid. Staff bookings must fall inside the staff booking window; a booking too close to its start time fails with code ERR01001 and one too far ahead with code ERR01002. A booking while the restaurant is closed fails with code ERR01004 and one beyond the shift's pacing limit with code ERR01005.
 // @Tags Reservation
 // @Accept json
 // @Produce json
-// @Param request body dto.ReservationRequest true "Number of customers in the group."
+// @Param restaurantId path string true "Restaurant ID"
+// @Param request body dto.ReservationRequest true "Number of customers in the group, who the booking is for and the requested time slot."
 // @Success 200 {object} model.Response{data=dto.ReservationResponse} "Tables reserved successfully."
-// @Failure 400 {object} model.Response{} "Reservation error."
-// @Router /secure/reservations [post]
+// @Failure 400 {object} model.Response{data=[]dto.FieldErrorResponse} "Invalid guest details or reservation error."
+// @Router /secure/restaurants/{restaurantId}/reservations [post]
 func (handler *ReservationHandler) Reserve(ctx echo.Context) error {
+	restaurantId := ctx.Param("restaurantId")
+
 	var req dto.ReservationRequest
 	if err := ctx.Bind(&req); err != nil {
 		handler.logger.Error("Failed to bind request", zap.Error(err))
 		return response.Response(ctx, nil, errors.New(error_code.InvalidRequest))
 	}
 
-	reservationID, numTables, err := handler.reservationService.ReserveTables(req.NumCustomers)
+	if fieldErrors := validateRequest(req); fieldErrors != nil {
+		handler.logger.Error("Invalid reservation request", zap.Any("fieldErrors", fieldErrors))
+		return response.Response(ctx, model.CreateError(error_code.InvalidRequest, fieldErrors), errors.New(error_code.InvalidRequest))
+	}
+
+	duration := time.Duration(req.DurationMinutes) * time.Minute
+	guest := coreModel.Guest{Name: req.Guest.Name, Phone: req.Guest.Phone, Email: req.Guest.Email, Notes: req.Guest.Notes}
+	reservation, err := handler.reservationService.ReserveTables(restaurantId, req.NumCustomers, guest, req.StartTime, duration, seating(req.Requirements), seating(req.Preferences))
 
 	if err != nil {
 		handler.logger.Error("Failed to reserve tables", zap.Error(err))
+		return response.Response(ctx, model.CreateError(errorCode(err), err.Error()), err)
+	}
+
+	return response.Response(ctx, handler.reservationResponse(reservation), nil)
+}
+
+// ReserveOnline
+// @Summary Book a table online
+// @Description Books tables for a guest booking online, for the turn time of the party size. Bookings that need a deposit are left pending_payment until it is paid. Online bookings must fall inside the public booking window; a booking too close to its start time fails with code ERR01001 and one too far ahead with code ERR01002. A booking while the restaurant is closed fails with code ERR01004 and one beyond the shift's pacing limit with code ERR01005.
+// @Tags Reservation
+// @Accept json
+// @Produce json
+// @Param restaurantId path string true "Restaurant ID"
+// @Param request body dto.OnlineReservationRequest true "Number of customers in the group, who the booking is for and the requested start time."
+// @Success 200 {object} model.Response{data=dto.ReservationResponse} "Tables reserved successfully."
+// @Failure 400 {object} model.Response{data=[]dto.FieldErrorResponse} "Invalid guest details, booking outside the booking window or reservation error."
+// @Router /public/restaurants/{restaurantId}/reservations [post]
+func (handler *ReservationHandler) ReserveOnline(ctx echo.Context) error {
+	var req dto.OnlineReservationRequest
+	if err := ctx.Bind(&req); err != nil {
+		handler.logger.Error("Failed to bind request", zap.Error(err))
+		return response.Response(ctx, nil, errors.New(error_code.InvalidRequest))
+	}
+
+	if fieldErrors := validateRequest(req); fieldErrors != nil {
+		handler.logger.Error("Invalid reservation request", zap.Any("fieldErrors", fieldErrors))
+		return response.Response(ctx, model.CreateError(error_code.InvalidRequest, fieldErrors), errors.New(error_code.InvalidRequest))
+	}
+
+	guest := coreModel.Guest{Name: req.Guest.Name, Phone: req.Guest.Phone, Email: req.Guest.Email, Notes: req.Guest.Notes}
+	reservation, err := handler.reservationService.ReserveOnline(ctx.Param("restaurantId"), req.NumCustomers, guest, req.StartTime, seating(req.Requirements), seating(req.Preferences))
+
+	if err != nil {
+		handler.logger.Error("Failed to reserve tables online", zap.Error(err))
+		return response.Response(ctx, model.CreateError(errorCode(err), err.Error()), err)
+	}
+
+	return response.Response(ctx, handler.reservationResponse(reservation), nil)
+}
+
+// HoldTables
+// @Summary Hold tables
+// @Description Locks tables for a group while the guest fills in their details. The hold expires and the tables are released unless it is confirmed in time.
+// @Tags Reservation
+// @Accept json
+// @Produce json
+// @Param restaurantId path string true "Restaurant ID"
+// @Param request body dto.HoldRequest true "Number of customers in the group and the requested time slot."
+// @Success 200 {object} model.Response{data=dto.ReservationResponse} "Tables held successfully."
+// @Failure 400 {object} model.Response{} "Hold error."
+// @Router /secure/restaurants/{restaurantId}/holds [post]
+func (handler *ReservationHandler) HoldTables(ctx echo.Context) error {
+	var req dto.HoldRequest
+	if err := ctx.Bind(&req); err != nil {
+		handler.logger.Error("Failed to bind request", zap.Error(err))
+		return response.Response(ctx, nil, errors.New(error_code.InvalidRequest))
+	}
+
+	duration := time.Duration(req.DurationMinutes) * time.Minute
+	reservation, err := handler.reservationService.HoldTables(ctx.Param("restaurantId"), req.NumCustomers, req.StartTime, duration)
+
+	if err != nil {
+		handler.logger.Error("Failed to hold tables", zap.Error(err))
+		return response.Response(ctx, model.CreateError(errorCode(err), err.Error()), err)
+	}
+
+	return response.Response(ctx, handler.reservationResponse(reservation), nil)
+}
+
+// SeatWalkIn
+// @Summary Seat a walk-in
+// @Description Seats a party that arrived without booking on tables free from now and records it as a seated walk-in. When no table is free, the response carries the estimated wait until a booked table frees up.
+// @Tags Reservation
+// @Accept json
+// @Produce json
+// @Param restaurantId path string true "Restaurant ID"
+// @Param request body dto.WalkInRequest true "Number of customers in the group and, optionally, who they are."
+// @Success 200 {object} model.Response{data=dto.ReservationResponse} "Party seated."
+// @Failure 400 {object} model.Response{data=dto.WalkInWaitResponse} "No table is free; estimated wait."
+// @Router /secure/restaurants/{restaurantId}/walk-ins [post]
+func (handler *ReservationHandler) SeatWalkIn(ctx echo.Context) error {
+	var req dto.WalkInRequest
+	if err := ctx.Bind(&req); err != nil {
+		handler.logger.Error("Failed to bind request", zap.Error(err))
+		return response.Response(ctx, nil, errors.New(error_code.InvalidRequest))
+	}
+
+	if fieldErrors := validateRequest(req); fieldErrors != nil {
+		handler.logger.Error("Invalid walk-in request", zap.Any("fieldErrors", fieldErrors))
+		return response.Response(ctx, model.CreateError(error_code.InvalidRequest, fieldErrors), errors.New(error_code.InvalidRequest))
+	}
+
+	duration := time.Duration(req.DurationMinutes) * time.Minute
+	guest := coreModel.Guest{Name: req.Name, Phone: req.Phone}
+	reservation, err := handler.reservationService.SeatWalkIn(ctx.Param("restaurantId"), req.NumCustomers, guest, duration)
+
+	var waitErr *service.WaitError
+	if errors.As(err, &waitErr) {
+		handler.logger.Info("No table free for walk-in", zap.Duration("estimatedWait", waitErr.EstimatedWait))
+		wait := dto.WalkInWaitResponse{
+			Message:              waitErr.Error(),
+			EstimatedWaitMinutes: int(math.Ceil(waitErr.EstimatedWait.Minutes())),
+			AvailableAt:          waitErr.AvailableAt,
+		}
+		return response.Response(ctx, model.CreateError(error_code.InvalidRequest, wait), err)
+	}
+	if err != nil {
+		handler.logger.Error("Failed to seat walk-in", zap.Error(err))
+		return response.Response(ctx, model.CreateError(errorCode(err), err.Error()), err)
+	}
+
+	return response.Response(ctx, handler.reservationResponse(reservation), nil)
+}
+
+// ConfirmReservation
+// @Summary Confirm a hold
+// @Description Confirms held tables as a reservation for the guest before the hold expires. A party that needs a deposit is left pending_payment until it is paid.
+// @Tags Reservation
+// @Accept json
+// @Produce json
+// @Param restaurantId path string true "Restaurant ID"
+// @Param id path string true "The held reservation ID."
+// @Param request body dto.ConfirmReservationRequest true "Who the booking is for."
+// @Success 200 {object} model.Response{data=dto.ReservationResponse} "Reservation confirmed."
+// @Failure 400 {object} model.Response{data=[]dto.FieldErrorResponse} "Invalid guest details or confirmation error."
+// @Router /secure/restaurants/{restaurantId}/reservations/{id}/confirm [post]
+func (handler *ReservationHandler) ConfirmReservation(ctx echo.Context) error {
+	var req dto.ConfirmReservationRequest
+	if err := ctx.Bind(&req); err != nil {
+		handler.logger.Error("Failed to bind request", zap.Error(err))
+		return response.Response(ctx, nil, errors.New(error_code.InvalidRequest))
+	}
+
+	if fieldErrors := validateRequest(req); fieldErrors != nil {
+		handler.logger.Error("Invalid confirmation request", zap.Any("fieldErrors", fieldErrors))
+		return response.Response(ctx, model.CreateError(error_code.InvalidRequest, fieldErrors), errors.New(error_code.InvalidRequest))
+	}
+
+	guest := coreModel.Guest{Name: req.Guest.Name, Phone: req.Guest.Phone, Email: req.Guest.Email, Notes: req.Guest.Notes}
+	reservation, err := handler.reservationService.ConfirmReservation(ctx.Param("restaurantId"), ctx.Param("id"), guest)
+
+	if err != nil {
+		handler.logger.Error("Failed to confirm reservation", zap.Error(err))
+		return response.Response(ctx, model.CreateError(error_code.InvalidRequest, err.Error()), err)
+	}
+
+	return response.Response(ctx, handler.reservationResponse(reservation), nil)
+}
+
+// GetReservation
+// @Summary Get a reservation
+// @Description Returns a reservation in any status, including cancelled ones.
+// @Tags Reservation
+// @Produce json
+// @Param restaurantId path string true "Restaurant ID"
+// @Param id path string true "The reservation ID."
+// @Success 200 {object} model.Response{data=dto.ReservationResponse} "Reservation found."
+// @Failure 400 {object} model.Response{} "Reservation not found."
+// @Router /secure/restaurants/{restaurantId}/reservations/{id} [get]
+func (handler *ReservationHandler) GetReservation(ctx echo.Context) error {
+	reservation, err := handler.reservationService.FindReservation(ctx.Param("restaurantId"), ctx.Param("id"))
+
+	if err != nil {
+		handler.logger.Error("Failed to find reservation", zap.Error(err))
+		return response.Response(ctx, model.CreateError(error_code.InvalidRequest, err.Error()), err)
+	}
+
+	return response.Response(ctx, handler.reservationResponse(reservation), nil)
+}
+
+// SeatReservation
+// @Summary Seat a reservation
+// @Description Marks a confirmed reservation as seated when the party arrives. An overbooked reservation is given free tables as it is seated and is refused while none are free.
+// @Tags Reservation
+// @Produce json
+// @Param restaurantId path string true "Restaurant ID"
+// @Param id path string true "The reservation ID."
+// @Success 200 {object} model.Response{data=dto.ReservationResponse} "Reservation seated."
+// @Failure 400 {object} model.Response{} "Status change error."
+// @Router /secure/restaurants/{restaurantId}/reservations/{id}/seat [post]
+func (handler *ReservationHandler) SeatReservation(ctx echo.Context) error {
+	return handler.updateStatus(ctx, handler.reservationService.SeatReservation)
+}
+
+// CompleteReservation
+// @Summary Complete a reservation
+// @Description Marks a seated reservation as completed and releases its tables. A party still seated past its end keeps its tables until then.
+// @Tags Reservation
+// @Produce json
+// @Param restaurantId path string true "Restaurant ID"
+// @Param id path string true "The reservation ID."
+// @Success 200 {object} model.Response{data=dto.ReservationResponse} "Reservation completed."
+// @Failure 400 {object} model.Response{} "Status change error."
+// @Router /secure/restaurants/{restaurantId}/reservations/{id}/complete [post]
+func (handler *ReservationHandler) CompleteReservation(ctx echo.Context) error {
+	return handler.updateStatus(ctx, handler.reservationService.CompleteReservation)
+}
+
+// MarkNoShow
+// @Summary Mark a reservation as no-show
+// @Description Marks a confirmed reservation whose party never arrived and releases its tables.
+// @Tags Reservation
+// @Produce json
+// @Param restaurantId path string true "Restaurant ID"
+// @Param id path string true "The reservation ID."
+// @Success 200 {object} model.Response{data=dto.ReservationResponse} "Reservation marked as no-show."
+// @Failure 400 {object} model.Response{} "Status change error."
+// @Router /secure/restaurants/{restaurantId}/reservations/{id}/no-show [post]
+func (handler *ReservationHandler) MarkNoShow(ctx echo.Context) error {
+	return handler.updateStatus(ctx, handler.reservationService.MarkNoShow)
+}
+
+func (handler *ReservationHandler) updateStatus(ctx echo.Context, update func(restaurantId string, reservationID string) (*coreModel.Reservation, error)) error {
+	reservation, err := update(ctx.Param("restaurantId"), ctx.Param("id"))
+
+	if err != nil {
+		handler.logger.Error("Failed to update reservation status", zap.Error(err))
 		return response.Response(ctx, model.CreateError(error_code.InvalidRequest, err.Error()), err)
 	}
 
-	return response.Response(
-		ctx,
-		dto.ReservationResponse{
-			BookingId:       reservationID,
-			TablesReserved:  numTables,
-			RemainingTables: handler.tableService.AvailableTables()},
-		nil)
+	return response.Response(ctx, handler.reservationResponse(reservation), nil)
+}
+
+// ModifyReservation
+// @Summary Change a reservation's party size
+// @Description Re-seats a reservation for a new number of customers. The reservation keeps its original tables if the new party cannot be seated. A confirmed party grown into needing a deposit is left pending_payment until it is paid. A party grown beyond the shift's pacing limit fails with code ERR01005.
+// @Tags Reservation
+// @Accept json
+// @Produce json
+// @Param restaurantId path string true "Restaurant ID"
+// @Param id path string true "The reservation ID to modify."
+// @Param request body dto.ModifyReservationRequest true "New number of customers in the group."
+// @Success 200 {object} model.Response{data=dto.ReservationResponse} "Reservation modified successfully."
+// @Failure 400 {object} model.Response{} "Modification error."
+// @Router /secure/restaurants/{restaurantId}/reservations/{id} [patch]
+func (handler *ReservationHandler) ModifyReservation(ctx echo.Context) error {
+	var req dto.ModifyReservationRequest
+	if err := ctx.Bind(&req); err != nil {
+		handler.logger.Error("Failed to bind request", zap.Error(err))
+		return response.Response(ctx, nil, errors.New(error_code.InvalidRequest))
+	}
+
+	reservation, err := handler.reservationService.ModifyReservation(ctx.Param("restaurantId"), ctx.Param("id"), req.NumCustomers)
+
+	if err != nil {
+		handler.logger.Error("Failed to modify reservation", zap.Error(err))
+		return response.Response(ctx, model.CreateError(errorCode(err), err.Error()), err)
+	}
+
+	return response.Response(ctx, handler.reservationResponse(reservation), nil)
 }
 
 // CancelReservation
 // @Summary Cancel a reservation
-// @Description Cancels a reservation and releases the reserved tables.
+// @Description Cancels a reservation and releases the reserved tables. Cancellations are free until the cancellation cutoff before the start time; later ones owe the cancellation fee or forfeit a paid deposit, unless staff waive it. The fee is informational and is not charged through the payment gateway. A paid deposit that is not forfeited is refunded.
 // @Tags Reservation
 // @Accept json
 // @Produce json
+// @Param restaurantId path string true "Restaurant ID"
 // @Param id path string true "The reservation ID to cancel."
+// @Param waive_fee query bool false "Cancel without charging the guest for a late cancellation."
 // @Success 200 {object} model.Response{data=dto.CancelReservationResponse} "Reservation canceled successfully."
 // @Failure 400 {object} model.Response{} "Cancellation error."
-// @Router /secure/reservations/{id} [delete]
+// @Router /secure/restaurants/{restaurantId}/reservations/{id} [delete]
 func (handler *ReservationHandler) CancelReservation(ctx echo.Context) error {
+	restaurantId := ctx.Param("restaurantId")
 	reservationID := ctx.Param("id")
 
 	if reservationID == "" {
@@ -82,17 +354,99 @@ func (handler *ReservationHandler) CancelReservation(ctx echo.Context) error {
 		return response.Response(ctx, nil, errors.New(error_code.InvalidRequest))
 	}
 
-	freedTables, err := handler.reservationService.CancelReservation(reservationID)
+	var req dto.CancelReservationRequest
+	if err := ctx.Bind(&req); err != nil {
+		handler.logger.Error("Failed to bind request", zap.Error(err))
+		return response.Response(ctx, nil, errors.New(error_code.InvalidRequest))
+	}
+
+	reservation, err := handler.reservationService.CancelReservation(restaurantId, reservationID, req.WaiveFee)
 
 	if err != nil {
 		handler.logger.Error("Failed to cancel reservation", zap.Error(err))
 		return response.Response(ctx, model.CreateError(error_code.InvalidRequest, err.Error()), err)
 	}
 
-	return response.Response(
-		ctx,
-		dto.CancelReservationResponse{
-			FreedTables:     freedTables,
-			RemainingTables: handler.tableService.AvailableTables()},
-		nil)
+	res := dto.CancelReservationResponse{
+		FreedTables:     reservation.NumTables,
+		RemainingTables: handler.tableService.AvailableTables(reservation.RestaurantId, reservation.StartTime, reservation.EndTime()),
+	}
+	if cancellation := reservation.Cancellation; cancellation != nil {
+		res.FeeApplies = cancellation.FeeApplies()
+		res.Fee = cancellation.Fee
+		res.DepositForfeited = cancellation.DepositForfeited
+		res.FeeWaived = cancellation.Waived
+		res.Refunded = cancellation.Refunded
+		if !cancellation.FreeUntil.IsZero() {
+			res.FreeUntil = &cancellation.FreeUntil
+		}
+		res.Policy = dto.CancellationPolicyResponse{
+			CutoffMinutes:  int(cancellation.Policy.Cutoff / time.Minute),
+			Fee:            cancellation.Policy.Fee,
+			ForfeitDeposit: cancellation.Policy.ForfeitDeposit,
+		}
+	}
+
+	return response.Response(ctx, res, nil)
+}
+
+func (handler *ReservationHandler) reservationResponse(reservation *coreModel.Reservation) dto.ReservationResponse {
+	var holdExpiresAt, paymentDueAt *time.Time
+	switch reservation.Status {
+	case coreModel.ReservationHeld:
+		holdExpiresAt = &reservation.HoldExpiresAt
+	case coreModel.ReservationPendingPayment:
+		paymentDueAt = &reservation.PaymentDueAt
+	}
+	return dto.ReservationResponse{
+		BookingId: reservation.Id,
+		Status:    string(reservation.Status),
+		Guest: dto.GuestResponse{
+			Name:  reservation.Guest.Name,
+			Phone: reservation.Guest.Phone,
+			Email: reservation.Guest.Email,
+			Notes: reservation.Guest.Notes,
+		},
+		TablesReserved:   reservation.NumTables,
+		TableIds:         reservation.TableIds,
+		Combined:         reservation.NumTables > 1,
+		Tables:           handler.reservedTables(reservation),
+		RemainingTables:  handler.tableService.AvailableTables(reservation.RestaurantId, reservation.StartTime, reservation.EndTime()),
+		StartTime:        reservation.StartTime,
+		EndTime:          reservation.EndTime(),
+		HoldExpiresAt:    holdExpiresAt,
+		WalkIn:           reservation.WalkIn,
+		Requirements:     dto.SeatingResponse{Section: reservation.Requirements.Section, Features: reservation.Requirements.Features},
+		Preferences:      dto.SeatingResponse{Section: reservation.Preferences.Section, Features: reservation.Preferences.Features},
+		UnmetPreferences: append([]string{}, reservation.UnmetPreferences...),
+		Type:             string(reservation.BookingType()),
+		DepositStatus:    string(reservation.DepositStatus),
+		DepositAmount:    reservation.Deposit,
+		PaymentDueAt:     paymentDueAt,
+		SeriesId:         reservation.SeriesId,
+		Overbooked:       reservation.Overbooked,
+		OverbookedTables: reservation.OverbookedTables,
+	}
+}
+
+func seating(req dto.SeatingRequest) coreModel.Seating {
+	return coreModel.Seating{Section: req.Section, Features: req.Features}
+}
+
+// reservedTables resolves the tables assigned to the reservation so staff know which tables to set
+// and, for combined tables, which ones to push together.
+func (handler *ReservationHandler) reservedTables(reservation *coreModel.Reservation) []dto.ReservedTableResponse {
+	tables := make([]dto.ReservedTableResponse, 0, len(reservation.TableIds))
+	for _, table := range handler.tableService.Tables(reservation.RestaurantId) {
+		if reservation.HasTable(table.Id) {
+			tables = append(tables, dto.ReservedTableResponse{
+				Id:       table.Id,
+				Label:    table.Label,
+				Capacity: table.Capacity,
+				Section:  table.Section,
+				Features: table.Features,
+			})
+		}
+	}
+	return tables
 }
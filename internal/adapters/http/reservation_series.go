@@ -0,0 +1,119 @@
+package http
+
+import (
+	"errors"
+	"github.com/bossncn/go-common/http/echo/response"
+	"github.com/bossncn/go-common/http/model"
+	"github.com/bossncn/go-common/http/model/error_code"
+	"github.com/bossncn/restaurant-reservation-service/internal/adapters/dto"
+	coreModel "github.com/bossncn/restaurant-reservation-service/internal/core/model"
+	"github.com/bossncn/restaurant-reservation-service/internal/core/service"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+	"time"
+)
+
+// ReserveSeries
+// @Summary Reserve recurring tables
+// @Description Books a reservation that repeats every week, or every few weeks, until a date or for a number of occurrences. Each occurrence is a reservation of its own holding its own tables, and can be cancelled on its own. The series is only booked when every occurrence can be seated; otherwise the occurrences that cannot are listed. Occurrences that need a deposit are each left pending_payment until it is paid.
+// @Tags Reservation
+// @Accept json
+// @Produce json
+// @Param restaurantId path string true "Restaurant ID"
+// @Param request body dto.ReservationSeriesRequest true "The first reservation and how it repeats."
+// @Success 200 {object} model.Response{data=dto.ReservationSeriesResponse} "Series reserved successfully."
+// @Failure 400 {object} model.Response{data=dto.RecurrenceConflictResponse} "Occurrences cannot be seated."
+// @Router /secure/restaurants/{restaurantId}/reservations/series [post]
+func (handler *ReservationHandler) ReserveSeries(ctx echo.Context) error {
+	var req dto.ReservationSeriesRequest
+	if err := ctx.Bind(&req); err != nil {
+		handler.logger.Error("Failed to bind request", zap.Error(err))
+		return response.Response(ctx, nil, errors.New(error_code.InvalidRequest))
+	}
+
+	if fieldErrors := validateRequest(req); fieldErrors != nil {
+		handler.logger.Error("Invalid reservation series request", zap.Any("fieldErrors", fieldErrors))
+		return response.Response(ctx, model.CreateError(error_code.InvalidRequest, fieldErrors), errors.New(error_code.InvalidRequest))
+	}
+
+	recurrence := coreModel.Recurrence{IntervalWeeks: req.Recurrence.IntervalWeeks, Count: req.Recurrence.Count}
+	if req.Recurrence.Until != "" {
+		// The date format was checked by validation.
+		recurrence.Until, _ = time.Parse(time.DateOnly, req.Recurrence.Until)
+	}
+	duration := time.Duration(req.DurationMinutes) * time.Minute
+	guest := coreModel.Guest{Name: req.Guest.Name, Phone: req.Guest.Phone, Email: req.Guest.Email, Notes: req.Guest.Notes}
+	occurrences, err := handler.reservationService.ReserveSeries(ctx.Param("restaurantId"), req.NumCustomers, guest, req.StartTime, duration, seating(req.Requirements), seating(req.Preferences), recurrence)
+
+	var conflictErr *service.RecurrenceConflictError
+	if errors.As(err, &conflictErr) {
+		handler.logger.Info("Reservation series conflicts with bookings", zap.Int("occurrences", len(conflictErr.Occurrences)))
+		conflicts := make([]dto.ConflictingOccurrenceResponse, 0, len(conflictErr.Occurrences))
+		for _, occurrence := range conflictErr.Occurrences {
+			conflicts = append(conflicts, dto.ConflictingOccurrenceResponse{StartTime: occurrence.StartTime, Reason: occurrence.Reason})
+		}
+		return response.Response(ctx, model.CreateError(error_code.InvalidRequest, dto.RecurrenceConflictResponse{Message: err.Error(), Occurrences: conflicts}), err)
+	}
+	if err != nil {
+		handler.logger.Error("Failed to reserve series", zap.Error(err))
+		return response.Response(ctx, model.CreateError(errorCode(err), err.Error()), err)
+	}
+
+	series := dto.ReservationSeriesResponse{Occurrences: make([]dto.ReservationResponse, 0, len(occurrences))}
+	for i := range occurrences {
+		series.SeriesId = occurrences[i].SeriesId
+		series.Occurrences = append(series.Occurrences, handler.reservationResponse(&occurrences[i]))
+	}
+	return response.Response(ctx, series, nil)
+}
+
+// CancelSeries
+// @Summary Cancel a recurring reservation
+// @Description Cancels every upcoming occurrence of a recurring reservation and releases their tables. Occurrences that have already started are left as they are; to cancel a single occurrence, cancel it as a reservation. Each occurrence is cancelled under the cancellation policy, as if cancelled on its own: occurrences past the free cancellation cutoff owe the fee or forfeit a paid deposit unless staff waive it, and other paid deposits are refunded. The fee is informational and is not charged through the payment gateway. An occurrence that cannot be cancelled, such as when its refund fails, is left as it was and listed under failed without holding back the others.
+// @Tags Reservation
+// @Produce json
+// @Param restaurantId path string true "Restaurant ID"
+// @Param seriesId path string true "The series ID."
+// @Param waive_fee query bool false "Cancel without charging the guest for late cancellations."
+// @Success 200 {object} model.Response{data=dto.CancelSeriesResponse} "Series cancelled."
+// @Failure 400 {object} model.Response{} "Series not found."
+// @Router /secure/restaurants/{restaurantId}/reservations/series/{seriesId} [delete]
+func (handler *ReservationHandler) CancelSeries(ctx echo.Context) error {
+	seriesId := ctx.Param("seriesId")
+
+	var req dto.CancelSeriesRequest
+	if err := ctx.Bind(&req); err != nil {
+		handler.logger.Error("Failed to bind request", zap.Error(err))
+		return response.Response(ctx, nil, errors.New(error_code.InvalidRequest))
+	}
+
+	result, err := handler.reservationService.CancelSeries(ctx.Param("restaurantId"), seriesId, req.WaiveFee)
+
+	if err != nil {
+		handler.logger.Error("Failed to cancel series", zap.Error(err))
+		return response.Response(ctx, model.CreateError(error_code.InvalidRequest, err.Error()), err)
+	}
+
+	res := dto.CancelSeriesResponse{
+		SeriesId:    seriesId,
+		BookingIds:  make([]string, 0, len(result.Cancelled)),
+		Occurrences: make([]dto.CancelledOccurrenceResponse, 0, len(result.Cancelled)),
+		Failed:      make([]dto.FailedOccurrenceResponse, 0, len(result.Failed)),
+	}
+	for _, occurrence := range result.Cancelled {
+		res.BookingIds = append(res.BookingIds, occurrence.Id)
+		outcome := dto.CancelledOccurrenceResponse{BookingId: occurrence.Id, StartTime: occurrence.StartTime}
+		if cancellation := occurrence.Cancellation; cancellation != nil {
+			outcome.FeeApplies = cancellation.FeeApplies()
+			outcome.Fee = cancellation.Fee
+			outcome.DepositForfeited = cancellation.DepositForfeited
+			outcome.FeeWaived = cancellation.Waived
+			outcome.Refunded = cancellation.Refunded
+		}
+		res.Occurrences = append(res.Occurrences, outcome)
+	}
+	for _, failure := range result.Failed {
+		res.Failed = append(res.Failed, dto.FailedOccurrenceResponse{BookingId: failure.ReservationId, StartTime: failure.StartTime, Reason: failure.Reason})
+	}
+	return response.Response(ctx, res, nil)
+}
@@ -0,0 +1,278 @@
+package http_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"github.com/bossncn/go-common/http/model"
+	"github.com/bossncn/restaurant-reservation-service/internal/adapters/dto"
+	"github.com/bossncn/restaurant-reservation-service/internal/adapters/http"
+	coreModel "github.com/bossncn/restaurant-reservation-service/internal/core/model"
+	"github.com/bossncn/restaurant-reservation-service/internal/core/service"
+	serviceMock "github.com/bossncn/restaurant-reservation-service/internal/core/service/mock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	netHttp "net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestReservationSeriesHandler(t *testing.T) {
+	start := time.Date(2025, 1, 10, 19, 0, 0, 0, time.UTC)
+	guest := coreModel.Guest{Name: "Alex Tan", Phone: "+66812345678"}
+
+	newContext := func(method string, body string, params ...string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(method, "/reservations/series", bytes.NewReader([]byte(body)))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		e := echo.New()
+		ctx := e.NewContext(req, rec)
+		ctx.SetParamNames(append([]string{"restaurantId"}, params...)...)
+		ctx.SetParamValues("r1", "series-1")
+		return ctx, rec
+	}
+
+	t.Run("ReserveSeries", func(t *testing.T) {
+		body := `{"num_customers": 2, "guest": {"name": "Alex Tan", "phone": "+66812345678"}, "start_time": "2025-01-10T19:00:00Z", "recurrence": {"interval_weeks": 1, "until": "2025-01-17"}}`
+		recurrence := coreModel.Recurrence{IntervalWeeks: 1, Until: time.Date(2025, 1, 17, 0, 0, 0, 0, time.UTC)}
+
+		t.Run("Success", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock dependencies
+			mockReservationService := serviceMock.NewMockReservationService(ctrl)
+			mockTableService := serviceMock.NewMockTableService(ctrl)
+			logger := zap.NewNop()
+			handler := http.NewReservationHandler(logger, &http.Service{
+				ReservationService: mockReservationService,
+				TableService:       mockTableService,
+			})
+
+			// Set up Echo mock context
+			ctx, rec := newContext(netHttp.MethodPost, body)
+
+			// Mock behavior
+			occurrences := []coreModel.Reservation{
+				{Id: "res-1", RestaurantId: "r1", PartySize: 2, Guest: guest, NumTables: 1, TableIds: []string{"T1"}, StartTime: start, Duration: 2 * time.Hour, Status: coreModel.ReservationConfirmed, SeriesId: "series-1"},
+				{Id: "res-2", RestaurantId: "r1", PartySize: 2, Guest: guest, NumTables: 1, TableIds: []string{"T1"}, StartTime: start.AddDate(0, 0, 7), Duration: 2 * time.Hour, Status: coreModel.ReservationConfirmed, SeriesId: "series-1"},
+			}
+			mockReservationService.EXPECT().ReserveSeries("r1", 2, guest, start, time.Duration(0), coreModel.Seating{}, coreModel.Seating{}, recurrence).Return(occurrences, nil).Times(1)
+			mockTableService.EXPECT().AvailableTables("r1", gomock.Any(), gomock.Any()).Return(3).Times(2)
+			mockTableService.EXPECT().Tables("r1").Return([]coreModel.Table{{Id: "T1", Capacity: 4}}).Times(2)
+
+			// Execute handler
+			err := handler.ReserveSeries(ctx)
+
+			// Assertions
+			assert.NoError(t, err)
+			assert.Equal(t, netHttp.StatusOK, rec.Code)
+
+			var res struct {
+				Data dto.ReservationSeriesResponse `json:"data"`
+			}
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, "series-1", res.Data.SeriesId)
+			assert.Len(t, res.Data.Occurrences, 2)
+			assert.Equal(t, "res-2", res.Data.Occurrences[1].BookingId)
+			assert.Equal(t, "series-1", res.Data.Occurrences[1].SeriesId)
+		})
+		t.Run("ValidationError", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock dependencies
+			mockReservationService := serviceMock.NewMockReservationService(ctrl)
+			logger := zap.NewNop()
+			handler := http.NewReservationHandler(logger, &http.Service{ReservationService: mockReservationService})
+
+			// Set up Echo mock context
+			ctx, rec := newContext(netHttp.MethodPost, `{"num_customers": 2, "guest": {"name": "Alex Tan", "phone": "+66812345678"}, "start_time": "2025-01-10T19:00:00Z", "recurrence": {"until": "17/01/2025"}}`)
+
+			// Execute handler
+			err := handler.ReserveSeries(ctx)
+
+			// Assertions
+			assert.NoError(t, err)
+			assert.Equal(t, netHttp.StatusBadRequest, rec.Code)
+
+			var res struct {
+				Data []dto.FieldErrorResponse `json:"data"`
+			}
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, []dto.FieldErrorResponse{{Field: "recurrence.until", Message: "must be formatted as 2006-01-02"}}, res.Data)
+		})
+		t.Run("Conflict", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock dependencies
+			mockReservationService := serviceMock.NewMockReservationService(ctrl)
+			logger := zap.NewNop()
+			handler := http.NewReservationHandler(logger, &http.Service{ReservationService: mockReservationService})
+
+			// Set up Echo mock context
+			ctx, rec := newContext(netHttp.MethodPost, body)
+
+			// Mock behavior
+			conflictErr := &service.RecurrenceConflictError{Occurrences: []service.OccurrenceConflict{{StartTime: start.AddDate(0, 0, 7), Reason: "not enough tables available"}}}
+			mockReservationService.EXPECT().ReserveSeries("r1", 2, guest, start, time.Duration(0), coreModel.Seating{}, coreModel.Seating{}, recurrence).Return(nil, conflictErr).Times(1)
+
+			// Execute handler
+			err := handler.ReserveSeries(ctx)
+
+			// Assertions
+			assert.NoError(t, err)
+			assert.Equal(t, netHttp.StatusBadRequest, rec.Code)
+
+			var res struct {
+				Data dto.RecurrenceConflictResponse `json:"data"`
+			}
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, dto.RecurrenceConflictResponse{
+				Message:     "cannot book the occurrences starting 2025-01-17T19:00:00Z",
+				Occurrences: []dto.ConflictingOccurrenceResponse{{StartTime: start.AddDate(0, 0, 7), Reason: "not enough tables available"}},
+			}, res.Data)
+		})
+	})
+	t.Run("CancelSeries", func(t *testing.T) {
+		t.Run("Success", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock dependencies
+			mockReservationService := serviceMock.NewMockReservationService(ctrl)
+			logger := zap.NewNop()
+			handler := http.NewReservationHandler(logger, &http.Service{ReservationService: mockReservationService})
+
+			// Set up Echo mock context
+			ctx, rec := newContext(netHttp.MethodDelete, "", "seriesId")
+
+			// Mock behavior
+			mockReservationService.EXPECT().CancelSeries("r1", "series-1", false).Return(&service.SeriesCancellation{Cancelled: []coreModel.Reservation{{Id: "res-1"}, {Id: "res-2"}}}, nil).Times(1)
+
+			// Execute handler
+			err := handler.CancelSeries(ctx)
+
+			// Assertions
+			assert.NoError(t, err)
+			assert.Equal(t, netHttp.StatusOK, rec.Code)
+
+			var res struct {
+				Data dto.CancelSeriesResponse `json:"data"`
+			}
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, dto.CancelSeriesResponse{
+				SeriesId:    "series-1",
+				BookingIds:  []string{"res-1", "res-2"},
+				Occurrences: []dto.CancelledOccurrenceResponse{{BookingId: "res-1"}, {BookingId: "res-2"}},
+				Failed:      []dto.FailedOccurrenceResponse{},
+			}, res.Data)
+		})
+		t.Run("LateCancellation", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock dependencies
+			mockReservationService := serviceMock.NewMockReservationService(ctrl)
+			logger := zap.NewNop()
+			handler := http.NewReservationHandler(logger, &http.Service{ReservationService: mockReservationService})
+
+			// Set up Echo mock context
+			ctx, rec := newContext(netHttp.MethodDelete, "", "seriesId")
+			ctx.Request().URL.RawQuery = "waive_fee=true"
+
+			// Mock behavior: tonight's occurrence is past the cutoff, next week's is still free.
+			tonight := time.Date(2025, 1, 10, 19, 0, 0, 0, time.UTC)
+			mockReservationService.EXPECT().CancelSeries("r1", "series-1", true).Return(&service.SeriesCancellation{Cancelled: []coreModel.Reservation{
+				{Id: "res-1", StartTime: tonight, Cancellation: &coreModel.Cancellation{Policy: coreModel.CancellationPolicy{Cutoff: 24 * time.Hour, Fee: 500}, Waived: true}},
+				{Id: "res-2", StartTime: tonight.AddDate(0, 0, 7), Cancellation: &coreModel.Cancellation{Policy: coreModel.CancellationPolicy{Cutoff: 24 * time.Hour, Fee: 500}, Refunded: 2000}},
+			}}, nil).Times(1)
+
+			// Execute handler
+			err := handler.CancelSeries(ctx)
+
+			// Assertions
+			assert.NoError(t, err)
+			assert.Equal(t, netHttp.StatusOK, rec.Code)
+
+			var res struct {
+				Data dto.CancelSeriesResponse `json:"data"`
+			}
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, []dto.CancelledOccurrenceResponse{
+				{BookingId: "res-1", StartTime: tonight, FeeWaived: true},
+				{BookingId: "res-2", StartTime: tonight.AddDate(0, 0, 7), Refunded: 2000},
+			}, res.Data.Occurrences)
+		})
+		t.Run("PartlyFailed", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock dependencies
+			mockReservationService := serviceMock.NewMockReservationService(ctrl)
+			logger := zap.NewNop()
+			handler := http.NewReservationHandler(logger, &http.Service{ReservationService: mockReservationService})
+
+			// Set up Echo mock context
+			ctx, rec := newContext(netHttp.MethodDelete, "", "seriesId")
+
+			// Mock behavior: refunding tonight's deposit failed, so only next week's is cancelled.
+			tonight := time.Date(2025, 1, 10, 19, 0, 0, 0, time.UTC)
+			mockReservationService.EXPECT().CancelSeries("r1", "series-1", false).Return(&service.SeriesCancellation{
+				Cancelled: []coreModel.Reservation{{Id: "res-2", StartTime: tonight.AddDate(0, 0, 7)}},
+				Failed:    []service.OccurrenceFailure{{ReservationId: "res-1", StartTime: tonight, Reason: "gateway unavailable"}},
+			}, nil).Times(1)
+
+			// Execute handler
+			err := handler.CancelSeries(ctx)
+
+			// Assertions
+			assert.NoError(t, err)
+			assert.Equal(t, netHttp.StatusOK, rec.Code)
+
+			var res struct {
+				Data dto.CancelSeriesResponse `json:"data"`
+			}
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"res-2"}, res.Data.BookingIds)
+			assert.Equal(t, []dto.FailedOccurrenceResponse{{BookingId: "res-1", StartTime: tonight, Reason: "gateway unavailable"}}, res.Data.Failed)
+		})
+		t.Run("NotFound", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock dependencies
+			mockReservationService := serviceMock.NewMockReservationService(ctrl)
+			logger := zap.NewNop()
+			handler := http.NewReservationHandler(logger, &http.Service{ReservationService: mockReservationService})
+
+			// Set up Echo mock context
+			ctx, rec := newContext(netHttp.MethodDelete, "", "seriesId")
+
+			// Mock behavior
+			mockReservationService.EXPECT().CancelSeries("r1", "series-1", false).Return(nil, errors.New("reservation series not found")).Times(1)
+
+			// Execute handler
+			err := handler.CancelSeries(ctx)
+
+			// Assertions
+			assert.NoError(t, err)
+			assert.Equal(t, netHttp.StatusBadRequest, rec.Code)
+
+			var res model.Response
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, "reservation series not found", res.Data)
+		})
+	})
+}
@@ -4,10 +4,13 @@ import (
 	"bytes"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"github.com/bossncn/go-common/http/model"
 	"github.com/bossncn/go-common/http/model/error_code"
 	"github.com/bossncn/restaurant-reservation-service/internal/adapters/dto"
 	"github.com/bossncn/restaurant-reservation-service/internal/adapters/http"
+	coreModel "github.com/bossncn/restaurant-reservation-service/internal/core/model"
+	"github.com/bossncn/restaurant-reservation-service/internal/core/service"
 	serviceMock "github.com/bossncn/restaurant-reservation-service/internal/core/service/mock"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
@@ -16,9 +19,13 @@ import (
 	netHttp "net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 )
 
 func TestReservationHandler(t *testing.T) {
+	startTime := time.Date(2025, 1, 10, 19, 0, 0, 0, time.UTC)
+	guest := dto.GuestRequest{Name: "Alex Tan", Phone: "+66812345678", Notes: "Birthday"}
+
 	t.Run("Reserve", func(t *testing.T) {
 		t.Run("Success", func(t *testing.T) {
 			ctrl := gomock.NewController(t)
@@ -34,17 +41,25 @@ func TestReservationHandler(t *testing.T) {
 			})
 
 			// Set up Echo mock context
-			reqBody := dto.ReservationRequest{NumCustomers: 8}
+			reqBody := dto.ReservationRequest{NumCustomers: 8, Guest: guest, StartTime: startTime, DurationMinutes: 90}
 			reqJSON, _ := json.Marshal(reqBody)
 			req := httptest.NewRequest(netHttp.MethodPost, "/reservations", bytes.NewReader(reqJSON))
 			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 			rec := httptest.NewRecorder()
 			e := echo.New()
 			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("restaurantId")
+			ctx.SetParamValues("r1")
 
 			// Mock behavior
-			mockReservationService.EXPECT().ReserveTables(reqBody.NumCustomers).Return("res-1", 2, nil).Times(1)
-			mockTableService.EXPECT().AvailableTables().Return(8).Times(1)
+			reservation := &coreModel.Reservation{Id: "res-1", RestaurantId: "r1", PartySize: 8, Guest: coreModel.Guest{Name: "Alex Tan", Phone: "+66812345678", Notes: "Birthday"}, NumTables: 2, TableIds: []string{"T1", "T2"}, StartTime: startTime, Duration: 90 * time.Minute}
+			mockReservationService.EXPECT().ReserveTables("r1", reqBody.NumCustomers, coreModel.Guest{Name: "Alex Tan", Phone: "+66812345678", Notes: "Birthday"}, startTime, 90*time.Minute, coreModel.Seating{}, coreModel.Seating{}).Return(reservation, nil).Times(1)
+			mockTableService.EXPECT().AvailableTables("r1", startTime, startTime.Add(90*time.Minute)).Return(8).Times(1)
+			mockTableService.EXPECT().Tables("r1").Return([]coreModel.Table{
+				{Id: "T1", Label: "Window", Capacity: 4, CombinableWith: []string{"T2"}},
+				{Id: "T2", Label: "Booth", Capacity: 4, CombinableWith: []string{"T1", "T3"}},
+				{Id: "T3", Label: "Bar", Capacity: 2, CombinableWith: []string{"T2"}},
+			}).Times(1)
 
 			// Execute handler
 			err := handler.Reserve(ctx)
@@ -58,7 +73,16 @@ func TestReservationHandler(t *testing.T) {
 			assert.NoError(t, err)
 			assert.Equal(t, "res-1", res.Data.(map[string]interface{})["booking_id"])
 			assert.Equal(t, float64(2), res.Data.(map[string]interface{})["tables_reserved"])
+			assert.Equal(t, []interface{}{"T1", "T2"}, res.Data.(map[string]interface{})["table_ids"])
+			assert.Equal(t, true, res.Data.(map[string]interface{})["combined"])
+			assert.Equal(t, map[string]interface{}{"name": "Alex Tan", "phone": "+66812345678", "notes": "Birthday"}, res.Data.(map[string]interface{})["guest"])
+
+			tables := res.Data.(map[string]interface{})["tables"].([]interface{})
+			assert.Len(t, tables, 2)
+			assert.Equal(t, "Window", tables[0].(map[string]interface{})["label"])
+			assert.Equal(t, "Booth", tables[1].(map[string]interface{})["label"])
 			assert.Equal(t, float64(8), res.Data.(map[string]interface{})["remaining_tables"])
+			assert.Equal(t, "2025-01-10T20:30:00Z", res.Data.(map[string]interface{})["end_time"])
 		})
 		t.Run("BindError", func(t *testing.T) {
 			ctrl := gomock.NewController(t)
@@ -79,6 +103,44 @@ func TestReservationHandler(t *testing.T) {
 			rec := httptest.NewRecorder()
 			e := echo.New()
 			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("restaurantId")
+			ctx.SetParamValues("r1")
+
+			// Execute handler
+			err := handler.Reserve(ctx)
+
+			// Assertions
+			assert.NoError(t, err)
+			assert.Equal(t, netHttp.StatusBadRequest, rec.Code)
+
+			var res model.Response
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, error_code.InvalidRequest, res.Code)
+		})
+		t.Run("InvalidGuest", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock dependencies
+			mockReservationService := serviceMock.NewMockReservationService(ctrl)
+			mockTableService := serviceMock.NewMockTableService(ctrl)
+			logger := zap.NewNop()
+			handler := http.NewReservationHandler(logger, &http.Service{
+				ReservationService: mockReservationService,
+				TableService:       mockTableService,
+			})
+
+			// Set up Echo mock context
+			reqBody := dto.ReservationRequest{NumCustomers: 2, Guest: dto.GuestRequest{Phone: "081 234 5678", Email: "alex@"}, StartTime: startTime}
+			reqJSON, _ := json.Marshal(reqBody)
+			req := httptest.NewRequest(netHttp.MethodPost, "/reservations", bytes.NewReader(reqJSON))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			e := echo.New()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("restaurantId")
+			ctx.SetParamValues("r1")
 
 			// Execute handler
 			err := handler.Reserve(ctx)
@@ -91,6 +153,11 @@ func TestReservationHandler(t *testing.T) {
 			err = json.Unmarshal(rec.Body.Bytes(), &res)
 			assert.NoError(t, err)
 			assert.Equal(t, error_code.InvalidRequest, res.Code)
+			assert.Equal(t, []interface{}{
+				map[string]interface{}{"field": "guest.name", "message": "is required"},
+				map[string]interface{}{"field": "guest.phone", "message": "must be a phone number in international format, e.g. +66812345678"},
+				map[string]interface{}{"field": "guest.email", "message": "must be a valid email address"},
+			}, res.Data)
 		})
 		t.Run("ServiceError", func(t *testing.T) {
 			ctrl := gomock.NewController(t)
@@ -106,16 +173,18 @@ func TestReservationHandler(t *testing.T) {
 			})
 
 			// Set up Echo mock context
-			reqBody := dto.ReservationRequest{NumCustomers: 8}
+			reqBody := dto.ReservationRequest{NumCustomers: 8, Guest: guest, StartTime: startTime, DurationMinutes: 90}
 			reqJSON, _ := json.Marshal(reqBody)
 			req := httptest.NewRequest(netHttp.MethodPost, "/reservations", bytes.NewReader(reqJSON))
 			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 			rec := httptest.NewRecorder()
 			e := echo.New()
 			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("restaurantId")
+			ctx.SetParamValues("r1")
 
 			// Mock behavior
-			mockReservationService.EXPECT().ReserveTables(reqBody.NumCustomers).Return("", 0, errors.New("reservation failed")).Times(1)
+			mockReservationService.EXPECT().ReserveTables("r1", reqBody.NumCustomers, coreModel.Guest{Name: "Alex Tan", Phone: "+66812345678", Notes: "Birthday"}, startTime, 90*time.Minute, coreModel.Seating{}, coreModel.Seating{}).Return(nil, errors.New("reservation failed")).Times(1)
 
 			// Execute handler
 			err := handler.Reserve(ctx)
@@ -131,6 +200,308 @@ func TestReservationHandler(t *testing.T) {
 			assert.Equal(t, "reservation failed", res.Data)
 		})
 	})
+	t.Run("ReserveOnline", func(t *testing.T) {
+		t.Run("Success", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock dependencies
+			mockReservationService := serviceMock.NewMockReservationService(ctrl)
+			mockTableService := serviceMock.NewMockTableService(ctrl)
+			logger := zap.NewNop()
+			handler := http.NewReservationHandler(logger, &http.Service{
+				ReservationService: mockReservationService,
+				TableService:       mockTableService,
+			})
+
+			// Set up Echo mock context
+			reqJSON, _ := json.Marshal(dto.OnlineReservationRequest{NumCustomers: 2, Guest: guest, StartTime: startTime})
+			req := httptest.NewRequest(netHttp.MethodPost, "/reservations", bytes.NewReader(reqJSON))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			e := echo.New()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("restaurantId")
+			ctx.SetParamValues("r1")
+
+			// Mock behavior
+			reservation := &coreModel.Reservation{Id: "res-1", RestaurantId: "r1", PartySize: 2, Guest: coreModel.Guest{Name: "Alex Tan", Phone: "+66812345678", Notes: "Birthday"}, NumTables: 1, TableIds: []string{"T1"}, StartTime: startTime, Duration: 2 * time.Hour}
+			mockReservationService.EXPECT().ReserveOnline("r1", 2, coreModel.Guest{Name: "Alex Tan", Phone: "+66812345678", Notes: "Birthday"}, startTime, coreModel.Seating{}, coreModel.Seating{}).Return(reservation, nil).Times(1)
+			mockTableService.EXPECT().AvailableTables("r1", startTime, startTime.Add(2*time.Hour)).Return(3).Times(1)
+			mockTableService.EXPECT().Tables("r1").Return([]coreModel.Table{{Id: "T1", Label: "T1", Capacity: 4}}).Times(1)
+
+			// Execute handler
+			err := handler.ReserveOnline(ctx)
+
+			// Assertions
+			assert.NoError(t, err)
+			assert.Equal(t, netHttp.StatusOK, rec.Code)
+
+			var res model.Response
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, "res-1", res.Data.(map[string]interface{})["booking_id"])
+		})
+		t.Run("BookingWindow", func(t *testing.T) {
+			testCases := []struct {
+				err  error
+				code string
+			}{
+				{err: fmt.Errorf("%w, bookings must be made at least 2 hours ahead", coreModel.ErrBookingTooSoon), code: http.BookingTooSoon},
+				{err: fmt.Errorf("%w, bookings open 60 days ahead", coreModel.ErrBookingTooFarAhead), code: http.BookingTooFarAhead},
+			}
+
+			for _, tc := range testCases {
+				t.Run(tc.code, func(t *testing.T) {
+					ctrl := gomock.NewController(t)
+					defer ctrl.Finish()
+
+					// Mock dependencies
+					mockReservationService := serviceMock.NewMockReservationService(ctrl)
+					mockTableService := serviceMock.NewMockTableService(ctrl)
+					logger := zap.NewNop()
+					handler := http.NewReservationHandler(logger, &http.Service{
+						ReservationService: mockReservationService,
+						TableService:       mockTableService,
+					})
+
+					// Set up Echo mock context
+					reqJSON, _ := json.Marshal(dto.OnlineReservationRequest{NumCustomers: 2, Guest: guest, StartTime: startTime})
+					req := httptest.NewRequest(netHttp.MethodPost, "/reservations", bytes.NewReader(reqJSON))
+					req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+					rec := httptest.NewRecorder()
+					e := echo.New()
+					ctx := e.NewContext(req, rec)
+					ctx.SetParamNames("restaurantId")
+					ctx.SetParamValues("r1")
+
+					// Mock behavior
+					mockReservationService.EXPECT().ReserveOnline("r1", 2, gomock.Any(), startTime, coreModel.Seating{}, coreModel.Seating{}).Return(nil, tc.err).Times(1)
+
+					// Execute handler
+					err := handler.ReserveOnline(ctx)
+
+					// Assertions
+					assert.NoError(t, err)
+					assert.Equal(t, netHttp.StatusBadRequest, rec.Code)
+
+					var res model.Response
+					err = json.Unmarshal(rec.Body.Bytes(), &res)
+					assert.NoError(t, err)
+					assert.Equal(t, tc.code, res.Code)
+					assert.Equal(t, tc.err.Error(), res.Data)
+				})
+			}
+		})
+	})
+	t.Run("HoldTables", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Mock dependencies
+		mockReservationService := serviceMock.NewMockReservationService(ctrl)
+		mockTableService := serviceMock.NewMockTableService(ctrl)
+		logger := zap.NewNop()
+		handler := http.NewReservationHandler(logger, &http.Service{
+			ReservationService: mockReservationService,
+			TableService:       mockTableService,
+		})
+
+		// Set up Echo mock context
+		reqJSON, _ := json.Marshal(dto.HoldRequest{NumCustomers: 4, StartTime: startTime})
+		req := httptest.NewRequest(netHttp.MethodPost, "/holds", bytes.NewReader(reqJSON))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		e := echo.New()
+		ctx := e.NewContext(req, rec)
+		ctx.SetParamNames("restaurantId")
+		ctx.SetParamValues("r1")
+
+		// Mock behavior
+		expiresAt := time.Date(2025, 1, 9, 12, 10, 0, 0, time.UTC)
+		reservation := &coreModel.Reservation{Id: "res-1", RestaurantId: "r1", PartySize: 4, NumTables: 1, TableIds: []string{"T1"}, StartTime: startTime, Duration: 2 * time.Hour, Status: coreModel.ReservationHeld, HoldExpiresAt: expiresAt}
+		mockReservationService.EXPECT().HoldTables("r1", 4, startTime, time.Duration(0)).Return(reservation, nil).Times(1)
+		mockTableService.EXPECT().AvailableTables("r1", startTime, startTime.Add(2*time.Hour)).Return(0).Times(1)
+		mockTableService.EXPECT().Tables("r1").Return([]coreModel.Table{{Id: "T1", Label: "T1", Capacity: 4}}).Times(1)
+
+		// Execute handler
+		err := handler.HoldTables(ctx)
+
+		// Assertions
+		assert.NoError(t, err)
+		assert.Equal(t, netHttp.StatusOK, rec.Code)
+
+		var res model.Response
+		err = json.Unmarshal(rec.Body.Bytes(), &res)
+		assert.NoError(t, err)
+		assert.Equal(t, "held", res.Data.(map[string]interface{})["status"])
+		assert.Equal(t, "2025-01-09T12:10:00Z", res.Data.(map[string]interface{})["hold_expires_at"])
+	})
+	t.Run("ConfirmReservation", func(t *testing.T) {
+		t.Run("Success", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock dependencies
+			mockReservationService := serviceMock.NewMockReservationService(ctrl)
+			mockTableService := serviceMock.NewMockTableService(ctrl)
+			logger := zap.NewNop()
+			handler := http.NewReservationHandler(logger, &http.Service{
+				ReservationService: mockReservationService,
+				TableService:       mockTableService,
+			})
+
+			// Set up Echo mock context
+			reqJSON, _ := json.Marshal(dto.ConfirmReservationRequest{Guest: guest})
+			req := httptest.NewRequest(netHttp.MethodPost, "/reservations/res-1/confirm", bytes.NewReader(reqJSON))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			e := echo.New()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("restaurantId", "id")
+			ctx.SetParamValues("r1", "res-1")
+
+			// Mock behavior
+			coreGuest := coreModel.Guest{Name: "Alex Tan", Phone: "+66812345678", Notes: "Birthday"}
+			reservation := &coreModel.Reservation{Id: "res-1", RestaurantId: "r1", PartySize: 4, Guest: coreGuest, NumTables: 1, TableIds: []string{"T1"}, StartTime: startTime, Duration: 2 * time.Hour, Status: coreModel.ReservationConfirmed}
+			mockReservationService.EXPECT().ConfirmReservation("r1", "res-1", coreGuest).Return(reservation, nil).Times(1)
+			mockTableService.EXPECT().AvailableTables("r1", startTime, startTime.Add(2*time.Hour)).Return(0).Times(1)
+			mockTableService.EXPECT().Tables("r1").Return([]coreModel.Table{{Id: "T1", Label: "T1", Capacity: 4}}).Times(1)
+
+			// Execute handler
+			err := handler.ConfirmReservation(ctx)
+
+			// Assertions
+			assert.NoError(t, err)
+			assert.Equal(t, netHttp.StatusOK, rec.Code)
+
+			var res model.Response
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, "confirmed", res.Data.(map[string]interface{})["status"])
+			assert.NotContains(t, res.Data.(map[string]interface{}), "hold_expires_at")
+		})
+		t.Run("Expired", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock dependencies
+			mockReservationService := serviceMock.NewMockReservationService(ctrl)
+			mockTableService := serviceMock.NewMockTableService(ctrl)
+			logger := zap.NewNop()
+			handler := http.NewReservationHandler(logger, &http.Service{
+				ReservationService: mockReservationService,
+				TableService:       mockTableService,
+			})
+
+			// Set up Echo mock context
+			reqJSON, _ := json.Marshal(dto.ConfirmReservationRequest{Guest: guest})
+			req := httptest.NewRequest(netHttp.MethodPost, "/reservations/res-1/confirm", bytes.NewReader(reqJSON))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			e := echo.New()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("restaurantId", "id")
+			ctx.SetParamValues("r1", "res-1")
+
+			// Mock behavior
+			mockReservationService.EXPECT().ConfirmReservation("r1", "res-1", gomock.Any()).Return(nil, errors.New("hold has expired")).Times(1)
+
+			// Execute handler
+			err := handler.ConfirmReservation(ctx)
+
+			// Assertions
+			assert.NoError(t, err)
+			assert.Equal(t, netHttp.StatusBadRequest, rec.Code)
+
+			var res model.Response
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, "hold has expired", res.Data)
+		})
+	})
+	t.Run("ModifyReservation", func(t *testing.T) {
+		t.Run("Success", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock dependencies
+			mockReservationService := serviceMock.NewMockReservationService(ctrl)
+			mockTableService := serviceMock.NewMockTableService(ctrl)
+			logger := zap.NewNop()
+			handler := http.NewReservationHandler(logger, &http.Service{
+				ReservationService: mockReservationService,
+				TableService:       mockTableService,
+			})
+
+			// Set up Echo mock context
+			req := httptest.NewRequest(netHttp.MethodPatch, "/reservations/res-1", bytes.NewReader([]byte(`{"num_customers": 7}`)))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			e := echo.New()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("restaurantId", "id")
+			ctx.SetParamValues("r1", "res-1")
+
+			// Mock behavior
+			reservation := &coreModel.Reservation{Id: "res-1", RestaurantId: "r1", PartySize: 7, NumTables: 2, TableIds: []string{"T1", "T2"}, StartTime: startTime, Duration: 2 * time.Hour, Status: coreModel.ReservationConfirmed}
+			mockReservationService.EXPECT().ModifyReservation("r1", "res-1", 7).Return(reservation, nil).Times(1)
+			mockTableService.EXPECT().AvailableTables("r1", startTime, startTime.Add(2*time.Hour)).Return(1).Times(1)
+			mockTableService.EXPECT().Tables("r1").Return([]coreModel.Table{{Id: "T1", Label: "T1", Capacity: 4}, {Id: "T2", Label: "T2", Capacity: 4}}).Times(1)
+
+			// Execute handler
+			err := handler.ModifyReservation(ctx)
+
+			// Assertions
+			assert.NoError(t, err)
+			assert.Equal(t, netHttp.StatusOK, rec.Code)
+
+			var res model.Response
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, float64(2), res.Data.(map[string]interface{})["tables_reserved"])
+			assert.Equal(t, true, res.Data.(map[string]interface{})["combined"])
+			assert.Equal(t, float64(1), res.Data.(map[string]interface{})["remaining_tables"])
+		})
+		t.Run("ServiceError", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock dependencies
+			mockReservationService := serviceMock.NewMockReservationService(ctrl)
+			mockTableService := serviceMock.NewMockTableService(ctrl)
+			logger := zap.NewNop()
+			handler := http.NewReservationHandler(logger, &http.Service{
+				ReservationService: mockReservationService,
+				TableService:       mockTableService,
+			})
+
+			// Set up Echo mock context
+			req := httptest.NewRequest(netHttp.MethodPatch, "/reservations/res-1", bytes.NewReader([]byte(`{"num_customers": 12}`)))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			e := echo.New()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("restaurantId", "id")
+			ctx.SetParamValues("r1", "res-1")
+
+			// Mock behavior
+			mockReservationService.EXPECT().ModifyReservation("r1", "res-1", 12).Return(nil, errors.New("not enough tables available")).Times(1)
+
+			// Execute handler
+			err := handler.ModifyReservation(ctx)
+
+			// Assertions
+			assert.NoError(t, err)
+			assert.Equal(t, netHttp.StatusBadRequest, rec.Code)
+
+			var res model.Response
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, error_code.InvalidRequest, res.Code)
+			assert.Equal(t, "not enough tables available", res.Data)
+		})
+	})
 	t.Run("CancelReservation", func(t *testing.T) {
 		t.Run("Success", func(t *testing.T) {
 			ctrl := gomock.NewController(t)
@@ -150,12 +521,13 @@ func TestReservationHandler(t *testing.T) {
 			rec := httptest.NewRecorder()
 			e := echo.New()
 			ctx := e.NewContext(req, rec)
-			ctx.SetParamNames("id")
-			ctx.SetParamValues("res-1")
+			ctx.SetParamNames("restaurantId", "id")
+			ctx.SetParamValues("r1", "res-1")
 
 			// Mock behavior
-			mockReservationService.EXPECT().CancelReservation("res-1").Return(3, nil).Times(1)
-			mockTableService.EXPECT().AvailableTables().Return(10).Times(1)
+			reservation := &coreModel.Reservation{Id: "res-1", RestaurantId: "r1", NumTables: 3, StartTime: startTime, Duration: 2 * time.Hour}
+			mockReservationService.EXPECT().CancelReservation("r1", "res-1", false).Return(reservation, nil).Times(1)
+			mockTableService.EXPECT().AvailableTables("r1", startTime, startTime.Add(2*time.Hour)).Return(10).Times(1)
 
 			// Execute handler
 			err := handler.CancelReservation(ctx)
@@ -169,6 +541,94 @@ func TestReservationHandler(t *testing.T) {
 			assert.NoError(t, err)
 			assert.Equal(t, float64(3), res.Data.(map[string]interface{})["freed_tables"])
 			assert.Equal(t, float64(10), res.Data.(map[string]interface{})["remaining_tables"])
+			assert.Equal(t, false, res.Data.(map[string]interface{})["fee_applies"])
+		})
+		t.Run("LateCancellation", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock dependencies
+			mockReservationService := serviceMock.NewMockReservationService(ctrl)
+			mockTableService := serviceMock.NewMockTableService(ctrl)
+			logger := zap.NewNop()
+			handler := http.NewReservationHandler(logger, &http.Service{
+				ReservationService: mockReservationService,
+				TableService:       mockTableService,
+			})
+
+			// Set up Echo mock context
+			req := httptest.NewRequest(netHttp.MethodDelete, "/reservations/res-1", nil)
+			rec := httptest.NewRecorder()
+			e := echo.New()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("restaurantId", "id")
+			ctx.SetParamValues("r1", "res-1")
+
+			// Mock behavior
+			policy := coreModel.CancellationPolicy{Cutoff: 24 * time.Hour, Fee: 500}
+			cancellation := &coreModel.Cancellation{Policy: policy, FreeUntil: startTime.Add(-24 * time.Hour), Fee: 500}
+			reservation := &coreModel.Reservation{Id: "res-1", RestaurantId: "r1", NumTables: 1, StartTime: startTime, Duration: 2 * time.Hour, Status: coreModel.ReservationCancelled, Cancellation: cancellation}
+			mockReservationService.EXPECT().CancelReservation("r1", "res-1", false).Return(reservation, nil).Times(1)
+			mockTableService.EXPECT().AvailableTables("r1", startTime, startTime.Add(2*time.Hour)).Return(10).Times(1)
+
+			// Execute handler
+			err := handler.CancelReservation(ctx)
+
+			// Assertions
+			assert.NoError(t, err)
+			assert.Equal(t, netHttp.StatusOK, rec.Code)
+
+			var body model.Response
+			err = json.Unmarshal(rec.Body.Bytes(), &body)
+			assert.NoError(t, err)
+			var res dto.CancelReservationResponse
+			data, _ := json.Marshal(body.Data)
+			assert.NoError(t, json.Unmarshal(data, &res))
+			assert.True(t, res.FeeApplies)
+			assert.Equal(t, 500, res.Fee)
+			assert.False(t, res.FeeWaived)
+			assert.Equal(t, startTime.Add(-24*time.Hour), *res.FreeUntil)
+			assert.Equal(t, dto.CancellationPolicyResponse{CutoffMinutes: 1440, Fee: 500}, res.Policy)
+		})
+		t.Run("WaiveFee", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock dependencies
+			mockReservationService := serviceMock.NewMockReservationService(ctrl)
+			mockTableService := serviceMock.NewMockTableService(ctrl)
+			logger := zap.NewNop()
+			handler := http.NewReservationHandler(logger, &http.Service{
+				ReservationService: mockReservationService,
+				TableService:       mockTableService,
+			})
+
+			// Set up Echo mock context
+			req := httptest.NewRequest(netHttp.MethodDelete, "/reservations/res-1?waive_fee=true", nil)
+			rec := httptest.NewRecorder()
+			e := echo.New()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("restaurantId", "id")
+			ctx.SetParamValues("r1", "res-1")
+
+			// Mock behavior
+			cancellation := &coreModel.Cancellation{Policy: coreModel.CancellationPolicy{Cutoff: 24 * time.Hour, ForfeitDeposit: true}, FreeUntil: startTime.Add(-24 * time.Hour), Waived: true}
+			reservation := &coreModel.Reservation{Id: "res-1", RestaurantId: "r1", NumTables: 1, StartTime: startTime, Duration: 2 * time.Hour, Status: coreModel.ReservationCancelled, Cancellation: cancellation}
+			mockReservationService.EXPECT().CancelReservation("r1", "res-1", true).Return(reservation, nil).Times(1)
+			mockTableService.EXPECT().AvailableTables("r1", startTime, startTime.Add(2*time.Hour)).Return(10).Times(1)
+
+			// Execute handler
+			err := handler.CancelReservation(ctx)
+
+			// Assertions
+			assert.NoError(t, err)
+			assert.Equal(t, netHttp.StatusOK, rec.Code)
+
+			var res model.Response
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, false, res.Data.(map[string]interface{})["fee_applies"])
+			assert.Equal(t, true, res.Data.(map[string]interface{})["fee_waived"])
 		})
 		t.Run("MissingID", func(t *testing.T) {
 			ctrl := gomock.NewController(t)
@@ -188,6 +648,8 @@ func TestReservationHandler(t *testing.T) {
 			rec := httptest.NewRecorder()
 			e := echo.New()
 			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("restaurantId")
+			ctx.SetParamValues("r1")
 
 			// Execute handler
 			err := handler.CancelReservation(ctx)
@@ -219,11 +681,11 @@ func TestReservationHandler(t *testing.T) {
 			rec := httptest.NewRecorder()
 			e := echo.New()
 			ctx := e.NewContext(req, rec)
-			ctx.SetParamNames("id")
-			ctx.SetParamValues("res-1")
+			ctx.SetParamNames("restaurantId", "id")
+			ctx.SetParamValues("r1", "res-1")
 
 			// Mock behavior
-			mockReservationService.EXPECT().CancelReservation("res-1").Return(0, errors.New("cancellation failed")).Times(1)
+			mockReservationService.EXPECT().CancelReservation("r1", "res-1", false).Return(nil, errors.New("cancellation failed")).Times(1)
 
 			// Execute handler
 			err := handler.CancelReservation(ctx)
@@ -239,4 +701,301 @@ func TestReservationHandler(t *testing.T) {
 			assert.Equal(t, "cancellation failed", res.Data)
 		})
 	})
+	t.Run("GetReservation", func(t *testing.T) {
+		t.Run("Success", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock dependencies
+			mockReservationService := serviceMock.NewMockReservationService(ctrl)
+			mockTableService := serviceMock.NewMockTableService(ctrl)
+			logger := zap.NewNop()
+			handler := http.NewReservationHandler(logger, &http.Service{
+				ReservationService: mockReservationService,
+				TableService:       mockTableService,
+			})
+
+			// Set up Echo mock context
+			req := httptest.NewRequest(netHttp.MethodGet, "/reservations/res-1", nil)
+			rec := httptest.NewRecorder()
+			e := echo.New()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("restaurantId", "id")
+			ctx.SetParamValues("r1", "res-1")
+
+			// Mock behavior
+			reservation := &coreModel.Reservation{Id: "res-1", RestaurantId: "r1", NumTables: 1, TableIds: []string{"T1"}, StartTime: startTime, Duration: 2 * time.Hour, Status: coreModel.ReservationCancelled}
+			mockReservationService.EXPECT().FindReservation("r1", "res-1").Return(reservation, nil).Times(1)
+			mockTableService.EXPECT().AvailableTables("r1", startTime, startTime.Add(2*time.Hour)).Return(4).Times(1)
+			mockTableService.EXPECT().Tables("r1").Return([]coreModel.Table{{Id: "T1", Label: "Window", Capacity: 4}}).Times(1)
+
+			// Execute handler
+			err := handler.GetReservation(ctx)
+
+			// Assertions
+			assert.NoError(t, err)
+			assert.Equal(t, netHttp.StatusOK, rec.Code)
+
+			var res model.Response
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, "res-1", res.Data.(map[string]interface{})["booking_id"])
+			assert.Equal(t, "cancelled", res.Data.(map[string]interface{})["status"])
+		})
+		t.Run("NotFound", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock dependencies
+			mockReservationService := serviceMock.NewMockReservationService(ctrl)
+			mockTableService := serviceMock.NewMockTableService(ctrl)
+			logger := zap.NewNop()
+			handler := http.NewReservationHandler(logger, &http.Service{
+				ReservationService: mockReservationService,
+				TableService:       mockTableService,
+			})
+
+			// Set up Echo mock context
+			req := httptest.NewRequest(netHttp.MethodGet, "/reservations/res-1", nil)
+			rec := httptest.NewRecorder()
+			e := echo.New()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("restaurantId", "id")
+			ctx.SetParamValues("r1", "res-1")
+
+			// Mock behavior
+			mockReservationService.EXPECT().FindReservation("r1", "res-1").Return(nil, errors.New("reservation not found")).Times(1)
+
+			// Execute handler
+			err := handler.GetReservation(ctx)
+
+			// Assertions
+			assert.NoError(t, err)
+			assert.Equal(t, netHttp.StatusBadRequest, rec.Code)
+
+			var res model.Response
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, "reservation not found", res.Data)
+		})
+	})
+	t.Run("SeatReservation", func(t *testing.T) {
+		t.Run("Success", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock dependencies
+			mockReservationService := serviceMock.NewMockReservationService(ctrl)
+			mockTableService := serviceMock.NewMockTableService(ctrl)
+			logger := zap.NewNop()
+			handler := http.NewReservationHandler(logger, &http.Service{
+				ReservationService: mockReservationService,
+				TableService:       mockTableService,
+			})
+
+			// Set up Echo mock context
+			req := httptest.NewRequest(netHttp.MethodPost, "/reservations/res-1/seat", nil)
+			rec := httptest.NewRecorder()
+			e := echo.New()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("restaurantId", "id")
+			ctx.SetParamValues("r1", "res-1")
+
+			// Mock behavior
+			reservation := &coreModel.Reservation{Id: "res-1", RestaurantId: "r1", NumTables: 1, TableIds: []string{"T1"}, StartTime: startTime, Duration: 2 * time.Hour, Status: coreModel.ReservationSeated}
+			mockReservationService.EXPECT().SeatReservation("r1", "res-1").Return(reservation, nil).Times(1)
+			mockTableService.EXPECT().AvailableTables("r1", startTime, startTime.Add(2*time.Hour)).Return(3).Times(1)
+			mockTableService.EXPECT().Tables("r1").Return([]coreModel.Table{{Id: "T1", Label: "Window", Capacity: 4}}).Times(1)
+
+			// Execute handler
+			err := handler.SeatReservation(ctx)
+
+			// Assertions
+			assert.NoError(t, err)
+			assert.Equal(t, netHttp.StatusOK, rec.Code)
+
+			var res model.Response
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, "seated", res.Data.(map[string]interface{})["status"])
+			assert.Equal(t, float64(3), res.Data.(map[string]interface{})["remaining_tables"])
+		})
+	})
+	t.Run("CompleteReservation", func(t *testing.T) {
+		t.Run("InvalidTransition", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock dependencies
+			mockReservationService := serviceMock.NewMockReservationService(ctrl)
+			mockTableService := serviceMock.NewMockTableService(ctrl)
+			logger := zap.NewNop()
+			handler := http.NewReservationHandler(logger, &http.Service{
+				ReservationService: mockReservationService,
+				TableService:       mockTableService,
+			})
+
+			// Set up Echo mock context
+			req := httptest.NewRequest(netHttp.MethodPost, "/reservations/res-1/complete", nil)
+			rec := httptest.NewRecorder()
+			e := echo.New()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("restaurantId", "id")
+			ctx.SetParamValues("r1", "res-1")
+
+			// Mock behavior
+			mockReservationService.EXPECT().CompleteReservation("r1", "res-1").Return(nil, errors.New("cannot change reservation from confirmed to completed")).Times(1)
+
+			// Execute handler
+			err := handler.CompleteReservation(ctx)
+
+			// Assertions
+			assert.NoError(t, err)
+			assert.Equal(t, netHttp.StatusBadRequest, rec.Code)
+
+			var res model.Response
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, error_code.InvalidRequest, res.Code)
+			assert.Equal(t, "cannot change reservation from confirmed to completed", res.Data)
+		})
+	})
+	t.Run("MarkNoShow", func(t *testing.T) {
+		t.Run("Success", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock dependencies
+			mockReservationService := serviceMock.NewMockReservationService(ctrl)
+			mockTableService := serviceMock.NewMockTableService(ctrl)
+			logger := zap.NewNop()
+			handler := http.NewReservationHandler(logger, &http.Service{
+				ReservationService: mockReservationService,
+				TableService:       mockTableService,
+			})
+
+			// Set up Echo mock context
+			req := httptest.NewRequest(netHttp.MethodPost, "/reservations/res-1/no-show", nil)
+			rec := httptest.NewRecorder()
+			e := echo.New()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("restaurantId", "id")
+			ctx.SetParamValues("r1", "res-1")
+
+			// Mock behavior
+			reservation := &coreModel.Reservation{Id: "res-1", RestaurantId: "r1", NumTables: 1, TableIds: []string{"T1"}, StartTime: startTime, Duration: 2 * time.Hour, Status: coreModel.ReservationNoShow}
+			mockReservationService.EXPECT().MarkNoShow("r1", "res-1").Return(reservation, nil).Times(1)
+			mockTableService.EXPECT().AvailableTables("r1", startTime, startTime.Add(2*time.Hour)).Return(4).Times(1)
+			mockTableService.EXPECT().Tables("r1").Return([]coreModel.Table{{Id: "T1", Label: "Window", Capacity: 4}}).Times(1)
+
+			// Execute handler
+			err := handler.MarkNoShow(ctx)
+
+			// Assertions
+			assert.NoError(t, err)
+			assert.Equal(t, netHttp.StatusOK, rec.Code)
+
+			var res model.Response
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, "no_show", res.Data.(map[string]interface{})["status"])
+			assert.Equal(t, float64(4), res.Data.(map[string]interface{})["remaining_tables"])
+		})
+	})
+	t.Run("SeatWalkIn", func(t *testing.T) {
+		newContext := func(body string) (echo.Context, *httptest.ResponseRecorder) {
+			req := httptest.NewRequest(netHttp.MethodPost, "/walk-ins", bytes.NewReader([]byte(body)))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			e := echo.New()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("restaurantId")
+			ctx.SetParamValues("r1")
+			return ctx, rec
+		}
+
+		t.Run("Success", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock dependencies
+			mockReservationService := serviceMock.NewMockReservationService(ctrl)
+			mockTableService := serviceMock.NewMockTableService(ctrl)
+			logger := zap.NewNop()
+			handler := http.NewReservationHandler(logger, &http.Service{
+				ReservationService: mockReservationService,
+				TableService:       mockTableService,
+			})
+
+			// Set up Echo mock context
+			ctx, rec := newContext(`{"num_customers": 2, "name": "Sam Lee"}`)
+
+			// Mock behavior
+			reservation := &coreModel.Reservation{Id: "res-1", RestaurantId: "r1", PartySize: 2, Guest: coreModel.Guest{Name: "Sam Lee"}, NumTables: 1, TableIds: []string{"T1"}, StartTime: startTime, Duration: 90 * time.Minute, Status: coreModel.ReservationSeated, WalkIn: true}
+			mockReservationService.EXPECT().SeatWalkIn("r1", 2, coreModel.Guest{Name: "Sam Lee"}, time.Duration(0)).Return(reservation, nil).Times(1)
+			mockTableService.EXPECT().AvailableTables("r1", startTime, startTime.Add(90*time.Minute)).Return(2).Times(1)
+			mockTableService.EXPECT().Tables("r1").Return([]coreModel.Table{{Id: "T1", Label: "T1", Capacity: 4}}).Times(1)
+
+			// Execute handler
+			err := handler.SeatWalkIn(ctx)
+
+			// Assertions
+			assert.NoError(t, err)
+			assert.Equal(t, netHttp.StatusOK, rec.Code)
+
+			var res model.Response
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, "seated", res.Data.(map[string]interface{})["status"])
+			assert.Equal(t, true, res.Data.(map[string]interface{})["walk_in"])
+		})
+		t.Run("Wait", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock dependencies
+			mockReservationService := serviceMock.NewMockReservationService(ctrl)
+			logger := zap.NewNop()
+			handler := http.NewReservationHandler(logger, &http.Service{ReservationService: mockReservationService})
+
+			// Set up Echo mock context
+			ctx, rec := newContext(`{"num_customers": 2}`)
+
+			// Mock behavior
+			availableAt := startTime.Add(30 * time.Minute)
+			mockReservationService.EXPECT().SeatWalkIn("r1", 2, coreModel.Guest{}, time.Duration(0)).Return(nil, &service.WaitError{AvailableAt: availableAt, EstimatedWait: 30 * time.Minute}).Times(1)
+
+			// Execute handler
+			err := handler.SeatWalkIn(ctx)
+
+			// Assertions
+			assert.NoError(t, err)
+			assert.Equal(t, netHttp.StatusBadRequest, rec.Code)
+
+			var res model.Response
+			_ = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.Equal(t, float64(30), res.Data.(map[string]interface{})["estimated_wait_minutes"])
+			assert.Equal(t, "2025-01-10T19:30:00Z", res.Data.(map[string]interface{})["available_at"])
+			assert.Equal(t, "no table is free, estimated wait is 30 minutes", res.Data.(map[string]interface{})["message"])
+		})
+		t.Run("InvalidPhone", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock dependencies
+			mockReservationService := serviceMock.NewMockReservationService(ctrl)
+			logger := zap.NewNop()
+			handler := http.NewReservationHandler(logger, &http.Service{ReservationService: mockReservationService})
+
+			// Set up Echo mock context
+			ctx, rec := newContext(`{"num_customers": 2, "phone": "0812345678"}`)
+
+			// Execute handler
+			err := handler.SeatWalkIn(ctx)
+
+			// Assertions
+			assert.NoError(t, err)
+			assert.Equal(t, netHttp.StatusBadRequest, rec.Code)
+		})
+	})
 }
@@ -0,0 +1,72 @@
+package http
+
+import (
+	"errors"
+	"github.com/bossncn/go-common/http/echo/response"
+	"github.com/bossncn/go-common/http/model"
+	"github.com/bossncn/go-common/http/model/error_code"
+	"github.com/bossncn/restaurant-reservation-service/internal/adapters/dto"
+	coreModel "github.com/bossncn/restaurant-reservation-service/internal/core/model"
+	"github.com/bossncn/restaurant-reservation-service/internal/core/service"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+type RestaurantHandler struct {
+	logger            *zap.Logger
+	restaurantService service.RestaurantService
+}
+
+func NewRestaurantHandler(logger *zap.Logger, service *Service) *RestaurantHandler {
+	return &RestaurantHandler{
+		logger:            logger,
+		restaurantService: service.RestaurantService,
+	}
+}
+
+func (handler *RestaurantHandler) RegisterRoutes(publicRoute *echo.Group, secureRoute *echo.Group) {
+	publicRoute.GET("/restaurants", handler.ListRestaurants)
+	secureRoute.POST("/restaurants", handler.CreateRestaurant)
+}
+
+// CreateRestaurant
+// @Summary Register a restaurant
+// @Description Registers a venue. Its id scopes every table and reservation route; a generated id is used when none is given.
+// @Tags Restaurant
+// @Accept json
+// @Produce json
+// @Param request body dto.CreateRestaurantRequest true "Restaurant to register."
+// @Success 200 {object} model.Response{data=dto.RestaurantResponse} "Restaurant registered."
+// @Failure 400 {object} model.Response{} "Registration error."
+// @Router /secure/restaurants [post]
+func (handler *RestaurantHandler) CreateRestaurant(ctx echo.Context) error {
+	var req dto.CreateRestaurantRequest
+	if err := ctx.Bind(&req); err != nil {
+		handler.logger.Error("Failed to bind request", zap.Error(err))
+		return response.Response(ctx, nil, errors.New(error_code.InvalidRequest))
+	}
+
+	restaurant, err := handler.restaurantService.CreateRestaurant(coreModel.Restaurant{Id: req.Id, Name: req.Name})
+	if err != nil {
+		handler.logger.Error("Failed to create restaurant", zap.Error(err))
+		return response.Response(ctx, model.CreateError(error_code.InvalidRequest, err.Error()), err)
+	}
+
+	return response.Response(ctx, dto.RestaurantResponse{Id: restaurant.Id, Name: restaurant.Name}, nil)
+}
+
+// ListRestaurants
+// @Summary List restaurants
+// @Description Lists every registered venue.
+// @Tags Restaurant
+// @Produce json
+// @Success 200 {object} model.Response{data=[]dto.RestaurantResponse} "Registered restaurants."
+// @Router /public/restaurants [get]
+func (handler *RestaurantHandler) ListRestaurants(ctx echo.Context) error {
+	restaurants := make([]dto.RestaurantResponse, 0)
+	for _, restaurant := range handler.restaurantService.ListRestaurants() {
+		restaurants = append(restaurants, dto.RestaurantResponse{Id: restaurant.Id, Name: restaurant.Name})
+	}
+
+	return response.Response(ctx, restaurants, nil)
+}
@@ -0,0 +1,121 @@
+package http_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"github.com/bossncn/go-common/http/model"
+	"github.com/bossncn/go-common/http/model/error_code"
+	"github.com/bossncn/restaurant-reservation-service/internal/adapters/dto"
+	"github.com/bossncn/restaurant-reservation-service/internal/adapters/http"
+	coreModel "github.com/bossncn/restaurant-reservation-service/internal/core/model"
+	serviceMock "github.com/bossncn/restaurant-reservation-service/internal/core/service/mock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	netHttp "net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRestaurantHandler(t *testing.T) {
+	t.Run("CreateRestaurant", func(t *testing.T) {
+		t.Run("Success", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock dependencies
+			mockRestaurantService := serviceMock.NewMockRestaurantService(ctrl)
+			logger := zap.NewNop()
+			handler := http.NewRestaurantHandler(logger, &http.Service{RestaurantService: mockRestaurantService})
+
+			// Set up Echo mock context
+			reqBody := dto.CreateRestaurantRequest{Id: "downtown", Name: "Downtown"}
+			reqJSON, _ := json.Marshal(reqBody)
+			req := httptest.NewRequest(netHttp.MethodPost, "/restaurants", bytes.NewReader(reqJSON))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			e := echo.New()
+			ctx := e.NewContext(req, rec)
+
+			// Mock behavior
+			mockRestaurantService.EXPECT().CreateRestaurant(coreModel.Restaurant{Id: "downtown", Name: "Downtown"}).Return(&coreModel.Restaurant{Id: "downtown", Name: "Downtown"}, nil).Times(1)
+
+			// Execute handler
+			err := handler.CreateRestaurant(ctx)
+
+			// Assertions
+			assert.NoError(t, err)
+			assert.Equal(t, netHttp.StatusOK, rec.Code)
+
+			var res model.Response
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, "downtown", res.Data.(map[string]interface{})["id"])
+			assert.Equal(t, "Downtown", res.Data.(map[string]interface{})["name"])
+		})
+		t.Run("ServiceError", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock dependencies
+			mockRestaurantService := serviceMock.NewMockRestaurantService(ctrl)
+			logger := zap.NewNop()
+			handler := http.NewRestaurantHandler(logger, &http.Service{RestaurantService: mockRestaurantService})
+
+			// Set up Echo mock context
+			req := httptest.NewRequest(netHttp.MethodPost, "/restaurants", bytes.NewReader([]byte(`{"id": "downtown"}`)))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			e := echo.New()
+			ctx := e.NewContext(req, rec)
+
+			// Mock behavior
+			mockRestaurantService.EXPECT().CreateRestaurant(coreModel.Restaurant{Id: "downtown"}).Return(nil, errors.New("restaurant name is required")).Times(1)
+
+			// Execute handler
+			err := handler.CreateRestaurant(ctx)
+
+			// Assertions
+			assert.NoError(t, err)
+			assert.Equal(t, netHttp.StatusBadRequest, rec.Code)
+
+			var res model.Response
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, error_code.InvalidRequest, res.Code)
+			assert.Equal(t, "restaurant name is required", res.Data)
+		})
+	})
+	t.Run("ListRestaurants", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Mock dependencies
+		mockRestaurantService := serviceMock.NewMockRestaurantService(ctrl)
+		logger := zap.NewNop()
+		handler := http.NewRestaurantHandler(logger, &http.Service{RestaurantService: mockRestaurantService})
+
+		// Set up Echo mock context
+		req := httptest.NewRequest(netHttp.MethodGet, "/restaurants", nil)
+		rec := httptest.NewRecorder()
+		e := echo.New()
+		ctx := e.NewContext(req, rec)
+
+		// Mock behavior
+		mockRestaurantService.EXPECT().ListRestaurants().Return([]coreModel.Restaurant{{Id: "downtown", Name: "Downtown"}, {Id: "harbour", Name: "Harbour"}}).Times(1)
+
+		// Execute handler
+		err := handler.ListRestaurants(ctx)
+
+		// Assertions
+		assert.NoError(t, err)
+		assert.Equal(t, netHttp.StatusOK, rec.Code)
+
+		var res model.Response
+		err = json.Unmarshal(rec.Body.Bytes(), &res)
+		assert.NoError(t, err)
+		assert.Len(t, res.Data.([]interface{}), 2)
+	})
+}
@@ -0,0 +1,152 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"github.com/bossncn/go-common/http/echo/response"
+	"github.com/bossncn/go-common/http/model"
+	"github.com/bossncn/go-common/http/model/error_code"
+	"github.com/bossncn/restaurant-reservation-service/internal/adapters/dto"
+	coreModel "github.com/bossncn/restaurant-reservation-service/internal/core/model"
+	"github.com/bossncn/restaurant-reservation-service/internal/core/service"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+	"strings"
+	"time"
+)
+
+type ScheduleHandler struct {
+	logger          *zap.Logger
+	scheduleService service.ScheduleService
+}
+
+func NewScheduleHandler(logger *zap.Logger, service *Service) *ScheduleHandler {
+	return &ScheduleHandler{
+		logger:          logger,
+		scheduleService: service.ScheduleService,
+	}
+}
+
+func (handler *ScheduleHandler) RegisterRoutes(publicRoute *echo.Group, secureRoute *echo.Group) {
+	publicRoute.GET("/schedule", handler.GetSchedule)
+	secureRoute.PUT("/schedule", handler.SetSchedule)
+}
+
+// SetSchedule
+// @Summary Set opening hours
+// @Description Replaces the restaurant's weekly shifts and closure days. Once set, bookings are only accepted when the whole visit falls inside a shift, and a shift's max_tables caps how many tables are booked at once (0 means no cap). Pacing limits how many covers (max_covers) and parties (max_parties) may arrive in each pacing interval of the shift, however many tables are free; bookings and availability respect them. Overbooking lets a shift accept reservations once no tables are free, up to overbooking_percent of its tables or overbooking_tables tables; those reservations are marked overbooked. A schedule without shifts only applies its closures.
+// @Tags Schedule
+// @Accept json
+// @Produce json
+// @Param restaurantId path string true "Restaurant ID"
+// @Param request body dto.ScheduleRequest true "Time zone, weekly shifts and closure days."
+// @Success 200 {object} model.Response{data=dto.ScheduleResponse} "Schedule saved."
+// @Failure 400 {object} model.Response{data=[]dto.FieldErrorResponse} "Invalid schedule."
+// @Router /secure/restaurants/{restaurantId}/schedule [put]
+func (handler *ScheduleHandler) SetSchedule(ctx echo.Context) error {
+	var req dto.ScheduleRequest
+	if err := ctx.Bind(&req); err != nil {
+		handler.logger.Error("Failed to bind request", zap.Error(err))
+		return response.Response(ctx, nil, errors.New(error_code.InvalidRequest))
+	}
+
+	if fieldErrors := validateRequest(req); fieldErrors != nil {
+		handler.logger.Error("Invalid schedule request", zap.Any("fieldErrors", fieldErrors))
+		return response.Response(ctx, model.CreateError(error_code.InvalidRequest, fieldErrors), errors.New(error_code.InvalidRequest))
+	}
+
+	schedule := coreModel.Schedule{RestaurantId: ctx.Param("restaurantId"), TimeZone: req.TimeZone}
+	for _, shift := range req.Shifts {
+		schedule.Shifts = append(schedule.Shifts, coreModel.Shift{
+			Name:      shift.Name,
+			Weekday:   parseWeekday(shift.Day),
+			Opens:     parseTimeOfDay(shift.Opens),
+			Closes:    parseTimeOfDay(shift.Closes),
+			MaxTables: shift.MaxTables,
+			Pacing: coreModel.Pacing{
+				Interval:   time.Duration(shift.PacingIntervalMinutes) * time.Minute,
+				MaxCovers:  shift.MaxCovers,
+				MaxParties: shift.MaxParties,
+			},
+			Overbooking: coreModel.Overbooking{Percent: shift.OverbookingPercent, Tables: shift.OverbookingTables},
+		})
+	}
+	for _, closure := range req.Closures {
+		schedule.Closures = append(schedule.Closures, coreModel.Closure{Date: closure.Date, Reason: closure.Reason})
+	}
+
+	saved, err := handler.scheduleService.SetSchedule(schedule)
+	if err != nil {
+		handler.logger.Error("Failed to set schedule", zap.Error(err))
+		return response.Response(ctx, model.CreateError(error_code.InvalidRequest, err.Error()), err)
+	}
+
+	return response.Response(ctx, scheduleResponse(saved), nil)
+}
+
+// GetSchedule
+// @Summary Get opening hours
+// @Description Returns the restaurant's weekly shifts and closure days.
+// @Tags Schedule
+// @Produce json
+// @Param restaurantId path string true "Restaurant ID"
+// @Success 200 {object} model.Response{data=dto.ScheduleResponse} "Schedule found."
+// @Failure 400 {object} model.Response{} "Schedule not found."
+// @Router /public/restaurants/{restaurantId}/schedule [get]
+func (handler *ScheduleHandler) GetSchedule(ctx echo.Context) error {
+	schedule, err := handler.scheduleService.FindSchedule(ctx.Param("restaurantId"))
+	if err != nil {
+		handler.logger.Error("Failed to find schedule", zap.Error(err))
+		return response.Response(ctx, model.CreateError(error_code.InvalidRequest, err.Error()), err)
+	}
+
+	return response.Response(ctx, scheduleResponse(schedule), nil)
+}
+
+// parseWeekday maps a validated lower-case day name to its weekday.
+func parseWeekday(day string) time.Weekday {
+	for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+		if strings.EqualFold(weekday.String(), day) {
+			return weekday
+		}
+	}
+	return -1
+}
+
+// parseTimeOfDay converts a validated HH:MM time, which may be 24:00 or later, to an offset from
+// midnight.
+func parseTimeOfDay(value string) time.Duration {
+	var hours, minutes int
+	_, _ = fmt.Sscanf(value, "%d:%d", &hours, &minutes)
+	return time.Duration(hours)*time.Hour + time.Duration(minutes)*time.Minute
+}
+
+func formatTimeOfDay(offset time.Duration) string {
+	return fmt.Sprintf("%02d:%02d", int(offset/time.Hour), int(offset%time.Hour/time.Minute))
+}
+
+func scheduleResponse(schedule *coreModel.Schedule) dto.ScheduleResponse {
+	res := dto.ScheduleResponse{
+		TimeZone: schedule.TimeZone,
+		Shifts:   make([]dto.ShiftResponse, 0, len(schedule.Shifts)),
+		Closures: make([]dto.ClosureResponse, 0, len(schedule.Closures)),
+	}
+	for _, shift := range schedule.Shifts {
+		res.Shifts = append(res.Shifts, dto.ShiftResponse{
+			Name:                  shift.Name,
+			Day:                   strings.ToLower(shift.Weekday.String()),
+			Opens:                 formatTimeOfDay(shift.Opens),
+			Closes:                formatTimeOfDay(shift.Closes),
+			MaxTables:             shift.MaxTables,
+			PacingIntervalMinutes: int(shift.Pacing.Interval / time.Minute),
+			MaxCovers:             shift.Pacing.MaxCovers,
+			MaxParties:            shift.Pacing.MaxParties,
+			OverbookingPercent:    shift.Overbooking.Percent,
+			OverbookingTables:     shift.Overbooking.Tables,
+		})
+	}
+	for _, closure := range schedule.Closures {
+		res.Closures = append(res.Closures, dto.ClosureResponse{Date: closure.Date, Reason: closure.Reason})
+	}
+	return res
+}
@@ -0,0 +1,246 @@
+package http_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"github.com/bossncn/go-common/http/model"
+	"github.com/bossncn/restaurant-reservation-service/internal/adapters/dto"
+	"github.com/bossncn/restaurant-reservation-service/internal/adapters/http"
+	coreModel "github.com/bossncn/restaurant-reservation-service/internal/core/model"
+	serviceMock "github.com/bossncn/restaurant-reservation-service/internal/core/service/mock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	netHttp "net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestScheduleHandler(t *testing.T) {
+	schedule := coreModel.Schedule{
+		RestaurantId: "r1",
+		TimeZone:     "Asia/Bangkok",
+		Shifts:       []coreModel.Shift{{Name: "Dinner", Weekday: time.Friday, Opens: 17*time.Hour + 30*time.Minute, Closes: 22 * time.Hour, MaxTables: 8}},
+		Closures:     []coreModel.Closure{{Date: "2025-04-13", Reason: "Songkran"}},
+	}
+
+	newContext := func(method string, body string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(method, "/schedule", bytes.NewReader([]byte(body)))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		e := echo.New()
+		ctx := e.NewContext(req, rec)
+		ctx.SetParamNames("restaurantId")
+		ctx.SetParamValues("r1")
+		return ctx, rec
+	}
+
+	t.Run("SetSchedule", func(t *testing.T) {
+		t.Run("Success", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock dependencies
+			mockScheduleService := serviceMock.NewMockScheduleService(ctrl)
+			logger := zap.NewNop()
+			handler := http.NewScheduleHandler(logger, &http.Service{ScheduleService: mockScheduleService})
+
+			// Set up Echo mock context
+			ctx, rec := newContext(netHttp.MethodPut, `{"time_zone": "Asia/Bangkok", "shifts": [{"name": "Dinner", "day": "friday", "opens": "17:30", "closes": "22:00", "max_tables": 8}], "closures": [{"date": "2025-04-13", "reason": "Songkran"}]}`)
+
+			// Mock behavior
+			mockScheduleService.EXPECT().SetSchedule(schedule).Return(&schedule, nil).Times(1)
+
+			// Execute handler
+			err := handler.SetSchedule(ctx)
+
+			// Assertions
+			assert.NoError(t, err)
+			assert.Equal(t, netHttp.StatusOK, rec.Code)
+
+			var res model.Response
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			shift := res.Data.(map[string]interface{})["shifts"].([]interface{})[0].(map[string]interface{})
+			assert.Equal(t, "friday", shift["day"])
+			assert.Equal(t, "17:30", shift["opens"])
+			assert.Equal(t, "22:00", shift["closes"])
+		})
+		t.Run("Pacing", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock dependencies
+			mockScheduleService := serviceMock.NewMockScheduleService(ctrl)
+			logger := zap.NewNop()
+			handler := http.NewScheduleHandler(logger, &http.Service{ScheduleService: mockScheduleService})
+
+			// Set up Echo mock context
+			ctx, rec := newContext(netHttp.MethodPut, `{"shifts": [{"name": "Dinner", "day": "friday", "opens": "17:00", "closes": "22:00", "pacing_interval_minutes": 30, "max_covers": 20, "max_parties": 6}]}`)
+
+			// Mock behavior
+			paced := coreModel.Schedule{
+				RestaurantId: "r1",
+				Shifts:       []coreModel.Shift{{Name: "Dinner", Weekday: time.Friday, Opens: 17 * time.Hour, Closes: 22 * time.Hour, Pacing: coreModel.Pacing{Interval: 30 * time.Minute, MaxCovers: 20, MaxParties: 6}}},
+			}
+			mockScheduleService.EXPECT().SetSchedule(paced).Return(&paced, nil).Times(1)
+
+			// Execute handler
+			err := handler.SetSchedule(ctx)
+
+			// Assertions
+			assert.NoError(t, err)
+			assert.Equal(t, netHttp.StatusOK, rec.Code)
+
+			var res struct {
+				Data dto.ScheduleResponse `json:"data"`
+			}
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, 30, res.Data.Shifts[0].PacingIntervalMinutes)
+			assert.Equal(t, 20, res.Data.Shifts[0].MaxCovers)
+			assert.Equal(t, 6, res.Data.Shifts[0].MaxParties)
+		})
+		t.Run("Overbooking", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock dependencies
+			mockScheduleService := serviceMock.NewMockScheduleService(ctrl)
+			logger := zap.NewNop()
+			handler := http.NewScheduleHandler(logger, &http.Service{ScheduleService: mockScheduleService})
+
+			// Set up Echo mock context
+			ctx, rec := newContext(netHttp.MethodPut, `{"shifts": [{"name": "Dinner", "day": "friday", "opens": "17:00", "closes": "22:00", "overbooking_percent": 8}, {"name": "Lunch", "day": "saturday", "opens": "11:00", "closes": "14:00", "overbooking_tables": 2}]}`)
+
+			// Mock behavior
+			overbooked := coreModel.Schedule{
+				RestaurantId: "r1",
+				Shifts: []coreModel.Shift{
+					{Name: "Dinner", Weekday: time.Friday, Opens: 17 * time.Hour, Closes: 22 * time.Hour, Overbooking: coreModel.Overbooking{Percent: 8}},
+					{Name: "Lunch", Weekday: time.Saturday, Opens: 11 * time.Hour, Closes: 14 * time.Hour, Overbooking: coreModel.Overbooking{Tables: 2}},
+				},
+			}
+			mockScheduleService.EXPECT().SetSchedule(overbooked).Return(&overbooked, nil).Times(1)
+
+			// Execute handler
+			err := handler.SetSchedule(ctx)
+
+			// Assertions
+			assert.NoError(t, err)
+			assert.Equal(t, netHttp.StatusOK, rec.Code)
+
+			var res struct {
+				Data dto.ScheduleResponse `json:"data"`
+			}
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, 8, res.Data.Shifts[0].OverbookingPercent)
+			assert.Equal(t, 2, res.Data.Shifts[1].OverbookingTables)
+		})
+		t.Run("InvalidShift", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock dependencies
+			mockScheduleService := serviceMock.NewMockScheduleService(ctrl)
+			logger := zap.NewNop()
+			handler := http.NewScheduleHandler(logger, &http.Service{ScheduleService: mockScheduleService})
+
+			// Set up Echo mock context
+			ctx, rec := newContext(netHttp.MethodPut, `{"shifts": [{"name": "Dinner", "day": "funday", "opens": "5pm", "closes": "22:00"}]}`)
+
+			// Execute handler
+			err := handler.SetSchedule(ctx)
+
+			// Assertions
+			assert.NoError(t, err)
+			assert.Equal(t, netHttp.StatusBadRequest, rec.Code)
+
+			var res model.Response
+			_ = json.Unmarshal(rec.Body.Bytes(), &res)
+			fieldErrors := res.Data.([]interface{})
+			assert.Len(t, fieldErrors, 2)
+			assert.Equal(t, "shifts[0].day", fieldErrors[0].(map[string]interface{})["field"])
+			assert.Equal(t, "shifts[0].opens", fieldErrors[1].(map[string]interface{})["field"])
+			assert.Equal(t, "must be formatted as 15:04", fieldErrors[1].(map[string]interface{})["message"])
+		})
+		t.Run("ServiceError", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock dependencies
+			mockScheduleService := serviceMock.NewMockScheduleService(ctrl)
+			logger := zap.NewNop()
+			handler := http.NewScheduleHandler(logger, &http.Service{ScheduleService: mockScheduleService})
+
+			// Set up Echo mock context
+			ctx, rec := newContext(netHttp.MethodPut, `{"time_zone": "Mars/Olympus"}`)
+
+			// Mock behavior
+			mockScheduleService.EXPECT().SetSchedule(coreModel.Schedule{RestaurantId: "r1", TimeZone: "Mars/Olympus"}).Return(nil, errors.New("unknown time zone Mars/Olympus")).Times(1)
+
+			// Execute handler
+			err := handler.SetSchedule(ctx)
+
+			// Assertions
+			assert.NoError(t, err)
+			assert.Equal(t, netHttp.StatusBadRequest, rec.Code)
+		})
+	})
+	t.Run("GetSchedule", func(t *testing.T) {
+		t.Run("Success", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock dependencies
+			mockScheduleService := serviceMock.NewMockScheduleService(ctrl)
+			logger := zap.NewNop()
+			handler := http.NewScheduleHandler(logger, &http.Service{ScheduleService: mockScheduleService})
+
+			// Set up Echo mock context
+			ctx, rec := newContext(netHttp.MethodGet, "")
+
+			// Mock behavior
+			mockScheduleService.EXPECT().FindSchedule("r1").Return(&schedule, nil).Times(1)
+
+			// Execute handler
+			err := handler.GetSchedule(ctx)
+
+			// Assertions
+			assert.NoError(t, err)
+			assert.Equal(t, netHttp.StatusOK, rec.Code)
+
+			var res model.Response
+			_ = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.Equal(t, "Asia/Bangkok", res.Data.(map[string]interface{})["time_zone"])
+			closure := res.Data.(map[string]interface{})["closures"].([]interface{})[0].(map[string]interface{})
+			assert.Equal(t, "Songkran", closure["reason"])
+		})
+		t.Run("NotFound", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock dependencies
+			mockScheduleService := serviceMock.NewMockScheduleService(ctrl)
+			logger := zap.NewNop()
+			handler := http.NewScheduleHandler(logger, &http.Service{ScheduleService: mockScheduleService})
+
+			// Set up Echo mock context
+			ctx, rec := newContext(netHttp.MethodGet, "")
+
+			// Mock behavior
+			mockScheduleService.EXPECT().FindSchedule("r1").Return(nil, errors.New("schedule not found")).Times(1)
+
+			// Execute handler
+			err := handler.GetSchedule(ctx)
+
+			// Assertions
+			assert.NoError(t, err)
+			assert.Equal(t, netHttp.StatusBadRequest, rec.Code)
+		})
+	})
+}
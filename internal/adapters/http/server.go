@@ -16,51 +16,95 @@ import (
 )
 
 type Repository struct {
+	RestaurantRepository  repository.RestaurantRepository
 	TableRepository       repository.TableRepository
 	ReservationRepository repository.ReservationRepository
+	WaitlistRepository    repository.WaitlistRepository
+	ScheduleRepository    repository.ScheduleRepository
+	BlockRepository       repository.BlockRepository
 }
 
 type Middleware struct {
-	Logger echo.MiddlewareFunc
+	Logger     echo.MiddlewareFunc
+	Restaurant echo.MiddlewareFunc
 }
 
 type Handler struct {
-	TableHandler       *TableHandler
-	ReservationHandler *ReservationHandler
+	RestaurantHandler   *RestaurantHandler
+	TableHandler        *TableHandler
+	ReservationHandler  *ReservationHandler
+	WaitlistHandler     *WaitlistHandler
+	ScheduleHandler     *ScheduleHandler
+	AvailabilityHandler *AvailabilityHandler
 }
 
 type Service struct {
-	TableService       service.TableService
-	ReservationService service.ReservationService
+	RestaurantService   service.RestaurantService
+	TableService        service.TableService
+	ReservationService  service.ReservationService
+	WaitlistService     service.WaitlistService
+	ScheduleService     service.ScheduleService
+	AvailabilityService service.AvailabilityService
+	BlockService        service.BlockService
 }
 
 func InitRepository() *Repository {
 	return &Repository{
+		RestaurantRepository:  memory.NewRestaurantRepository(),
 		TableRepository:       memory.NewTableRepository(),
 		ReservationRepository: memory.NewReservationRepository(),
+		WaitlistRepository:    memory.NewWaitlistRepository(),
+		ScheduleRepository:    memory.NewScheduleRepository(),
+		BlockRepository:       memory.NewBlockRepository(),
 	}
 }
 
-func InitMiddleware(logger *zap.Logger) *Middleware {
+func InitMiddleware(logger *zap.Logger, services *Service) *Middleware {
 	return &Middleware{
-		Logger: middleware.ZapLoggerMiddleware(logger),
+		Logger:     middleware.ZapLoggerMiddleware(logger),
+		Restaurant: middleware.RestaurantMiddleware(logger, services.RestaurantService),
 	}
 }
 
 func InitHandler(logger *zap.Logger, services *Service) *Handler {
 	return &Handler{
-		TableHandler:       NewTableHandler(logger, services),
-		ReservationHandler: NewReservationHandler(logger, services),
+		RestaurantHandler:   NewRestaurantHandler(logger, services),
+		TableHandler:        NewTableHandler(logger, services),
+		ReservationHandler:  NewReservationHandler(logger, services),
+		WaitlistHandler:     NewWaitlistHandler(logger, services),
+		ScheduleHandler:     NewScheduleHandler(logger, services),
+		AvailabilityHandler: NewAvailabilityHandler(logger, services),
 	}
 }
 
 func InitService(logger *zap.Logger, repo *Repository, eventRequest *chan model.EventRequest) *Service {
 	return &Service{
-		TableService:       service.NewTableService(repo.TableRepository, logger, eventRequest),
-		ReservationService: service.NewReservationService(repo.ReservationRepository, logger, eventRequest),
+		RestaurantService:   service.NewRestaurantService(repo.RestaurantRepository, logger),
+		TableService:        service.NewTableService(repo.TableRepository, logger, eventRequest),
+		ReservationService:  service.NewReservationService(repo.ReservationRepository, logger, eventRequest),
+		WaitlistService:     service.NewWaitlistService(repo.WaitlistRepository, logger, eventRequest),
+		ScheduleService:     service.NewScheduleService(repo.ScheduleRepository, logger),
+		AvailabilityService: service.NewAvailabilityService(repo.ScheduleRepository, logger, eventRequest),
+		BlockService:        service.NewBlockService(repo.BlockRepository, logger, eventRequest),
 	}
 }
 
+// RegisterRoutes mounts the public and secure API. Table, reservation, waitlist, schedule and availability routes are scoped to a
+// registered restaurant under /restaurants/:restaurantId.
+func RegisterRoutes(e *echo.Echo, middleware *Middleware, handler *Handler) {
+	publicRoute := e.Group("/public")
+	secureRoute := e.Group("/secure")
+	handler.RestaurantHandler.RegisterRoutes(publicRoute, secureRoute)
+
+	publicRestaurantRoute := publicRoute.Group("/restaurants/:restaurantId", middleware.Restaurant)
+	secureRestaurantRoute := secureRoute.Group("/restaurants/:restaurantId", middleware.Restaurant)
+	handler.TableHandler.RegisterRoutes(publicRestaurantRoute, secureRestaurantRoute)
+	handler.ReservationHandler.RegisterRoutes(publicRestaurantRoute, secureRestaurantRoute)
+	handler.WaitlistHandler.RegisterRoutes(secureRestaurantRoute)
+	handler.ScheduleHandler.RegisterRoutes(publicRestaurantRoute, secureRestaurantRoute)
+	handler.AvailabilityHandler.RegisterRoutes(publicRestaurantRoute)
+}
+
 type ServerHttp struct {
 	app *echo.Echo
 }
@@ -78,10 +122,7 @@ func NewHTTPServer(cfg *config.Config, middleware *Middleware, handler *Handler)
 		fmt.Println("Swagger enabled at: http://localhost:8080/swagger/index.html")
 	}
 
-	publicRoute := e.Group("/public")
-	secureRoute := e.Group("/secure")
-	handler.TableHandler.RegisterRoutes(publicRoute)
-	handler.ReservationHandler.RegisterRoutes(publicRoute, secureRoute)
+	RegisterRoutes(e, middleware, handler)
 
 	return &ServerHttp{
 		app: e,
@@ -6,49 +6,337 @@ import (
 	"github.com/bossncn/go-common/http/model"
 	"github.com/bossncn/go-common/http/model/error_code"
 	"github.com/bossncn/restaurant-reservation-service/internal/adapters/dto"
+	coreModel "github.com/bossncn/restaurant-reservation-service/internal/core/model"
 	"github.com/bossncn/restaurant-reservation-service/internal/core/service"
 	"github.com/labstack/echo/v4"
 	"go.uber.org/zap"
+	"time"
 )
 
 type TableHandler struct {
-	logger       *zap.Logger
-	tableService service.TableService
+	logger          *zap.Logger
+	tableService    service.TableService
+	blockService    service.BlockService
+	scheduleService service.ScheduleService
 }
 
 func NewTableHandler(logger *zap.Logger, service *Service) *TableHandler {
 	return &TableHandler{
-		logger:       logger,
-		tableService: service.TableService,
+		logger:          logger,
+		tableService:    service.TableService,
+		blockService:    service.BlockService,
+		scheduleService: service.ScheduleService,
 	}
 }
 
-func (handler *TableHandler) RegisterRoutes(publicRouteGroup *echo.Group) {
+func (handler *TableHandler) RegisterRoutes(publicRouteGroup *echo.Group, secureRouteGroup *echo.Group) {
 	publicTableRouteGroup := publicRouteGroup.Group("/table")
 	publicTableRouteGroup.POST("/init", handler.InitializeTable)
+	publicTableRouteGroup.GET("", handler.GetTables)
+	secureRouteGroup.PUT("/table", handler.ResizeTables)
+	secureRouteGroup.POST("/table/blocks", handler.BlockTables)
+	secureRouteGroup.DELETE("/table/blocks/:blockId", handler.UnblockTables)
 }
 
 // InitializeTable
 // @Summary Initialize tables in the restaurant
-// @Description Initializes the total number of tables in the restaurant. This endpoint must be called first and only once.
+// @Description Initializes the table inventory of the restaurant, either as a list of tables with their seat capacity and the tables they can be pushed together with, or as a row of four-seat tables. This endpoint must be called first and only once.
 // @Tags table
 // @Accept json
 // @Produce json
-// @Param request body dto.InitializeTableRequest true "Initialize Number of table Request"
+// @Param restaurantId path string true "Restaurant ID"
+// @Param request body dto.InitializeTableRequest true "Initialize Tables Request"
 // @Success 200 {object} model.Response{data=dto.InitializeTableResponse} "Total Initialized Tables"
 // @Failure 400 {object} model.Response{} "table Already Initialized"
-// @Router /public/table/init [post]
+// @Router /public/restaurants/{restaurantId}/table/init [post]
 func (handler *TableHandler) InitializeTable(ctx echo.Context) error {
+	restaurantId := ctx.Param("restaurantId")
+
 	var req dto.InitializeTableRequest
 	if err := ctx.Bind(&req); err != nil {
 		handler.logger.Error("Failed to bind request", zap.Error(err))
 		return response.Response(ctx, nil, errors.New(error_code.InvalidRequest))
 	}
 
-	if err := handler.tableService.InitializeTables(req.NumTables); err != nil {
+	tables := tablesFromRequest(req)
+	if err := handler.tableService.InitializeTables(restaurantId, tables); err != nil {
 		handler.logger.Error("Failed to initialize tables", zap.Error(err))
 		return response.Response(ctx, model.CreateError(error_code.InvalidRequest, err.Error()), err)
 	}
 
-	return response.Response(ctx, dto.InitializeTableResponse{TotalTables: req.NumTables}, nil)
+	return response.Response(ctx, inventoryResponse(tables), nil)
+}
+
+// ResizeTables
+// @Summary Resize the table inventory
+// @Description Replaces the table inventory of an initialized restaurant without losing its reservations, in the same format as initialization. Tables can be added, removed or changed; the change is refused and the conflicting reservations listed when an upcoming reservation holds a removed table or its tables would no longer seat the party. Parties on the waitlist are seated at added tables straight away.
+// @Tags table
+// @Accept json
+// @Produce json
+// @Param restaurantId path string true "Restaurant ID"
+// @Param request body dto.InitializeTableRequest true "Resize Tables Request"
+// @Success 200 {object} model.Response{data=dto.InitializeTableResponse} "Total Tables"
+// @Failure 400 {object} model.Response{data=dto.InventoryConflictResponse} "Reservations hold tables that would be removed"
+// @Router /secure/restaurants/{restaurantId}/table [put]
+func (handler *TableHandler) ResizeTables(ctx echo.Context) error {
+	restaurantId := ctx.Param("restaurantId")
+
+	var req dto.InitializeTableRequest
+	if err := ctx.Bind(&req); err != nil {
+		handler.logger.Error("Failed to bind request", zap.Error(err))
+		return response.Response(ctx, nil, errors.New(error_code.InvalidRequest))
+	}
+
+	tables := tablesFromRequest(req)
+	err := handler.tableService.ResizeTables(restaurantId, tables)
+
+	var conflictErr *service.InventoryConflictError
+	if errors.As(err, &conflictErr) {
+		handler.logger.Info("Table inventory change conflicts with reservations", zap.Int("reservations", len(conflictErr.Reservations)))
+		return response.Response(ctx, model.CreateError(error_code.InvalidRequest, inventoryConflictResponse(conflictErr)), err)
+	}
+	if err != nil {
+		handler.logger.Error("Failed to resize tables", zap.Error(err))
+		return response.Response(ctx, model.CreateError(error_code.InvalidRequest, err.Error()), err)
+	}
+
+	return response.Response(ctx, inventoryResponse(tables), nil)
+}
+
+// BlockTables
+// @Summary Block tables
+// @Description Takes tables out of service, e.g. for maintenance or a staff meal. The block starts now unless start_time is given and lasts until it is lifted unless end_time is given. Blocked tables are not offered to new bookings; the block is refused and the conflicting reservations listed when a reservation holds one of the tables while the block is in force.
+// @Tags table
+// @Accept json
+// @Produce json
+// @Param restaurantId path string true "Restaurant ID"
+// @Param request body dto.TableBlockRequest true "Tables to block, when and why."
+// @Success 200 {object} model.Response{data=dto.TableBlockResponse} "Tables blocked"
+// @Failure 400 {object} model.Response{data=dto.InventoryConflictResponse} "Reservations hold the tables while the block is in force"
+// @Router /secure/restaurants/{restaurantId}/table/blocks [post]
+func (handler *TableHandler) BlockTables(ctx echo.Context) error {
+	var req dto.TableBlockRequest
+	if err := ctx.Bind(&req); err != nil {
+		handler.logger.Error("Failed to bind request", zap.Error(err))
+		return response.Response(ctx, nil, errors.New(error_code.InvalidRequest))
+	}
+
+	if fieldErrors := validateRequest(req); fieldErrors != nil {
+		handler.logger.Error("Invalid table block request", zap.Any("fieldErrors", fieldErrors))
+		return response.Response(ctx, model.CreateError(error_code.InvalidRequest, fieldErrors), errors.New(error_code.InvalidRequest))
+	}
+
+	block, err := handler.blockService.BlockTables(ctx.Param("restaurantId"), req.TableIds, req.StartTime, req.EndTime, req.Reason)
+
+	var conflictErr *service.InventoryConflictError
+	if errors.As(err, &conflictErr) {
+		handler.logger.Info("Table block conflicts with reservations", zap.Int("reservations", len(conflictErr.Reservations)))
+		return response.Response(ctx, model.CreateError(error_code.InvalidRequest, inventoryConflictResponse(conflictErr)), err)
+	}
+	if err != nil {
+		handler.logger.Error("Failed to block tables", zap.Error(err))
+		return response.Response(ctx, model.CreateError(error_code.InvalidRequest, err.Error()), err)
+	}
+
+	return response.Response(ctx, tableBlockResponse(block), nil)
+}
+
+// UnblockTables
+// @Summary Unblock tables
+// @Description Lifts a table block so its tables can be booked again. Parties on the waitlist are seated at them straight away.
+// @Tags table
+// @Produce json
+// @Param restaurantId path string true "Restaurant ID"
+// @Param blockId path string true "Block ID"
+// @Success 200 {object} model.Response{data=dto.TableBlockResponse} "Block lifted"
+// @Failure 400 {object} model.Response{} "Block not found"
+// @Router /secure/restaurants/{restaurantId}/table/blocks/{blockId} [delete]
+func (handler *TableHandler) UnblockTables(ctx echo.Context) error {
+	block, err := handler.blockService.UnblockTables(ctx.Param("restaurantId"), ctx.Param("blockId"))
+	if err != nil {
+		handler.logger.Error("Failed to unblock tables", zap.Error(err))
+		return response.Response(ctx, model.CreateError(error_code.InvalidRequest, err.Error()), err)
+	}
+
+	return response.Response(ctx, tableBlockResponse(block), nil)
+}
+
+func tableBlockResponse(block *coreModel.TableBlock) dto.TableBlockResponse {
+	res := dto.TableBlockResponse{
+		Id:        block.Id,
+		TableIds:  block.TableIds,
+		StartTime: block.StartTime,
+		Reason:    block.Reason,
+	}
+	if !block.EndTime.IsZero() {
+		res.EndTime = &block.EndTime
+	}
+	return res
+}
+
+func inventoryConflictResponse(err *service.InventoryConflictError) dto.InventoryConflictResponse {
+	conflict := dto.InventoryConflictResponse{Message: err.Error(), Reservations: make([]dto.ConflictingReservationResponse, 0, len(err.Reservations))}
+	for _, reservation := range err.Reservations {
+		conflict.Reservations = append(conflict.Reservations, dto.ConflictingReservationResponse{
+			BookingId: reservation.Id,
+			PartySize: reservation.PartySize,
+			TableIds:  reservation.TableIds,
+			StartTime: reservation.StartTime,
+			EndTime:   reservation.EndTime(),
+		})
+	}
+	return conflict
+}
+
+// tablesFromRequest returns the listed tables, or a row of uniform tables when none are listed.
+func tablesFromRequest(req dto.InitializeTableRequest) []coreModel.Table {
+	if len(req.Tables) == 0 {
+		return service.UniformTables(req.NumTables)
+	}
+
+	tables := make([]coreModel.Table, 0, len(req.Tables))
+	for _, table := range req.Tables {
+		tables = append(tables, coreModel.Table{
+			Id:             table.Id,
+			Label:          table.Label,
+			Capacity:       table.Capacity,
+			MinPartySize:   table.MinPartySize,
+			CombinableWith: table.CombinableWith,
+			Section:        table.Section,
+			Features:       table.Features,
+		})
+	}
+	return tables
+}
+
+func inventoryResponse(tables []coreModel.Table) dto.InitializeTableResponse {
+	totalSeats := 0
+	for _, table := range tables {
+		totalSeats += table.Capacity
+	}
+	return dto.InitializeTableResponse{TotalTables: len(tables), TotalSeats: totalSeats}
+}
+
+// GetTables
+// @Summary Get table availability
+// @Description Returns every table with whether it is free for the whole time window, the reservations overlapping it and the blocks in force during it. Real capacity (total_tables, available_tables) is shown alongside the overbooked capacity: the overbooking_allowance of the shift covering the window and the overbooked_tables taken by overbooked_reservations, which hold no tables. The window defaults to the next two hours.
+// @Tags table
+// @Produce json
+// @Param restaurantId path string true "Restaurant ID"
+// @Param start_time query string false "Window start (RFC3339)."
+// @Param end_time query string false "Window end (RFC3339)."
+// @Success 200 {object} model.Response{data=dto.TablesResponse} "Table availability"
+// @Failure 400 {object} model.Response{} "Invalid time window"
+// @Router /public/restaurants/{restaurantId}/table [get]
+func (handler *TableHandler) GetTables(ctx echo.Context) error {
+	restaurantId := ctx.Param("restaurantId")
+
+	start, end, err := parseTimeWindow(ctx)
+	if err != nil {
+		handler.logger.Error("Failed to parse time window", zap.Error(err))
+		return response.Response(ctx, model.CreateError(error_code.InvalidRequest, err.Error()), err)
+	}
+
+	reservations := make(map[string]int)
+	overbooked := make(map[string]int)
+	overbookedTables := 0
+	reservedBy := make(map[string]string)
+	for _, reservation := range handler.tableService.ReservationsBetween(restaurantId, start, end) {
+		reservations[reservation.Id] = reservation.NumTables
+		if reservation.Overbooked {
+			overbooked[reservation.Id] = reservation.OverbookedTables
+			overbookedTables += reservation.OverbookedTables
+		}
+		for _, tableId := range reservation.TableIds {
+			reservedBy[tableId] = reservation.Id
+		}
+	}
+
+	blockedBy := make(map[string]coreModel.TableBlock)
+	for _, block := range handler.blockService.BlocksBetween(restaurantId, start, end) {
+		for _, tableId := range block.TableIds {
+			if _, blocked := blockedBy[tableId]; !blocked {
+				blockedBy[tableId] = block
+			}
+		}
+	}
+
+	// Free tables that are blocked are not available either.
+	blockedFree := 0
+	tables := make([]dto.TableStatusResponse, 0)
+	for _, table := range handler.tableService.Tables(restaurantId) {
+		block, blocked := blockedBy[table.Id]
+		if blocked && reservedBy[table.Id] == "" {
+			blockedFree++
+		}
+		tables = append(tables, dto.TableStatusResponse{
+			Id:             table.Id,
+			Label:          table.Label,
+			Capacity:       table.Capacity,
+			MinPartySize:   table.MinPartySize,
+			CombinableWith: table.CombinableWith,
+			Section:        table.Section,
+			Features:       table.Features,
+			Available:      reservedBy[table.Id] == "" && !blocked,
+			ReservationId:  reservedBy[table.Id],
+			Blocked:        blocked,
+			BlockId:        block.Id,
+			BlockReason:    block.Reason,
+		})
+	}
+
+	totalTables := handler.tableService.TotalTables(restaurantId)
+	return response.Response(
+		ctx,
+		dto.TablesResponse{
+			TotalTables:            totalTables,
+			AvailableTables:        handler.tableService.AvailableTables(restaurantId, start, end) - blockedFree,
+			OverbookingAllowance:   handler.overbookingAllowance(restaurantId, start, end, totalTables),
+			OverbookedTables:       overbookedTables,
+			StartTime:              start,
+			EndTime:                end,
+			Tables:                 tables,
+			Reservations:           reservations,
+			OverbookedReservations: overbooked},
+		nil)
+}
+
+// overbookingAllowance returns how many tables the shift covering the window may overbook, or zero
+// when the window is not within a single shift.
+func (handler *TableHandler) overbookingAllowance(restaurantId string, start time.Time, end time.Time, totalTables int) int {
+	schedule, err := handler.scheduleService.FindSchedule(restaurantId)
+	if err != nil {
+		return 0
+	}
+	shift, err := schedule.ShiftFor(start, end)
+	if err != nil || shift == nil {
+		return 0
+	}
+	return shift.OverbookingAllowance(totalTables)
+}
+
+func parseTimeWindow(ctx echo.Context) (time.Time, time.Time, error) {
+	start := time.Now()
+	if value := ctx.QueryParam("start_time"); value != "" {
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return time.Time{}, time.Time{}, errors.New("invalid start_time")
+		}
+		start = parsed
+	}
+
+	end := start.Add(service.DefaultReservationDuration)
+	if value := ctx.QueryParam("end_time"); value != "" {
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return time.Time{}, time.Time{}, errors.New("invalid end_time")
+		}
+		end = parsed
+	}
+
+	if !end.After(start) {
+		return time.Time{}, time.Time{}, errors.New("end_time must be after start_time")
+	}
+
+	return start, end, nil
 }
@@ -8,6 +8,8 @@ import (
 	"github.com/bossncn/go-common/http/model/error_code"
 	"github.com/bossncn/restaurant-reservation-service/internal/adapters/dto"
 	"github.com/bossncn/restaurant-reservation-service/internal/adapters/http"
+	coreModel "github.com/bossncn/restaurant-reservation-service/internal/core/model"
+	"github.com/bossncn/restaurant-reservation-service/internal/core/service"
 	serviceMock "github.com/bossncn/restaurant-reservation-service/internal/core/service/mock"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
@@ -16,6 +18,7 @@ import (
 	netHttp "net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 )
 
 func TestTableHandler(t *testing.T) {
@@ -37,9 +40,11 @@ func TestTableHandler(t *testing.T) {
 			rec := httptest.NewRecorder()
 			e := echo.New()
 			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("restaurantId")
+			ctx.SetParamValues("r1")
 
 			// Mock behavior
-			mockTableService.EXPECT().InitializeTables(reqBody.NumTables).Return(nil).Times(1)
+			mockTableService.EXPECT().InitializeTables("r1", service.UniformTables(reqBody.NumTables)).Return(nil).Times(1)
 
 			// Execute handler
 			err := handler.InitializeTable(ctx)
@@ -52,6 +57,49 @@ func TestTableHandler(t *testing.T) {
 			err = json.Unmarshal(rec.Body.Bytes(), &res)
 			assert.NoError(t, err)
 			assert.Equal(t, float64(reqBody.NumTables), res.Data.(map[string]interface{})["total_tables"])
+			assert.Equal(t, float64(40), res.Data.(map[string]interface{})["total_seats"])
+		})
+		t.Run("SuccessWithTableList", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock dependencies
+			mockTableService := serviceMock.NewMockTableService(ctrl)
+			logger := zap.NewNop()
+			handler := http.NewTableHandler(logger, &http.Service{TableService: mockTableService})
+
+			// Set up Echo mock context
+			reqBody := dto.InitializeTableRequest{Tables: []dto.TableRequest{
+				{Id: "T1", Label: "Window", Capacity: 2},
+				{Id: "T2", Label: "Booth", Capacity: 6, MinPartySize: 3},
+			}}
+			reqJSON, _ := json.Marshal(reqBody)
+			req := httptest.NewRequest(netHttp.MethodPost, "/table/init", bytes.NewReader(reqJSON))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			e := echo.New()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("restaurantId")
+			ctx.SetParamValues("r1")
+
+			// Mock behavior
+			mockTableService.EXPECT().InitializeTables("r1", []coreModel.Table{
+				{Id: "T1", Label: "Window", Capacity: 2},
+				{Id: "T2", Label: "Booth", Capacity: 6, MinPartySize: 3},
+			}).Return(nil).Times(1)
+
+			// Execute handler
+			err := handler.InitializeTable(ctx)
+
+			// Assertions
+			assert.NoError(t, err)
+			assert.Equal(t, netHttp.StatusOK, rec.Code)
+
+			var res model.Response
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, float64(2), res.Data.(map[string]interface{})["total_tables"])
+			assert.Equal(t, float64(8), res.Data.(map[string]interface{})["total_seats"])
 		})
 		t.Run("BindError", func(t *testing.T) {
 			ctrl := gomock.NewController(t)
@@ -68,6 +116,8 @@ func TestTableHandler(t *testing.T) {
 			rec := httptest.NewRecorder()
 			e := echo.New()
 			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("restaurantId")
+			ctx.SetParamValues("r1")
 
 			// Execute handler
 			err := handler.InitializeTable(ctx)
@@ -98,9 +148,11 @@ func TestTableHandler(t *testing.T) {
 			rec := httptest.NewRecorder()
 			e := echo.New()
 			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("restaurantId")
+			ctx.SetParamValues("r1")
 
 			// Mock behavior
-			mockTableService.EXPECT().InitializeTables(reqBody.NumTables).Return(errors.New("table already initialized")).Times(1)
+			mockTableService.EXPECT().InitializeTables("r1", service.UniformTables(reqBody.NumTables)).Return(errors.New("table already initialized")).Times(1)
 
 			// Execute handler
 			err := handler.InitializeTable(ctx)
@@ -116,4 +168,486 @@ func TestTableHandler(t *testing.T) {
 			assert.Equal(t, "table already initialized", res.Data)
 		})
 	})
+	t.Run("ResizeTables", func(t *testing.T) {
+		t.Run("Success", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock dependencies
+			mockTableService := serviceMock.NewMockTableService(ctrl)
+			logger := zap.NewNop()
+			handler := http.NewTableHandler(logger, &http.Service{TableService: mockTableService})
+
+			// Set up Echo mock context
+			reqBody := dto.InitializeTableRequest{NumTables: 12}
+			reqJSON, _ := json.Marshal(reqBody)
+			req := httptest.NewRequest(netHttp.MethodPut, "/table", bytes.NewReader(reqJSON))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			e := echo.New()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("restaurantId")
+			ctx.SetParamValues("r1")
+
+			// Mock behavior
+			mockTableService.EXPECT().ResizeTables("r1", service.UniformTables(reqBody.NumTables)).Return(nil).Times(1)
+
+			// Execute handler
+			err := handler.ResizeTables(ctx)
+
+			// Assertions
+			assert.NoError(t, err)
+			assert.Equal(t, netHttp.StatusOK, rec.Code)
+
+			var res model.Response
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, float64(12), res.Data.(map[string]interface{})["total_tables"])
+			assert.Equal(t, float64(48), res.Data.(map[string]interface{})["total_seats"])
+		})
+		t.Run("Conflict", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock dependencies
+			mockTableService := serviceMock.NewMockTableService(ctrl)
+			logger := zap.NewNop()
+			handler := http.NewTableHandler(logger, &http.Service{TableService: mockTableService})
+
+			// Set up Echo mock context
+			reqBody := dto.InitializeTableRequest{NumTables: 1}
+			reqJSON, _ := json.Marshal(reqBody)
+			req := httptest.NewRequest(netHttp.MethodPut, "/table", bytes.NewReader(reqJSON))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			e := echo.New()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("restaurantId")
+			ctx.SetParamValues("r1")
+
+			// Mock behavior
+			start := time.Date(2025, 1, 10, 19, 0, 0, 0, time.UTC)
+			conflict := coreModel.Reservation{Id: "res-1", PartySize: 4, TableIds: []string{"T2"}, StartTime: start, Duration: 2 * time.Hour}
+			mockTableService.EXPECT().ResizeTables("r1", service.UniformTables(1)).Return(&service.InventoryConflictError{Reservations: []coreModel.Reservation{conflict}}).Times(1)
+
+			// Execute handler
+			err := handler.ResizeTables(ctx)
+
+			// Assertions
+			assert.NoError(t, err)
+			assert.Equal(t, netHttp.StatusBadRequest, rec.Code)
+
+			var res struct {
+				Code string                        `json:"code"`
+				Data dto.InventoryConflictResponse `json:"data"`
+			}
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, error_code.InvalidRequest, res.Code)
+			assert.Equal(t, dto.InventoryConflictResponse{
+				Message:      "tables are still reserved by res-1",
+				Reservations: []dto.ConflictingReservationResponse{{BookingId: "res-1", PartySize: 4, TableIds: []string{"T2"}, StartTime: start, EndTime: start.Add(2 * time.Hour)}},
+			}, res.Data)
+		})
+		t.Run("ServiceError", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock dependencies
+			mockTableService := serviceMock.NewMockTableService(ctrl)
+			logger := zap.NewNop()
+			handler := http.NewTableHandler(logger, &http.Service{TableService: mockTableService})
+
+			// Set up Echo mock context
+			reqBody := dto.InitializeTableRequest{NumTables: 2}
+			reqJSON, _ := json.Marshal(reqBody)
+			req := httptest.NewRequest(netHttp.MethodPut, "/table", bytes.NewReader(reqJSON))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			e := echo.New()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("restaurantId")
+			ctx.SetParamValues("r1")
+
+			// Mock behavior
+			mockTableService.EXPECT().ResizeTables("r1", service.UniformTables(2)).Return(errors.New("tables has not been initialized")).Times(1)
+
+			// Execute handler
+			err := handler.ResizeTables(ctx)
+
+			// Assertions
+			assert.NoError(t, err)
+			assert.Equal(t, netHttp.StatusBadRequest, rec.Code)
+
+			var res model.Response
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, "tables has not been initialized", res.Data)
+		})
+	})
+	t.Run("BlockTables", func(t *testing.T) {
+		t.Run("Success", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock dependencies
+			mockBlockService := serviceMock.NewMockBlockService(ctrl)
+			logger := zap.NewNop()
+			handler := http.NewTableHandler(logger, &http.Service{BlockService: mockBlockService})
+
+			// Set up Echo mock context
+			start := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+			reqJSON := `{"table_ids": ["T1", "T2"], "start_time": "2025-01-10T12:00:00Z", "reason": "staff meal"}`
+			req := httptest.NewRequest(netHttp.MethodPost, "/table/blocks", bytes.NewReader([]byte(reqJSON)))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			e := echo.New()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("restaurantId")
+			ctx.SetParamValues("r1")
+
+			// Mock behavior
+			mockBlockService.EXPECT().BlockTables("r1", []string{"T1", "T2"}, start, time.Time{}, "staff meal").Return(&coreModel.TableBlock{Id: "blk-1", RestaurantId: "r1", TableIds: []string{"T1", "T2"}, StartTime: start, Reason: "staff meal"}, nil).Times(1)
+
+			// Execute handler
+			err := handler.BlockTables(ctx)
+
+			// Assertions
+			assert.NoError(t, err)
+			assert.Equal(t, netHttp.StatusOK, rec.Code)
+
+			var res struct {
+				Data dto.TableBlockResponse `json:"data"`
+			}
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, dto.TableBlockResponse{Id: "blk-1", TableIds: []string{"T1", "T2"}, StartTime: start, Reason: "staff meal"}, res.Data)
+		})
+		t.Run("ValidationError", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock dependencies
+			mockBlockService := serviceMock.NewMockBlockService(ctrl)
+			logger := zap.NewNop()
+			handler := http.NewTableHandler(logger, &http.Service{BlockService: mockBlockService})
+
+			// Set up Echo mock context
+			req := httptest.NewRequest(netHttp.MethodPost, "/table/blocks", bytes.NewReader([]byte(`{"reason": "broken chair"}`)))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			e := echo.New()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("restaurantId")
+			ctx.SetParamValues("r1")
+
+			// Execute handler
+			err := handler.BlockTables(ctx)
+
+			// Assertions
+			assert.NoError(t, err)
+			assert.Equal(t, netHttp.StatusBadRequest, rec.Code)
+
+			var res struct {
+				Data []dto.FieldErrorResponse `json:"data"`
+			}
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Len(t, res.Data, 1)
+			assert.Equal(t, "table_ids", res.Data[0].Field)
+		})
+		t.Run("Conflict", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock dependencies
+			mockBlockService := serviceMock.NewMockBlockService(ctrl)
+			logger := zap.NewNop()
+			handler := http.NewTableHandler(logger, &http.Service{BlockService: mockBlockService})
+
+			// Set up Echo mock context
+			req := httptest.NewRequest(netHttp.MethodPost, "/table/blocks", bytes.NewReader([]byte(`{"table_ids": ["T1"]}`)))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			e := echo.New()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("restaurantId")
+			ctx.SetParamValues("r1")
+
+			// Mock behavior
+			start := time.Date(2025, 1, 10, 19, 0, 0, 0, time.UTC)
+			conflict := coreModel.Reservation{Id: "res-1", PartySize: 2, TableIds: []string{"T1"}, StartTime: start, Duration: 2 * time.Hour}
+			mockBlockService.EXPECT().BlockTables("r1", []string{"T1"}, time.Time{}, time.Time{}, "").Return(nil, &service.InventoryConflictError{Reservations: []coreModel.Reservation{conflict}}).Times(1)
+
+			// Execute handler
+			err := handler.BlockTables(ctx)
+
+			// Assertions
+			assert.NoError(t, err)
+			assert.Equal(t, netHttp.StatusBadRequest, rec.Code)
+
+			var res struct {
+				Data dto.InventoryConflictResponse `json:"data"`
+			}
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, "tables are still reserved by res-1", res.Data.Message)
+			assert.Equal(t, "res-1", res.Data.Reservations[0].BookingId)
+		})
+	})
+	t.Run("UnblockTables", func(t *testing.T) {
+		t.Run("Success", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock dependencies
+			mockBlockService := serviceMock.NewMockBlockService(ctrl)
+			logger := zap.NewNop()
+			handler := http.NewTableHandler(logger, &http.Service{BlockService: mockBlockService})
+
+			// Set up Echo mock context
+			req := httptest.NewRequest(netHttp.MethodDelete, "/table/blocks/blk-1", nil)
+			rec := httptest.NewRecorder()
+			e := echo.New()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("restaurantId", "blockId")
+			ctx.SetParamValues("r1", "blk-1")
+
+			// Mock behavior
+			start := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+			mockBlockService.EXPECT().UnblockTables("r1", "blk-1").Return(&coreModel.TableBlock{Id: "blk-1", TableIds: []string{"T1"}, StartTime: start, EndTime: start.Add(time.Hour)}, nil).Times(1)
+
+			// Execute handler
+			err := handler.UnblockTables(ctx)
+
+			// Assertions
+			assert.NoError(t, err)
+			assert.Equal(t, netHttp.StatusOK, rec.Code)
+
+			var res struct {
+				Data dto.TableBlockResponse `json:"data"`
+			}
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, "blk-1", res.Data.Id)
+			assert.Equal(t, start.Add(time.Hour), *res.Data.EndTime)
+		})
+		t.Run("NotFound", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock dependencies
+			mockBlockService := serviceMock.NewMockBlockService(ctrl)
+			logger := zap.NewNop()
+			handler := http.NewTableHandler(logger, &http.Service{BlockService: mockBlockService})
+
+			// Set up Echo mock context
+			req := httptest.NewRequest(netHttp.MethodDelete, "/table/blocks/blk-9", nil)
+			rec := httptest.NewRecorder()
+			e := echo.New()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("restaurantId", "blockId")
+			ctx.SetParamValues("r1", "blk-9")
+
+			// Mock behavior
+			mockBlockService.EXPECT().UnblockTables("r1", "blk-9").Return(nil, errors.New("table block not found")).Times(1)
+
+			// Execute handler
+			err := handler.UnblockTables(ctx)
+
+			// Assertions
+			assert.NoError(t, err)
+			assert.Equal(t, netHttp.StatusBadRequest, rec.Code)
+
+			var res model.Response
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, "table block not found", res.Data)
+		})
+	})
+	t.Run("GetTables", func(t *testing.T) {
+		t.Run("Success", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock dependencies
+			mockTableService := serviceMock.NewMockTableService(ctrl)
+			mockBlockService := serviceMock.NewMockBlockService(ctrl)
+			mockScheduleService := serviceMock.NewMockScheduleService(ctrl)
+			logger := zap.NewNop()
+			handler := http.NewTableHandler(logger, &http.Service{TableService: mockTableService, BlockService: mockBlockService, ScheduleService: mockScheduleService})
+
+			start := time.Date(2025, 1, 10, 19, 0, 0, 0, time.UTC)
+			end := start.Add(2 * time.Hour)
+
+			// Set up Echo mock context
+			req := httptest.NewRequest(netHttp.MethodGet, "/table?start_time=2025-01-10T19:00:00Z&end_time=2025-01-10T21:00:00Z", nil)
+			rec := httptest.NewRecorder()
+			e := echo.New()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("restaurantId")
+			ctx.SetParamValues("r1")
+
+			// Mock behavior
+			mockTableService.EXPECT().TotalTables("r1").Return(10).Times(1)
+			mockTableService.EXPECT().AvailableTables("r1", start, end).Return(7).Times(1)
+			mockTableService.EXPECT().ReservationsBetween("r1", start, end).Return([]coreModel.Reservation{{Id: "res-1", NumTables: 1, TableIds: []string{"T2"}, StartTime: start, Duration: time.Hour}}).Times(1)
+			mockTableService.EXPECT().Tables("r1").Return([]coreModel.Table{{Id: "T1", Capacity: 2}, {Id: "T2", Capacity: 4}}).Times(1)
+			mockBlockService.EXPECT().BlocksBetween("r1", start, end).Return(nil).Times(1)
+			mockScheduleService.EXPECT().FindSchedule("r1").Return(nil, errors.New("schedule not found")).Times(1)
+
+			// Execute handler
+			err := handler.GetTables(ctx)
+
+			// Assertions
+			assert.NoError(t, err)
+			assert.Equal(t, netHttp.StatusOK, rec.Code)
+
+			var res model.Response
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, float64(10), res.Data.(map[string]interface{})["total_tables"])
+			assert.Equal(t, float64(7), res.Data.(map[string]interface{})["available_tables"])
+			assert.Equal(t, float64(1), res.Data.(map[string]interface{})["reservations"].(map[string]interface{})["res-1"])
+
+			tables := res.Data.(map[string]interface{})["tables"].([]interface{})
+			assert.Len(t, tables, 2)
+			assert.Equal(t, true, tables[0].(map[string]interface{})["available"])
+			assert.Equal(t, false, tables[1].(map[string]interface{})["available"])
+			assert.Equal(t, "res-1", tables[1].(map[string]interface{})["reservation_id"])
+		})
+		t.Run("BlockedTables", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock dependencies
+			mockTableService := serviceMock.NewMockTableService(ctrl)
+			mockBlockService := serviceMock.NewMockBlockService(ctrl)
+			mockScheduleService := serviceMock.NewMockScheduleService(ctrl)
+			logger := zap.NewNop()
+			handler := http.NewTableHandler(logger, &http.Service{TableService: mockTableService, BlockService: mockBlockService, ScheduleService: mockScheduleService})
+
+			start := time.Date(2025, 1, 10, 19, 0, 0, 0, time.UTC)
+			end := start.Add(2 * time.Hour)
+
+			// Set up Echo mock context
+			req := httptest.NewRequest(netHttp.MethodGet, "/table?start_time=2025-01-10T19:00:00Z&end_time=2025-01-10T21:00:00Z", nil)
+			rec := httptest.NewRecorder()
+			e := echo.New()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("restaurantId")
+			ctx.SetParamValues("r1")
+
+			// Mock behavior
+			mockTableService.EXPECT().TotalTables("r1").Return(3).Times(1)
+			mockTableService.EXPECT().AvailableTables("r1", start, end).Return(2).Times(1)
+			mockTableService.EXPECT().ReservationsBetween("r1", start, end).Return([]coreModel.Reservation{{Id: "res-1", NumTables: 1, TableIds: []string{"T3"}, StartTime: start, Duration: time.Hour}}).Times(1)
+			mockTableService.EXPECT().Tables("r1").Return([]coreModel.Table{{Id: "T1", Capacity: 2}, {Id: "T2", Capacity: 4}, {Id: "T3", Capacity: 4}}).Times(1)
+			mockBlockService.EXPECT().BlocksBetween("r1", start, end).Return([]coreModel.TableBlock{{Id: "blk-1", TableIds: []string{"T2"}, StartTime: start, Reason: "broken chair"}}).Times(1)
+			mockScheduleService.EXPECT().FindSchedule("r1").Return(nil, errors.New("schedule not found")).Times(1)
+
+			// Execute handler
+			err := handler.GetTables(ctx)
+
+			// Assertions
+			assert.NoError(t, err)
+			assert.Equal(t, netHttp.StatusOK, rec.Code)
+
+			var res struct {
+				Data dto.TablesResponse `json:"data"`
+			}
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, 1, res.Data.AvailableTables)
+			assert.True(t, res.Data.Tables[0].Available)
+			assert.Equal(t, dto.TableStatusResponse{Id: "T2", Capacity: 4, Blocked: true, BlockId: "blk-1", BlockReason: "broken chair"}, res.Data.Tables[1])
+			assert.False(t, res.Data.Tables[2].Available)
+		})
+		t.Run("Overbooked", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock dependencies
+			mockTableService := serviceMock.NewMockTableService(ctrl)
+			mockBlockService := serviceMock.NewMockBlockService(ctrl)
+			mockScheduleService := serviceMock.NewMockScheduleService(ctrl)
+			logger := zap.NewNop()
+			handler := http.NewTableHandler(logger, &http.Service{TableService: mockTableService, BlockService: mockBlockService, ScheduleService: mockScheduleService})
+
+			start := time.Date(2025, 1, 10, 19, 0, 0, 0, time.UTC)
+			end := start.Add(2 * time.Hour)
+			schedule := &coreModel.Schedule{RestaurantId: "r1", Shifts: []coreModel.Shift{
+				{Name: "Friday dinner", Weekday: time.Friday, Opens: 17 * time.Hour, Closes: 23 * time.Hour, Overbooking: coreModel.Overbooking{Percent: 50}},
+			}}
+
+			// Set up Echo mock context
+			req := httptest.NewRequest(netHttp.MethodGet, "/table?start_time=2025-01-10T19:00:00Z&end_time=2025-01-10T21:00:00Z", nil)
+			rec := httptest.NewRecorder()
+			e := echo.New()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("restaurantId")
+			ctx.SetParamValues("r1")
+
+			// Mock behavior: both tables are booked and a third party is overbooked.
+			mockTableService.EXPECT().TotalTables("r1").Return(2).Times(1)
+			mockTableService.EXPECT().AvailableTables("r1", start, end).Return(0).Times(1)
+			mockTableService.EXPECT().ReservationsBetween("r1", start, end).Return([]coreModel.Reservation{
+				{Id: "res-1", NumTables: 1, TableIds: []string{"T1"}, StartTime: start, Duration: time.Hour},
+				{Id: "res-2", NumTables: 1, TableIds: []string{"T2"}, StartTime: start, Duration: time.Hour},
+				{Id: "res-3", StartTime: start, Duration: time.Hour, Overbooked: true, OverbookedTables: 1},
+			}).Times(1)
+			mockTableService.EXPECT().Tables("r1").Return([]coreModel.Table{{Id: "T1", Capacity: 4}, {Id: "T2", Capacity: 4}}).Times(1)
+			mockBlockService.EXPECT().BlocksBetween("r1", start, end).Return(nil).Times(1)
+			mockScheduleService.EXPECT().FindSchedule("r1").Return(schedule, nil).Times(1)
+
+			// Execute handler
+			err := handler.GetTables(ctx)
+
+			// Assertions
+			assert.NoError(t, err)
+			assert.Equal(t, netHttp.StatusOK, rec.Code)
+
+			var res struct {
+				Data dto.TablesResponse `json:"data"`
+			}
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, 2, res.Data.TotalTables)
+			assert.Equal(t, 0, res.Data.AvailableTables)
+			assert.Equal(t, 1, res.Data.OverbookingAllowance)
+			assert.Equal(t, 1, res.Data.OverbookedTables)
+			assert.Equal(t, map[string]int{"res-3": 1}, res.Data.OverbookedReservations)
+			assert.Equal(t, 0, res.Data.Reservations["res-3"])
+		})
+		t.Run("InvalidWindow", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock dependencies
+			mockTableService := serviceMock.NewMockTableService(ctrl)
+			logger := zap.NewNop()
+			handler := http.NewTableHandler(logger, &http.Service{TableService: mockTableService})
+
+			// Set up Echo mock context
+			req := httptest.NewRequest(netHttp.MethodGet, "/table?start_time=2025-01-10T21:00:00Z&end_time=2025-01-10T19:00:00Z", nil)
+			rec := httptest.NewRecorder()
+			e := echo.New()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("restaurantId")
+			ctx.SetParamValues("r1")
+
+			// Execute handler
+			err := handler.GetTables(ctx)
+
+			// Assertions
+			assert.NoError(t, err)
+			assert.Equal(t, netHttp.StatusBadRequest, rec.Code)
+
+			var res model.Response
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, error_code.InvalidRequest, res.Code)
+			assert.Equal(t, "end_time must be after start_time", res.Data)
+		})
+	})
 }
@@ -0,0 +1,82 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"github.com/bossncn/restaurant-reservation-service/internal/adapters/dto"
+	"github.com/go-playground/validator/v10"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+var validate = newValidator()
+
+// timeOfDayPattern matches HH:MM times up to 47:59, so a shift can close after midnight.
+var timeOfDayPattern = regexp.MustCompile(`^([0-3][0-9]|4[0-7]):[0-5][0-9]$`)
+
+func newValidator() *validator.Validate {
+	v := validator.New()
+	// Report fields by their JSON names so clients can map errors back to their inputs.
+	v.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name := strings.SplitN(field.Tag.Get("json"), ",", 2)[0]
+		if name == "-" {
+			return ""
+		}
+		return name
+	})
+	_ = v.RegisterValidation("timeofday", func(fl validator.FieldLevel) bool {
+		return timeOfDayPattern.MatchString(fl.Field().String())
+	})
+	return v
+}
+
+// validateRequest checks req against its validate tags and returns one error per invalid field,
+// or nil when the request is valid.
+func validateRequest(req interface{}) []dto.FieldErrorResponse {
+	err := validate.Struct(req)
+	if err == nil {
+		return nil
+	}
+
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return []dto.FieldErrorResponse{{Message: err.Error()}}
+	}
+
+	fieldErrors := make([]dto.FieldErrorResponse, 0, len(validationErrors))
+	for _, fieldError := range validationErrors {
+		// Drop the struct name so nested fields read as guest.phone.
+		field := fieldError.Namespace()
+		if i := strings.Index(field, "."); i >= 0 {
+			field = field[i+1:]
+		}
+		fieldErrors = append(fieldErrors, dto.FieldErrorResponse{Field: field, Message: fieldErrorMessage(fieldError)})
+	}
+	return fieldErrors
+}
+
+func fieldErrorMessage(fieldError validator.FieldError) string {
+	switch fieldError.Tag() {
+	case "required":
+		return "is required"
+	case "e164":
+		return "must be a phone number in international format, e.g. +66812345678"
+	case "email":
+		return "must be a valid email address"
+	case "max":
+		return fmt.Sprintf("must be at most %s characters", fieldError.Param())
+	case "min", "gte":
+		return fmt.Sprintf("must be at least %s", fieldError.Param())
+	case "gt":
+		return fmt.Sprintf("must be greater than %s", fieldError.Param())
+	case "oneof":
+		return fmt.Sprintf("must be one of %s", fieldError.Param())
+	case "datetime":
+		return fmt.Sprintf("must be formatted as %s", fieldError.Param())
+	case "timeofday":
+		return "must be formatted as HH:MM"
+	default:
+		return fmt.Sprintf("failed %s validation", fieldError.Tag())
+	}
+}
@@ -0,0 +1,136 @@
+package http
+
+import (
+	"errors"
+	"github.com/bossncn/go-common/http/echo/response"
+	"github.com/bossncn/go-common/http/model"
+	"github.com/bossncn/go-common/http/model/error_code"
+	"github.com/bossncn/restaurant-reservation-service/internal/adapters/dto"
+	coreModel "github.com/bossncn/restaurant-reservation-service/internal/core/model"
+	"github.com/bossncn/restaurant-reservation-service/internal/core/service"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+	"time"
+)
+
+type WaitlistHandler struct {
+	logger          *zap.Logger
+	waitlistService service.WaitlistService
+}
+
+func NewWaitlistHandler(logger *zap.Logger, service *Service) *WaitlistHandler {
+	return &WaitlistHandler{
+		logger:          logger,
+		waitlistService: service.WaitlistService,
+	}
+}
+
+func (handler *WaitlistHandler) RegisterRoutes(secureRoute *echo.Group) {
+	secureWaitlistGroup := secureRoute.Group("/waitlist")
+	secureWaitlistGroup.POST("", handler.JoinWaitlist)
+	secureWaitlistGroup.GET("/:id", handler.GetWaitlistPosition)
+	secureWaitlistGroup.DELETE("/:id", handler.LeaveWaitlist)
+}
+
+// JoinWaitlist
+// @Summary Join the waitlist
+// @Description Queues a party for a time slot that has no tables left. The party is booked automatically, in the order it joined, once tables are released. A party that needs a deposit is booked pending_payment. Parties still waiting when their time slot starts expire, and time slots that have already started cannot be joined. A time slot while the restaurant is closed fails with code ERR01004.
+// @Tags Waitlist
+// @Accept json
+// @Produce json
+// @Param restaurantId path string true "Restaurant ID"
+// @Param request body dto.ReservationRequest true "Number of customers in the group, who the booking is for and the requested time slot."
+// @Success 200 {object} model.Response{data=dto.WaitlistEntryResponse} "Party added to the waitlist."
+// @Failure 400 {object} model.Response{data=[]dto.FieldErrorResponse} "Invalid guest details or waitlist error."
+// @Router /secure/restaurants/{restaurantId}/waitlist [post]
+func (handler *WaitlistHandler) JoinWaitlist(ctx echo.Context) error {
+	restaurantId := ctx.Param("restaurantId")
+
+	var req dto.ReservationRequest
+	if err := ctx.Bind(&req); err != nil {
+		handler.logger.Error("Failed to bind request", zap.Error(err))
+		return response.Response(ctx, nil, errors.New(error_code.InvalidRequest))
+	}
+
+	if fieldErrors := validateRequest(req); fieldErrors != nil {
+		handler.logger.Error("Invalid waitlist request", zap.Any("fieldErrors", fieldErrors))
+		return response.Response(ctx, model.CreateError(error_code.InvalidRequest, fieldErrors), errors.New(error_code.InvalidRequest))
+	}
+
+	duration := time.Duration(req.DurationMinutes) * time.Minute
+	guest := coreModel.Guest{Name: req.Guest.Name, Phone: req.Guest.Phone, Email: req.Guest.Email, Notes: req.Guest.Notes}
+	entry, err := handler.waitlistService.JoinWaitlist(restaurantId, req.NumCustomers, guest, req.StartTime, duration, seating(req.Requirements), seating(req.Preferences))
+
+	if err != nil {
+		handler.logger.Error("Failed to join waitlist", zap.Error(err))
+		return response.Response(ctx, model.CreateError(errorCode(err), err.Error()), err)
+	}
+
+	_, position, err := handler.waitlistService.WaitlistPosition(restaurantId, entry.Id)
+	if err != nil {
+		handler.logger.Error("Failed to find waitlist position", zap.Error(err))
+		return response.Response(ctx, model.CreateError(error_code.InvalidRequest, err.Error()), err)
+	}
+
+	return response.Response(ctx, waitlistEntryResponse(entry, position), nil)
+}
+
+// GetWaitlistPosition
+// @Summary Get a waitlist entry
+// @Description Returns the party's place in the queue, the reservation it was promoted to, or expired when its time slot started before tables came free.
+// @Tags Waitlist
+// @Produce json
+// @Param restaurantId path string true "Restaurant ID"
+// @Param id path string true "The waitlist entry ID."
+// @Success 200 {object} model.Response{data=dto.WaitlistEntryResponse} "Waitlist entry found."
+// @Failure 400 {object} model.Response{} "Waitlist entry not found."
+// @Router /secure/restaurants/{restaurantId}/waitlist/{id} [get]
+func (handler *WaitlistHandler) GetWaitlistPosition(ctx echo.Context) error {
+	entry, position, err := handler.waitlistService.WaitlistPosition(ctx.Param("restaurantId"), ctx.Param("id"))
+
+	if err != nil {
+		handler.logger.Error("Failed to find waitlist entry", zap.Error(err))
+		return response.Response(ctx, model.CreateError(error_code.InvalidRequest, err.Error()), err)
+	}
+
+	return response.Response(ctx, waitlistEntryResponse(entry, position), nil)
+}
+
+// LeaveWaitlist
+// @Summary Leave the waitlist
+// @Description Removes a waiting party from the queue.
+// @Tags Waitlist
+// @Produce json
+// @Param restaurantId path string true "Restaurant ID"
+// @Param id path string true "The waitlist entry ID."
+// @Success 200 {object} model.Response{data=dto.WaitlistEntryResponse} "Party removed from the waitlist."
+// @Failure 400 {object} model.Response{} "Waitlist error."
+// @Router /secure/restaurants/{restaurantId}/waitlist/{id} [delete]
+func (handler *WaitlistHandler) LeaveWaitlist(ctx echo.Context) error {
+	entry, err := handler.waitlistService.LeaveWaitlist(ctx.Param("restaurantId"), ctx.Param("id"))
+
+	if err != nil {
+		handler.logger.Error("Failed to leave waitlist", zap.Error(err))
+		return response.Response(ctx, model.CreateError(error_code.InvalidRequest, err.Error()), err)
+	}
+
+	return response.Response(ctx, waitlistEntryResponse(entry, 0), nil)
+}
+
+func waitlistEntryResponse(entry *coreModel.WaitlistEntry, position int) dto.WaitlistEntryResponse {
+	return dto.WaitlistEntryResponse{
+		WaitlistId: entry.Id,
+		Status:     string(entry.Status),
+		Position:   position,
+		PartySize:  entry.PartySize,
+		Guest: dto.GuestResponse{
+			Name:  entry.Guest.Name,
+			Phone: entry.Guest.Phone,
+			Email: entry.Guest.Email,
+			Notes: entry.Guest.Notes,
+		},
+		StartTime:     entry.StartTime,
+		EndTime:       entry.EndTime(),
+		ReservationId: entry.ReservationId,
+	}
+}
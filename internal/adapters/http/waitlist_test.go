@@ -0,0 +1,204 @@
+package http_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"github.com/bossncn/go-common/http/model"
+	"github.com/bossncn/go-common/http/model/error_code"
+	"github.com/bossncn/restaurant-reservation-service/internal/adapters/dto"
+	"github.com/bossncn/restaurant-reservation-service/internal/adapters/http"
+	coreModel "github.com/bossncn/restaurant-reservation-service/internal/core/model"
+	serviceMock "github.com/bossncn/restaurant-reservation-service/internal/core/service/mock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	netHttp "net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestWaitlistHandler(t *testing.T) {
+	startTime := time.Date(2025, 1, 10, 19, 0, 0, 0, time.UTC)
+	guest := coreModel.Guest{Name: "Alex Tan", Phone: "+66812345678"}
+
+	t.Run("JoinWaitlist", func(t *testing.T) {
+		t.Run("Success", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock dependencies
+			mockWaitlistService := serviceMock.NewMockWaitlistService(ctrl)
+			logger := zap.NewNop()
+			handler := http.NewWaitlistHandler(logger, &http.Service{WaitlistService: mockWaitlistService})
+
+			// Set up Echo mock context
+			reqBody := dto.ReservationRequest{NumCustomers: 4, Guest: dto.GuestRequest{Name: guest.Name, Phone: guest.Phone}, StartTime: startTime}
+			reqJSON, _ := json.Marshal(reqBody)
+			req := httptest.NewRequest(netHttp.MethodPost, "/waitlist", bytes.NewReader(reqJSON))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			e := echo.New()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("restaurantId")
+			ctx.SetParamValues("r1")
+
+			// Mock behavior
+			entry := &coreModel.WaitlistEntry{Id: "w-1", RestaurantId: "r1", PartySize: 4, Guest: guest, StartTime: startTime, Duration: 2 * time.Hour, Status: coreModel.WaitlistWaiting}
+			mockWaitlistService.EXPECT().JoinWaitlist("r1", 4, guest, startTime, time.Duration(0), coreModel.Seating{}, coreModel.Seating{}).Return(entry, nil).Times(1)
+			mockWaitlistService.EXPECT().WaitlistPosition("r1", "w-1").Return(entry, 3, nil).Times(1)
+
+			// Execute handler
+			err := handler.JoinWaitlist(ctx)
+
+			// Assertions
+			assert.NoError(t, err)
+			assert.Equal(t, netHttp.StatusOK, rec.Code)
+
+			var res model.Response
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, "w-1", res.Data.(map[string]interface{})["waitlist_id"])
+			assert.Equal(t, "waiting", res.Data.(map[string]interface{})["status"])
+			assert.Equal(t, float64(3), res.Data.(map[string]interface{})["position"])
+			assert.Equal(t, "2025-01-10T21:00:00Z", res.Data.(map[string]interface{})["end_time"])
+		})
+		t.Run("InvalidGuest", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock dependencies
+			mockWaitlistService := serviceMock.NewMockWaitlistService(ctrl)
+			logger := zap.NewNop()
+			handler := http.NewWaitlistHandler(logger, &http.Service{WaitlistService: mockWaitlistService})
+
+			// Set up Echo mock context
+			reqBody := dto.ReservationRequest{NumCustomers: 4, Guest: dto.GuestRequest{Name: guest.Name}, StartTime: startTime}
+			reqJSON, _ := json.Marshal(reqBody)
+			req := httptest.NewRequest(netHttp.MethodPost, "/waitlist", bytes.NewReader(reqJSON))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			e := echo.New()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("restaurantId")
+			ctx.SetParamValues("r1")
+
+			// Execute handler
+			err := handler.JoinWaitlist(ctx)
+
+			// Assertions
+			assert.NoError(t, err)
+			assert.Equal(t, netHttp.StatusBadRequest, rec.Code)
+
+			var res model.Response
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, error_code.InvalidRequest, res.Code)
+			assert.Equal(t, []interface{}{map[string]interface{}{"field": "guest.phone", "message": "is required"}}, res.Data)
+		})
+		t.Run("ServiceError", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock dependencies
+			mockWaitlistService := serviceMock.NewMockWaitlistService(ctrl)
+			logger := zap.NewNop()
+			handler := http.NewWaitlistHandler(logger, &http.Service{WaitlistService: mockWaitlistService})
+
+			// Set up Echo mock context
+			reqBody := dto.ReservationRequest{NumCustomers: 4, Guest: dto.GuestRequest{Name: guest.Name, Phone: guest.Phone}, StartTime: startTime}
+			reqJSON, _ := json.Marshal(reqBody)
+			req := httptest.NewRequest(netHttp.MethodPost, "/waitlist", bytes.NewReader(reqJSON))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			e := echo.New()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("restaurantId")
+			ctx.SetParamValues("r1")
+
+			// Mock behavior
+			mockWaitlistService.EXPECT().JoinWaitlist("r1", 4, guest, startTime, time.Duration(0), coreModel.Seating{}, coreModel.Seating{}).Return(nil, errors.New("tables are available for this time slot")).Times(1)
+
+			// Execute handler
+			err := handler.JoinWaitlist(ctx)
+
+			// Assertions
+			assert.NoError(t, err)
+			assert.Equal(t, netHttp.StatusBadRequest, rec.Code)
+
+			var res model.Response
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, "tables are available for this time slot", res.Data)
+		})
+	})
+	t.Run("GetWaitlistPosition", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Mock dependencies
+		mockWaitlistService := serviceMock.NewMockWaitlistService(ctrl)
+		logger := zap.NewNop()
+		handler := http.NewWaitlistHandler(logger, &http.Service{WaitlistService: mockWaitlistService})
+
+		// Set up Echo mock context
+		req := httptest.NewRequest(netHttp.MethodGet, "/waitlist/w-1", nil)
+		rec := httptest.NewRecorder()
+		e := echo.New()
+		ctx := e.NewContext(req, rec)
+		ctx.SetParamNames("restaurantId", "id")
+		ctx.SetParamValues("r1", "w-1")
+
+		// Mock behavior
+		entry := &coreModel.WaitlistEntry{Id: "w-1", RestaurantId: "r1", PartySize: 4, Guest: guest, StartTime: startTime, Duration: 2 * time.Hour, Status: coreModel.WaitlistPromoted, ReservationId: "res-9"}
+		mockWaitlistService.EXPECT().WaitlistPosition("r1", "w-1").Return(entry, 0, nil).Times(1)
+
+		// Execute handler
+		err := handler.GetWaitlistPosition(ctx)
+
+		// Assertions
+		assert.NoError(t, err)
+		assert.Equal(t, netHttp.StatusOK, rec.Code)
+
+		var res model.Response
+		err = json.Unmarshal(rec.Body.Bytes(), &res)
+		assert.NoError(t, err)
+		assert.Equal(t, "promoted", res.Data.(map[string]interface{})["status"])
+		assert.Equal(t, "res-9", res.Data.(map[string]interface{})["reservation_id"])
+		assert.Equal(t, float64(0), res.Data.(map[string]interface{})["position"])
+	})
+	t.Run("LeaveWaitlist", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Mock dependencies
+		mockWaitlistService := serviceMock.NewMockWaitlistService(ctrl)
+		logger := zap.NewNop()
+		handler := http.NewWaitlistHandler(logger, &http.Service{WaitlistService: mockWaitlistService})
+
+		// Set up Echo mock context
+		req := httptest.NewRequest(netHttp.MethodDelete, "/waitlist/w-1", nil)
+		rec := httptest.NewRecorder()
+		e := echo.New()
+		ctx := e.NewContext(req, rec)
+		ctx.SetParamNames("restaurantId", "id")
+		ctx.SetParamValues("r1", "w-1")
+
+		// Mock behavior
+		mockWaitlistService.EXPECT().LeaveWaitlist("r1", "w-1").Return(nil, errors.New("waitlist entry is already left")).Times(1)
+
+		// Execute handler
+		err := handler.LeaveWaitlist(ctx)
+
+		// Assertions
+		assert.NoError(t, err)
+		assert.Equal(t, netHttp.StatusBadRequest, rec.Code)
+
+		var res model.Response
+		err = json.Unmarshal(rec.Body.Bytes(), &res)
+		assert.NoError(t, err)
+		assert.Equal(t, "waitlist entry is already left", res.Data)
+	})
+}
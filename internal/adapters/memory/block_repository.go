@@ -0,0 +1,64 @@
+package memory
+
+import (
+	"errors"
+	"github.com/bossncn/restaurant-reservation-service/internal/core/model"
+	"sort"
+	"sync"
+	"time"
+)
+
+// BlockRepository keeps each restaurant's table blocks. Blocks are listed outside the event
+// processor, so they are guarded with a lock.
+type BlockRepository struct {
+	Blocks map[string][]model.TableBlock
+	mu     sync.RWMutex
+}
+
+func NewBlockRepository() *BlockRepository {
+	repo := &BlockRepository{
+		Blocks: make(map[string][]model.TableBlock),
+	}
+	return repo
+}
+
+func (r *BlockRepository) AddBlock(block model.TableBlock) *model.TableBlock {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	block.Id = generateID()
+	r.Blocks[block.RestaurantId] = append(r.Blocks[block.RestaurantId], block)
+	return &block
+}
+
+func (r *BlockRepository) RemoveBlock(restaurantId string, id string) (*model.TableBlock, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	blocks := r.Blocks[restaurantId]
+	for i, block := range blocks {
+		if block.Id == id {
+			r.Blocks[restaurantId] = append(blocks[:i:i], blocks[i+1:]...)
+			return &block, nil
+		}
+	}
+	return nil, errors.New("table block not found")
+}
+
+func (r *BlockRepository) BlocksBetween(restaurantId string, start time.Time, end time.Time) []model.TableBlock {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	blocks := make([]model.TableBlock, 0)
+	for _, block := range r.Blocks[restaurantId] {
+		if block.Overlaps(start, end) {
+			blocks = append(blocks, block)
+		}
+	}
+
+	sort.SliceStable(blocks, func(i, j int) bool {
+		return blocks[i].StartTime.Before(blocks[j].StartTime)
+	})
+
+	return blocks
+}
@@ -0,0 +1,60 @@
+package memory_test
+
+import (
+	"github.com/bossncn/restaurant-reservation-service/internal/adapters/memory"
+	"github.com/bossncn/restaurant-reservation-service/internal/core/model"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestMemoryBlockRepository(t *testing.T) {
+	morning := time.Date(2025, 1, 10, 9, 0, 0, 0, time.UTC)
+
+	t.Run("NewBlockRepository", func(t *testing.T) {
+		repo := memory.NewBlockRepository()
+
+		assert.NotNil(t, repo)
+		assert.Empty(t, repo.BlocksBetween("r1", morning, morning.Add(time.Hour)))
+	})
+	t.Run("AddBlock", func(t *testing.T) {
+		repo := memory.NewBlockRepository()
+
+		block := repo.AddBlock(model.TableBlock{RestaurantId: "r1", TableIds: []string{"T1"}, StartTime: morning, EndTime: morning.Add(2 * time.Hour), Reason: "broken chair"})
+
+		assert.NotEmpty(t, block.Id)
+		assert.Equal(t, []model.TableBlock{*block}, repo.BlocksBetween("r1", morning, morning.Add(time.Hour)))
+		// Blocks are invisible to other restaurants
+		assert.Empty(t, repo.BlocksBetween("r2", morning, morning.Add(time.Hour)))
+	})
+	t.Run("BlocksBetween", func(t *testing.T) {
+		repo := memory.NewBlockRepository()
+
+		evening := repo.AddBlock(model.TableBlock{RestaurantId: "r1", TableIds: []string{"T1"}, StartTime: morning.Add(9 * time.Hour), EndTime: morning.Add(11 * time.Hour)})
+		open := repo.AddBlock(model.TableBlock{RestaurantId: "r1", TableIds: []string{"T2"}, StartTime: morning})
+
+		assert.Equal(t, []model.TableBlock{*open}, repo.BlocksBetween("r1", morning, morning.Add(time.Hour)))
+		assert.Equal(t, []model.TableBlock{*open, *evening}, repo.BlocksBetween("r1", morning, morning.Add(12*time.Hour)))
+		assert.Equal(t, []model.TableBlock{*open}, repo.BlocksBetween("r1", morning.Add(11*time.Hour), morning.Add(12*time.Hour)))
+	})
+	t.Run("RemoveBlock", func(t *testing.T) {
+		t.Run("Success", func(t *testing.T) {
+			repo := memory.NewBlockRepository()
+
+			block := repo.AddBlock(model.TableBlock{RestaurantId: "r1", TableIds: []string{"T1"}, StartTime: morning})
+			removed, err := repo.RemoveBlock("r1", block.Id)
+
+			assert.NoError(t, err)
+			assert.Equal(t, block, removed)
+			assert.Empty(t, repo.BlocksBetween("r1", morning, morning.Add(time.Hour)))
+		})
+		t.Run("NotFound", func(t *testing.T) {
+			repo := memory.NewBlockRepository()
+
+			block := repo.AddBlock(model.TableBlock{RestaurantId: "r1", TableIds: []string{"T1"}, StartTime: morning})
+			_, err := repo.RemoveBlock("r2", block.Id)
+
+			assert.EqualError(t, err, "table block not found")
+		})
+	})
+}
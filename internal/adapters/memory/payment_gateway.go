@@ -0,0 +1,70 @@
+package memory
+
+import (
+	"errors"
+	"fmt"
+	"github.com/bossncn/restaurant-reservation-service/internal/core/service"
+	"sync"
+)
+
+// DeclinedToken is a payment token the fake gateway always declines, for trying out failed payments.
+const DeclinedToken = "tok_declined"
+
+// FakePaymentGateway takes payments in memory without moving any money, for local development and
+// tests. Every token but DeclinedToken is accepted.
+type FakePaymentGateway struct {
+	Payments map[string]*FakePayment
+	mu       sync.Mutex
+}
+
+type FakePayment struct {
+	Reference string
+	Amount    int
+	Refunded  int
+}
+
+func NewFakePaymentGateway() *FakePaymentGateway {
+	return &FakePaymentGateway{
+		Payments: make(map[string]*FakePayment),
+	}
+}
+
+func (g *FakePaymentGateway) Charge(token string, amount int, reference string) (string, error) {
+	if token == "" {
+		return "", errors.New("payment token must not be empty")
+	}
+	if amount <= 0 {
+		return "", errors.New("payment amount must be greater than zero")
+	}
+	if token == DeclinedToken {
+		return "", service.ErrPaymentDeclined
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	var id string
+	for {
+		id = generateID()
+		if _, exists := g.Payments[id]; !exists {
+			break
+		}
+	}
+	g.Payments[id] = &FakePayment{Reference: reference, Amount: amount}
+	return id, nil
+}
+
+func (g *FakePaymentGateway) Refund(paymentId string, amount int) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	payment, ok := g.Payments[paymentId]
+	if !ok {
+		return fmt.Errorf("payment %s not found", paymentId)
+	}
+	if amount <= 0 || payment.Refunded+amount > payment.Amount {
+		return errors.New("refund exceeds the amount paid")
+	}
+	payment.Refunded += amount
+	return nil
+}
@@ -0,0 +1,49 @@
+package memory_test
+
+import (
+	"github.com/bossncn/restaurant-reservation-service/internal/adapters/memory"
+	"github.com/bossncn/restaurant-reservation-service/internal/core/service"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestFakePaymentGateway(t *testing.T) {
+	t.Run("Charge", func(t *testing.T) {
+		gateway := memory.NewFakePaymentGateway()
+
+		id, err := gateway.Charge("tok_visa", 2000, "res-1")
+
+		assert.NoError(t, err)
+		assert.NotEmpty(t, id)
+		assert.Equal(t, &memory.FakePayment{Reference: "res-1", Amount: 2000}, gateway.Payments[id])
+	})
+	t.Run("Declined", func(t *testing.T) {
+		gateway := memory.NewFakePaymentGateway()
+
+		id, err := gateway.Charge(memory.DeclinedToken, 2000, "res-1")
+
+		assert.ErrorIs(t, err, service.ErrPaymentDeclined)
+		assert.Empty(t, id)
+		assert.Empty(t, gateway.Payments)
+	})
+	t.Run("InvalidCharge", func(t *testing.T) {
+		gateway := memory.NewFakePaymentGateway()
+
+		_, err := gateway.Charge("", 2000, "res-1")
+		assert.EqualError(t, err, "payment token must not be empty")
+
+		_, err = gateway.Charge("tok_visa", 0, "res-1")
+		assert.EqualError(t, err, "payment amount must be greater than zero")
+	})
+	t.Run("Refund", func(t *testing.T) {
+		gateway := memory.NewFakePaymentGateway()
+		id, _ := gateway.Charge("tok_visa", 2000, "res-1")
+
+		assert.NoError(t, gateway.Refund(id, 1500))
+		assert.EqualError(t, gateway.Refund(id, 1000), "refund exceeds the amount paid")
+		assert.NoError(t, gateway.Refund(id, 500))
+		assert.Equal(t, 2000, gateway.Payments[id].Refunded)
+
+		assert.EqualError(t, gateway.Refund("missing", 100), "payment missing not found")
+	})
+}
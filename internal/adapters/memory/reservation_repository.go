@@ -4,31 +4,49 @@ import (
 	"errors"
 	"fmt"
 	"github.com/bossncn/restaurant-reservation-service/internal/core/model"
+	"sort"
+	"sync"
 	"time"
 )
 
+// ReservationRepository is written by the event processor and read by request handlers looking up
+// reservations, so it guards the reservations with a lock.
 type ReservationRepository struct {
-	Reservations map[string]model.Reservation
+	// Reservations is keyed by restaurant id, then reservation id.
+	Reservations map[string]map[string]model.Reservation
+	mu           sync.RWMutex
 }
 
 func NewReservationRepository() *ReservationRepository {
 	repo := &ReservationRepository{
-		Reservations: make(map[string]model.Reservation),
+		Reservations: make(map[string]map[string]model.Reservation),
 	}
 	return repo
 }
 
-func (r *ReservationRepository) CreateReservation(numTables int) *model.Reservation {
-	reservation := &model.Reservation{
-		Id:        generateID(),
-		NumTables: numTables,
+func (r *ReservationRepository) CreateReservation(reservation model.Reservation) *model.Reservation {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.Reservations[reservation.RestaurantId] == nil {
+		r.Reservations[reservation.RestaurantId] = make(map[string]model.Reservation)
+	}
+	// Recurring reservations are created in a burst, so make sure no two share an id.
+	for {
+		reservation.Id = generateID()
+		if _, exists := r.Reservations[reservation.RestaurantId][reservation.Id]; !exists {
+			break
+		}
 	}
-	r.Reservations[reservation.Id] = *reservation
-	return reservation
+	r.Reservations[reservation.RestaurantId][reservation.Id] = reservation
+	return &reservation
 }
 
-func (r *ReservationRepository) FindReservationById(id string) (*model.Reservation, error) {
-	res, existed := r.Reservations[id]
+func (r *ReservationRepository) FindReservationById(restaurantId string, id string) (*model.Reservation, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	res, existed := r.Reservations[restaurantId][id]
 	if !existed {
 		return nil, errors.New("reservation not found")
 	}
@@ -36,17 +54,33 @@ func (r *ReservationRepository) FindReservationById(id string) (*model.Reservati
 	return &res, nil
 }
 
-func (r *ReservationRepository) CancelReservation(reservationID string) error {
-	res, err := r.FindReservationById(reservationID)
-	if err != nil {
-		return err
+func (r *ReservationRepository) UpdateReservation(reservation model.Reservation) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, existed := r.Reservations[reservation.RestaurantId][reservation.Id]; !existed {
+		return errors.New("reservation not found")
 	}
 
-	delete(r.Reservations, res.Id)
+	r.Reservations[reservation.RestaurantId][reservation.Id] = reservation
 
 	return nil
 }
 
+func (r *ReservationRepository) FindReservationsBySeriesId(restaurantId string, seriesId string) []model.Reservation {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	reservations := make([]model.Reservation, 0)
+	for _, reservation := range r.Reservations[restaurantId] {
+		if seriesId != "" && reservation.SeriesId == seriesId {
+			reservations = append(reservations, reservation)
+		}
+	}
+	sort.Slice(reservations, func(i, j int) bool { return reservations[i].StartTime.Before(reservations[j].StartTime) })
+	return reservations
+}
+
 func generateID() string {
 	return fmt.Sprintf("%d", time.Now().UnixNano())
 }
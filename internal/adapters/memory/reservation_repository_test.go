@@ -2,8 +2,11 @@ package memory_test
 
 import (
 	"github.com/bossncn/restaurant-reservation-service/internal/adapters/memory"
+	"github.com/bossncn/restaurant-reservation-service/internal/core/model"
 	"github.com/stretchr/testify/assert"
+	"sync"
 	"testing"
+	"time"
 )
 
 func TestMemoryReservationRepository(t *testing.T) {
@@ -16,23 +19,22 @@ func TestMemoryReservationRepository(t *testing.T) {
 		repo := memory.NewReservationRepository()
 
 		// Create a reservation
-		reservation := repo.CreateReservation(3)
+		reservation := repo.CreateReservation(model.Reservation{RestaurantId: "r1", NumTables: 3})
 
 		assert.NotNil(t, reservation)
 		assert.Equal(t, 3, reservation.NumTables)
 		assert.NotEmpty(t, reservation.Id)
-		assert.Equal(t, *reservation, repo.Reservations[reservation.Id])
+		assert.Equal(t, *reservation, repo.Reservations["r1"][reservation.Id])
 	})
 	t.Run("FindReservationById", func(t *testing.T) {
 		t.Run("Success", func(t *testing.T) {
 			repo := memory.NewReservationRepository()
 
 			// Create and add a reservation to the repository
-			reservation := repo.CreateReservation(2)
-			repo.Reservations[reservation.Id] = *reservation
+			reservation := repo.CreateReservation(model.Reservation{RestaurantId: "r1", NumTables: 2})
 
 			// Find the reservation
-			found, err := repo.FindReservationById(reservation.Id)
+			found, err := repo.FindReservationById("r1", reservation.Id)
 
 			assert.NoError(t, err)
 			assert.Equal(t, reservation.Id, found.Id)
@@ -42,34 +44,86 @@ func TestMemoryReservationRepository(t *testing.T) {
 			repo := memory.NewReservationRepository()
 
 			// Try to find a non-existent reservation
-			_, err := repo.FindReservationById("non-existent-id")
+			_, err := repo.FindReservationById("r1", "non-existent-id")
+
+			assert.Error(t, err)
+			assert.Equal(t, "reservation not found", err.Error())
+		})
+		t.Run("OtherRestaurant", func(t *testing.T) {
+			repo := memory.NewReservationRepository()
+
+			reservation := repo.CreateReservation(model.Reservation{RestaurantId: "r1", NumTables: 2})
+
+			// Reservations are invisible to other restaurants
+			_, err := repo.FindReservationById("r2", reservation.Id)
 
 			assert.Error(t, err)
 			assert.Equal(t, "reservation not found", err.Error())
 		})
 	})
-	t.Run("CancelReservation", func(t *testing.T) {
+	t.Run("UpdateReservation", func(t *testing.T) {
 		t.Run("Success", func(t *testing.T) {
 			repo := memory.NewReservationRepository()
 
 			// Create and add a reservation to the repository
-			reservation := repo.CreateReservation(4)
-			repo.Reservations[reservation.Id] = *reservation
+			reservation := repo.CreateReservation(model.Reservation{RestaurantId: "r1", NumTables: 4, Status: model.ReservationConfirmed})
 
 			// Cancel the reservation
-			err := repo.CancelReservation(reservation.Id)
+			reservation.Status = model.ReservationCancelled
+			err := repo.UpdateReservation(*reservation)
+
+			assert.NoError(t, err)
 
+			// Cancelled reservations stay queryable
+			found, err := repo.FindReservationById("r1", reservation.Id)
 			assert.NoError(t, err)
-			assert.NotContains(t, repo.Reservations, reservation.Id)
+			assert.Equal(t, model.ReservationCancelled, found.Status)
 		})
 		t.Run("NotFound", func(t *testing.T) {
 			repo := memory.NewReservationRepository()
 
-			// Try to cancel a non-existent reservation
-			err := repo.CancelReservation("non-existent-id")
+			// Try to update a non-existent reservation
+			err := repo.UpdateReservation(model.Reservation{Id: "non-existent-id", RestaurantId: "r1"})
 
 			assert.Error(t, err)
 			assert.Equal(t, "reservation not found", err.Error())
 		})
 	})
+	t.Run("FindReservationsBySeriesId", func(t *testing.T) {
+		repo := memory.NewReservationRepository()
+		start := time.Date(2025, 1, 10, 19, 0, 0, 0, time.UTC)
+
+		second := repo.CreateReservation(model.Reservation{RestaurantId: "r1", StartTime: start.AddDate(0, 0, 7), SeriesId: "series-1"})
+		first := repo.CreateReservation(model.Reservation{RestaurantId: "r1", StartTime: start, SeriesId: "series-1"})
+		repo.CreateReservation(model.Reservation{RestaurantId: "r1", StartTime: start})
+		repo.CreateReservation(model.Reservation{RestaurantId: "r1", StartTime: start, SeriesId: "series-2"})
+
+		// Occurrences come back in start time order
+		assert.Equal(t, []model.Reservation{*first, *second}, repo.FindReservationsBySeriesId("r1", "series-1"))
+		assert.Empty(t, repo.FindReservationsBySeriesId("r2", "series-1"))
+		assert.Empty(t, repo.FindReservationsBySeriesId("r1", ""))
+	})
+	t.Run("ConcurrentAccess", func(t *testing.T) {
+		repo := memory.NewReservationRepository()
+		reservation := repo.CreateReservation(model.Reservation{RestaurantId: "r1", PartySize: 2})
+
+		// Handlers look reservations up while the processor writes them; run with -race to catch unguarded access.
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				repo.CreateReservation(model.Reservation{RestaurantId: "r1"})
+				_ = repo.UpdateReservation(model.Reservation{Id: reservation.Id, RestaurantId: "r1", PartySize: i})
+			}
+		}()
+		for i := 0; i < 100; i++ {
+			_, _ = repo.FindReservationById("r1", reservation.Id)
+		}
+		wg.Wait()
+
+		found, err := repo.FindReservationById("r1", reservation.Id)
+		assert.NoError(t, err)
+		assert.Equal(t, 99, found.PartySize)
+	})
 }
@@ -0,0 +1,66 @@
+package memory
+
+import (
+	"errors"
+	"fmt"
+	"github.com/bossncn/restaurant-reservation-service/internal/core/model"
+	"sort"
+	"sync"
+)
+
+// RestaurantRepository is read by every request, outside the event processor, so it guards the
+// registry with a lock.
+type RestaurantRepository struct {
+	Restaurants map[string]model.Restaurant
+	mu          sync.RWMutex
+}
+
+func NewRestaurantRepository() *RestaurantRepository {
+	repo := &RestaurantRepository{
+		Restaurants: make(map[string]model.Restaurant),
+	}
+	return repo
+}
+
+func (r *RestaurantRepository) CreateRestaurant(restaurant model.Restaurant) (*model.Restaurant, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if restaurant.Id == "" {
+		restaurant.Id = generateID()
+	}
+	if _, existed := r.Restaurants[restaurant.Id]; existed {
+		return nil, fmt.Errorf("restaurant %s already exists", restaurant.Id)
+	}
+
+	r.Restaurants[restaurant.Id] = restaurant
+	return &restaurant, nil
+}
+
+func (r *RestaurantRepository) FindRestaurantById(id string) (*model.Restaurant, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	restaurant, existed := r.Restaurants[id]
+	if !existed {
+		return nil, errors.New("restaurant not found")
+	}
+
+	return &restaurant, nil
+}
+
+func (r *RestaurantRepository) ListRestaurants() []model.Restaurant {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	restaurants := make([]model.Restaurant, 0, len(r.Restaurants))
+	for _, restaurant := range r.Restaurants {
+		restaurants = append(restaurants, restaurant)
+	}
+
+	sort.Slice(restaurants, func(i, j int) bool {
+		return restaurants[i].Id < restaurants[j].Id
+	})
+
+	return restaurants
+}
@@ -0,0 +1,73 @@
+package memory_test
+
+import (
+	"github.com/bossncn/restaurant-reservation-service/internal/adapters/memory"
+	"github.com/bossncn/restaurant-reservation-service/internal/core/model"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestMemoryRestaurantRepository(t *testing.T) {
+	t.Run("NewRestaurantRepository", func(t *testing.T) {
+		repo := memory.NewRestaurantRepository()
+
+		assert.NotNil(t, repo)
+		assert.Empty(t, repo.ListRestaurants())
+	})
+	t.Run("CreateRestaurant", func(t *testing.T) {
+		t.Run("Success", func(t *testing.T) {
+			repo := memory.NewRestaurantRepository()
+
+			restaurant, err := repo.CreateRestaurant(model.Restaurant{Id: "downtown", Name: "Downtown"})
+
+			assert.NoError(t, err)
+			assert.Equal(t, "downtown", restaurant.Id)
+			assert.Contains(t, repo.Restaurants, "downtown")
+		})
+		t.Run("GeneratedId", func(t *testing.T) {
+			repo := memory.NewRestaurantRepository()
+
+			restaurant, err := repo.CreateRestaurant(model.Restaurant{Name: "Harbour"})
+
+			assert.NoError(t, err)
+			assert.NotEmpty(t, restaurant.Id)
+		})
+		t.Run("AlreadyExists", func(t *testing.T) {
+			repo := memory.NewRestaurantRepository()
+
+			_, _ = repo.CreateRestaurant(model.Restaurant{Id: "downtown", Name: "Downtown"})
+			_, err := repo.CreateRestaurant(model.Restaurant{Id: "downtown", Name: "Other"})
+
+			assert.Error(t, err)
+			assert.Equal(t, "restaurant downtown already exists", err.Error())
+		})
+	})
+	t.Run("FindRestaurantById", func(t *testing.T) {
+		t.Run("Success", func(t *testing.T) {
+			repo := memory.NewRestaurantRepository()
+
+			_, _ = repo.CreateRestaurant(model.Restaurant{Id: "downtown", Name: "Downtown"})
+
+			restaurant, err := repo.FindRestaurantById("downtown")
+
+			assert.NoError(t, err)
+			assert.Equal(t, "Downtown", restaurant.Name)
+		})
+		t.Run("NotFound", func(t *testing.T) {
+			repo := memory.NewRestaurantRepository()
+
+			_, err := repo.FindRestaurantById("downtown")
+
+			assert.Error(t, err)
+			assert.Equal(t, "restaurant not found", err.Error())
+		})
+	})
+	t.Run("ListRestaurants", func(t *testing.T) {
+		repo := memory.NewRestaurantRepository()
+
+		_, _ = repo.CreateRestaurant(model.Restaurant{Id: "harbour", Name: "Harbour"})
+		_, _ = repo.CreateRestaurant(model.Restaurant{Id: "downtown", Name: "Downtown"})
+
+		assert.Equal(t, []model.Restaurant{{Id: "downtown", Name: "Downtown"}, {Id: "harbour", Name: "Harbour"}}, repo.ListRestaurants())
+	})
+}
@@ -0,0 +1,40 @@
+package memory
+
+import (
+	"errors"
+	"github.com/bossncn/restaurant-reservation-service/internal/core/model"
+	"sync"
+)
+
+// ScheduleRepository is written by admin requests and read by the event processor, so it guards
+// the schedules with a lock.
+type ScheduleRepository struct {
+	Schedules map[string]model.Schedule
+	mu        sync.RWMutex
+}
+
+func NewScheduleRepository() *ScheduleRepository {
+	repo := &ScheduleRepository{
+		Schedules: make(map[string]model.Schedule),
+	}
+	return repo
+}
+
+func (r *ScheduleRepository) SaveSchedule(schedule model.Schedule) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.Schedules[schedule.RestaurantId] = schedule
+	return nil
+}
+
+func (r *ScheduleRepository) FindScheduleByRestaurantId(restaurantId string) (*model.Schedule, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	schedule, existed := r.Schedules[restaurantId]
+	if !existed {
+		return nil, errors.New("schedule not found")
+	}
+	return &schedule, nil
+}
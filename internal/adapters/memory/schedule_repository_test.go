@@ -0,0 +1,34 @@
+package memory_test
+
+import (
+	"github.com/bossncn/restaurant-reservation-service/internal/adapters/memory"
+	"github.com/bossncn/restaurant-reservation-service/internal/core/model"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestMemoryScheduleRepository(t *testing.T) {
+	t.Run("SaveSchedule", func(t *testing.T) {
+		repo := memory.NewScheduleRepository()
+
+		err := repo.SaveSchedule(model.Schedule{RestaurantId: "r1", Shifts: []model.Shift{{Name: "Lunch", Weekday: time.Monday, Opens: 11 * time.Hour, Closes: 14 * time.Hour}}})
+
+		assert.NoError(t, err)
+		found, err := repo.FindScheduleByRestaurantId("r1")
+		assert.NoError(t, err)
+		assert.Equal(t, "Lunch", found.Shifts[0].Name)
+
+		// Saving again replaces the schedule
+		assert.NoError(t, repo.SaveSchedule(model.Schedule{RestaurantId: "r1"}))
+		found, _ = repo.FindScheduleByRestaurantId("r1")
+		assert.Empty(t, found.Shifts)
+	})
+	t.Run("NotFound", func(t *testing.T) {
+		repo := memory.NewScheduleRepository()
+
+		_, err := repo.FindScheduleByRestaurantId("r1")
+
+		assert.EqualError(t, err, "schedule not found")
+	})
+}
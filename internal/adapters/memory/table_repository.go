@@ -2,55 +2,176 @@ package memory
 
 import (
 	"errors"
+	"fmt"
 	"github.com/bossncn/restaurant-reservation-service/internal/core/model"
+	"sort"
+	"sync"
+	"time"
 )
 
+// TableRepository is written by the event processor and read by request handlers reporting table
+// status, so it guards the inventory and reservations with a lock.
 type TableRepository struct {
-	Table model.Table
+	// Inventory is keyed by restaurant id.
+	Inventory map[string][]model.Table
+	// Reservations is keyed by restaurant id, then reservation id.
+	Reservations map[string]map[string]model.Reservation
+	mu           sync.RWMutex
 }
 
 func NewTableRepository() *TableRepository {
 	repo := &TableRepository{
-		Table: model.Table{
-			Reservations: make(map[string]model.Reservation),
-		},
+		Inventory:    make(map[string][]model.Table),
+		Reservations: make(map[string]map[string]model.Reservation),
 	}
 	return repo
 }
 
-func (r *TableRepository) InitializeTables(numTables int) error {
-	if r.Table.TotalTables > 0 {
+func (r *TableRepository) InitializeTables(restaurantId string, tables []model.Table) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(r.Inventory[restaurantId]) > 0 {
 		return errors.New("tables already initialized")
 	}
 
-	r.Table.TotalTables = numTables
-	r.Table.AvailableTables = numTables
+	r.Inventory[restaurantId] = append([]model.Table{}, tables...)
+	r.Reservations[restaurantId] = make(map[string]model.Reservation)
+	return nil
+}
+
+func (r *TableRepository) ReplaceTables(restaurantId string, tables []model.Table) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(r.Inventory[restaurantId]) == 0 {
+		return errors.New("tables has not been initialized")
+	}
+
+	r.Inventory[restaurantId] = append([]model.Table{}, tables...)
 	return nil
 }
 
 func (r *TableRepository) ReserveTables(reservation model.Reservation) error {
-	r.Table.Reservations[reservation.Id] = reservation
-	r.Table.AvailableTables -= reservation.NumTables
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	reservations, initialized := r.Reservations[reservation.RestaurantId]
+	if !initialized {
+		return errors.New("tables has not been initialized")
+	}
+
+	for _, tableId := range reservation.TableIds {
+		if !r.hasTable(reservation.RestaurantId, tableId) {
+			return fmt.Errorf("table %s not found", tableId)
+		}
+		for _, existing := range reservations {
+			if existing.Id != reservation.Id && existing.HasTable(tableId) && existing.Overlaps(reservation.StartTime, reservation.EndTime()) {
+				return fmt.Errorf("table %s is already reserved", tableId)
+			}
+		}
+	}
+
+	reservations[reservation.Id] = reservation
 
 	return nil
 }
 
-func (r *TableRepository) CancelReservedTable(reservationId string) error {
-	reservation, existed := r.Table.Reservations[reservationId]
+func (r *TableRepository) CancelReservedTable(restaurantId string, reservationId string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	reservation, existed := r.Reservations[restaurantId][reservationId]
 
 	if !existed {
 		return errors.New("booking not found")
 	}
-	delete(r.Table.Reservations, reservation.Id)
-	r.Table.AvailableTables += reservation.NumTables
+	delete(r.Reservations[restaurantId], reservation.Id)
 
 	return nil
 }
 
-func (r *TableRepository) IsTableInitialized() bool {
-	return r.Table.TotalTables > 0
+func (r *TableRepository) IsTableInitialized(restaurantId string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return len(r.Inventory[restaurantId]) > 0
+}
+
+func (r *TableRepository) Tables(restaurantId string) []model.Table {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return append([]model.Table{}, r.Inventory[restaurantId]...)
+}
+
+func (r *TableRepository) TotalTables(restaurantId string) int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return len(r.Inventory[restaurantId])
 }
 
-func (r *TableRepository) AvailableTables() int {
-	return r.Table.AvailableTables
+func (r *TableRepository) AvailableTables(restaurantId string, start time.Time, end time.Time) int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return len(r.freeTables(restaurantId, start, end))
+}
+
+func (r *TableRepository) FreeTables(restaurantId string, start time.Time, end time.Time) []model.Table {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.freeTables(restaurantId, start, end)
+}
+
+func (r *TableRepository) freeTables(restaurantId string, start time.Time, end time.Time) []model.Table {
+	reserved := make(map[string]bool)
+	for _, reservation := range r.Reservations[restaurantId] {
+		if reservation.Overlaps(start, end) {
+			for _, tableId := range reservation.TableIds {
+				reserved[tableId] = true
+			}
+		}
+	}
+
+	tables := make([]model.Table, 0, len(r.Inventory[restaurantId]))
+	for _, table := range r.Inventory[restaurantId] {
+		if !reserved[table.Id] {
+			tables = append(tables, table)
+		}
+	}
+
+	return tables
+}
+
+func (r *TableRepository) ReservationsBetween(restaurantId string, start time.Time, end time.Time) []model.Reservation {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	reservations := make([]model.Reservation, 0)
+	for _, reservation := range r.Reservations[restaurantId] {
+		if reservation.Overlaps(start, end) {
+			reservations = append(reservations, reservation)
+		}
+	}
+
+	sort.Slice(reservations, func(i, j int) bool {
+		if reservations[i].StartTime.Equal(reservations[j].StartTime) {
+			return reservations[i].Id < reservations[j].Id
+		}
+		return reservations[i].StartTime.Before(reservations[j].StartTime)
+	})
+
+	return reservations
+}
+
+func (r *TableRepository) hasTable(restaurantId string, tableId string) bool {
+	for _, table := range r.Inventory[restaurantId] {
+		if table.Id == tableId {
+			return true
+		}
+	}
+	return false
 }
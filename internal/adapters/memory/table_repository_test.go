@@ -1,97 +1,213 @@
 package memory_test
 
 import (
+	"fmt"
 	"github.com/bossncn/restaurant-reservation-service/internal/adapters/memory"
 	"github.com/bossncn/restaurant-reservation-service/internal/core/model"
 	"github.com/stretchr/testify/assert"
+	"sync"
 	"testing"
+	"time"
 )
 
 func TestMemoryTableRepository(t *testing.T) {
+	dinner := time.Date(2025, 1, 10, 19, 0, 0, 0, time.UTC)
+	lunch := time.Date(2025, 1, 11, 12, 0, 0, 0, time.UTC)
+	duration := 2 * time.Hour
+	tables := []model.Table{
+		{Id: "T1", Label: "Window 1", Capacity: 2, MinPartySize: 1},
+		{Id: "T2", Label: "Window 2", Capacity: 4, MinPartySize: 1},
+		{Id: "T3", Label: "Booth", Capacity: 6, MinPartySize: 3},
+	}
+
 	t.Run("NewTableRepository", func(t *testing.T) {
 		repo := memory.NewTableRepository()
 
 		assert.NotNil(t, repo)
-		assert.Equal(t, 0, repo.AvailableTables())
-		assert.False(t, repo.IsTableInitialized())
+		assert.Equal(t, 0, repo.TotalTables("r1"))
+		assert.Equal(t, 0, repo.AvailableTables("r1", dinner, dinner.Add(duration)))
+		assert.False(t, repo.IsTableInitialized("r1"))
 	})
 	t.Run("InitializeTables", func(t *testing.T) {
 		t.Run("Success", func(t *testing.T) {
 			repo := memory.NewTableRepository()
 
-			err := repo.InitializeTables(10)
+			err := repo.InitializeTables("r1", tables)
 
 			assert.NoError(t, err)
-			assert.Equal(t, 10, repo.AvailableTables())
-			assert.True(t, repo.IsTableInitialized())
+			assert.Equal(t, 3, repo.TotalTables("r1"))
+			assert.Equal(t, tables, repo.Tables("r1"))
+			assert.Equal(t, 3, repo.AvailableTables("r1", dinner, dinner.Add(duration)))
+			assert.True(t, repo.IsTableInitialized("r1"))
 		})
 		t.Run("AlreadyInitialized", func(t *testing.T) {
 			repo := memory.NewTableRepository()
 
-			_ = repo.InitializeTables(10)
-			err := repo.InitializeTables(5)
+			_ = repo.InitializeTables("r1", tables)
+			err := repo.InitializeTables("r1", tables[:1])
 
 			assert.Error(t, err)
 			assert.Equal(t, "tables already initialized", err.Error())
-			assert.Equal(t, 10, repo.AvailableTables())
+			assert.Equal(t, 3, repo.TotalTables("r1"))
+		})
+	})
+	t.Run("ReplaceTables", func(t *testing.T) {
+		t.Run("Success", func(t *testing.T) {
+			repo := memory.NewTableRepository()
+
+			_ = repo.InitializeTables("r1", tables)
+			_ = repo.ReserveTables(model.Reservation{Id: "res-1", RestaurantId: "r1", TableIds: []string{"T1"}, StartTime: dinner, Duration: duration})
+			err := repo.ReplaceTables("r1", tables[:2])
+
+			assert.NoError(t, err)
+			assert.Equal(t, tables[:2], repo.Tables("r1"))
+			assert.Contains(t, repo.Reservations["r1"], "res-1")
+			assert.Equal(t, 1, repo.AvailableTables("r1", dinner, dinner.Add(duration)))
+		})
+		t.Run("NotInitialized", func(t *testing.T) {
+			repo := memory.NewTableRepository()
+
+			err := repo.ReplaceTables("r1", tables)
+
+			assert.EqualError(t, err, "tables has not been initialized")
+			assert.False(t, repo.IsTableInitialized("r1"))
 		})
 	})
 	t.Run("ReserveTables", func(t *testing.T) {
 		t.Run("Success", func(t *testing.T) {
 			repo := memory.NewTableRepository()
 
-			_ = repo.InitializeTables(10)
+			_ = repo.InitializeTables("r1", tables)
 			reservation := model.Reservation{
-				Id:        "res-1",
-				NumTables: 3,
+				Id:           "res-1",
+				RestaurantId: "r1",
+				PartySize:    8,
+				NumTables:    2,
+				TableIds:     []string{"T2", "T3"},
+				StartTime:    dinner,
+				Duration:     duration,
 			}
 
 			err := repo.ReserveTables(reservation)
 
 			assert.NoError(t, err)
-			assert.Equal(t, 7, repo.AvailableTables())
-			assert.Contains(t, repo.Table.Reservations, "res-1")
+			assert.Equal(t, 1, repo.AvailableTables("r1", dinner, dinner.Add(duration)))
+			assert.Contains(t, repo.Reservations["r1"], "res-1")
+		})
+		t.Run("UnknownTable", func(t *testing.T) {
+			repo := memory.NewTableRepository()
+
+			_ = repo.InitializeTables("r1", tables)
+
+			err := repo.ReserveTables(model.Reservation{RestaurantId: "r1", Id: "res-1", TableIds: []string{"T9"}, StartTime: dinner, Duration: duration})
+
+			assert.Error(t, err)
+			assert.Equal(t, "table T9 not found", err.Error())
+		})
+		t.Run("TableAlreadyReserved", func(t *testing.T) {
+			repo := memory.NewTableRepository()
+
+			_ = repo.InitializeTables("r1", tables)
+			_ = repo.ReserveTables(model.Reservation{RestaurantId: "r1", Id: "res-1", TableIds: []string{"T1"}, StartTime: dinner, Duration: duration})
+
+			err := repo.ReserveTables(model.Reservation{RestaurantId: "r1", Id: "res-2", TableIds: []string{"T1"}, StartTime: dinner.Add(time.Hour), Duration: duration})
+
+			assert.Error(t, err)
+			assert.Equal(t, "table T1 is already reserved", err.Error())
+			assert.NotContains(t, repo.Reservations["r1"], "res-2")
 		})
 	})
 	t.Run("CancelReservedTable", func(t *testing.T) {
 		t.Run("Success", func(t *testing.T) {
 			repo := memory.NewTableRepository()
 
-			_ = repo.InitializeTables(10)
+			_ = repo.InitializeTables("r1", tables)
 			reservation := model.Reservation{
-				Id:        "res-1",
-				NumTables: 3,
+				Id:           "res-1",
+				RestaurantId: "r1",
+				NumTables:    1,
+				TableIds:     []string{"T2"},
+				StartTime:    dinner,
+				Duration:     duration,
 			}
 			_ = repo.ReserveTables(reservation)
 
-			err := repo.CancelReservedTable("res-1")
+			err := repo.CancelReservedTable("r1", "res-1")
 
 			assert.NoError(t, err)
-			assert.Equal(t, 10, repo.AvailableTables())
-			assert.NotContains(t, repo.Table.Reservations, "res-1")
+			assert.Equal(t, 3, repo.AvailableTables("r1", dinner, dinner.Add(duration)))
+			assert.NotContains(t, repo.Reservations["r1"], "res-1")
 		})
 		t.Run("NotFound", func(t *testing.T) {
 			repo := memory.NewTableRepository()
 
-			_ = repo.InitializeTables(10)
+			_ = repo.InitializeTables("r1", tables)
 
-			err := repo.CancelReservedTable("non-existent-id")
+			err := repo.CancelReservedTable("r1", "non-existent-id")
 
 			assert.Error(t, err)
 			assert.Equal(t, "booking not found", err.Error())
-			assert.Equal(t, 10, repo.AvailableTables())
+			assert.Equal(t, 3, repo.AvailableTables("r1", dinner, dinner.Add(duration)))
 		})
 	})
-	t.Run("AvailableTables", func(t *testing.T) {
+	t.Run("FreeTables", func(t *testing.T) {
+		repo := memory.NewTableRepository()
+
+		_ = repo.InitializeTables("r1", tables)
+		_ = repo.ReserveTables(model.Reservation{RestaurantId: "r1", Id: "res-1", TableIds: []string{"T3"}, StartTime: dinner, Duration: duration})
+		_ = repo.ReserveTables(model.Reservation{RestaurantId: "r1", Id: "res-2", TableIds: []string{"T1"}, StartTime: dinner.Add(time.Hour), Duration: duration})
+
+		assert.Equal(t, []model.Table{tables[0], tables[1]}, repo.FreeTables("r1", dinner, dinner.Add(time.Hour)))
+		assert.Equal(t, []model.Table{tables[1]}, repo.FreeTables("r1", dinner.Add(time.Hour), dinner.Add(duration)))
+		assert.Equal(t, []model.Table{tables[1], tables[2]}, repo.FreeTables("r1", dinner.Add(duration), dinner.Add(2*duration)))
+		assert.Equal(t, 3, repo.AvailableTables("r1", lunch, lunch.Add(duration)))
+	})
+	t.Run("RestaurantIsolation", func(t *testing.T) {
 		repo := memory.NewTableRepository()
 
-		_ = repo.InitializeTables(10)
-		reservation := model.Reservation{
-			Id:        "res-1",
-			NumTables: 4,
+		_ = repo.InitializeTables("r1", tables)
+		_ = repo.InitializeTables("r2", tables[:1])
+		_ = repo.ReserveTables(model.Reservation{RestaurantId: "r1", Id: "res-1", TableIds: []string{"T1"}, StartTime: dinner, Duration: duration})
+
+		assert.Equal(t, 2, repo.AvailableTables("r1", dinner, dinner.Add(duration)))
+		assert.Equal(t, 1, repo.AvailableTables("r2", dinner, dinner.Add(duration)))
+		assert.Empty(t, repo.ReservationsBetween("r2", dinner, dinner.Add(duration)))
+		assert.False(t, repo.IsTableInitialized("r3"))
+		assert.EqualError(t, repo.ReserveTables(model.Reservation{RestaurantId: "r3", Id: "res-2", TableIds: []string{"T1"}, StartTime: dinner, Duration: duration}), "tables has not been initialized")
+	})
+	t.Run("ReservationsBetween", func(t *testing.T) {
+		repo := memory.NewTableRepository()
+
+		_ = repo.InitializeTables("r1", tables)
+		_ = repo.ReserveTables(model.Reservation{RestaurantId: "r1", Id: "res-2", TableIds: []string{"T2"}, StartTime: dinner.Add(time.Hour), Duration: duration})
+		_ = repo.ReserveTables(model.Reservation{RestaurantId: "r1", Id: "res-1", TableIds: []string{"T1"}, StartTime: dinner, Duration: duration})
+		_ = repo.ReserveTables(model.Reservation{RestaurantId: "r1", Id: "res-3", TableIds: []string{"T1"}, StartTime: lunch, Duration: duration})
+
+		reservations := repo.ReservationsBetween("r1", dinner, dinner.Add(duration))
+
+		assert.Len(t, reservations, 2)
+		assert.Equal(t, "res-1", reservations[0].Id)
+		assert.Equal(t, "res-2", reservations[1].Id)
+	})
+	t.Run("ConcurrentAccess", func(t *testing.T) {
+		repo := memory.NewTableRepository()
+		_ = repo.InitializeTables("r1", tables)
+
+		// Handlers read table status while the processor books; run with -race to catch unguarded access.
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				_ = repo.ReserveTables(model.Reservation{RestaurantId: "r1", Id: fmt.Sprintf("res-%d", i), StartTime: dinner.Add(time.Duration(i) * duration), Duration: duration, TableIds: []string{"T1"}})
+			}
+		}()
+		for i := 0; i < 100; i++ {
+			repo.AvailableTables("r1", dinner, dinner.Add(duration))
+			repo.ReservationsBetween("r1", dinner, dinner.Add(duration))
 		}
-		_ = repo.ReserveTables(reservation)
+		wg.Wait()
 
-		assert.Equal(t, 6, repo.AvailableTables())
+		assert.Len(t, repo.ReservationsBetween("r1", dinner, dinner.Add(100*duration)), 100)
 	})
 }
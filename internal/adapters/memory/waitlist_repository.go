@@ -0,0 +1,69 @@
+package memory
+
+import (
+	"errors"
+	"github.com/bossncn/restaurant-reservation-service/internal/core/model"
+	"sync"
+)
+
+// WaitlistRepository keeps each restaurant's entries in the order they joined. Positions are read
+// outside the event processor, so the entries are guarded with a lock.
+type WaitlistRepository struct {
+	Entries map[string][]model.WaitlistEntry
+	mu      sync.RWMutex
+}
+
+func NewWaitlistRepository() *WaitlistRepository {
+	repo := &WaitlistRepository{
+		Entries: make(map[string][]model.WaitlistEntry),
+	}
+	return repo
+}
+
+func (r *WaitlistRepository) AddEntry(entry model.WaitlistEntry) *model.WaitlistEntry {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entry.Id = generateID()
+	r.Entries[entry.RestaurantId] = append(r.Entries[entry.RestaurantId], entry)
+	return &entry
+}
+
+func (r *WaitlistRepository) FindEntryById(restaurantId string, id string) (*model.WaitlistEntry, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, entry := range r.Entries[restaurantId] {
+		if entry.Id == id {
+			return &entry, nil
+		}
+	}
+	return nil, errors.New("waitlist entry not found")
+}
+
+func (r *WaitlistRepository) UpdateEntry(entry model.WaitlistEntry) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entries := r.Entries[entry.RestaurantId]
+	for i := range entries {
+		if entries[i].Id == entry.Id {
+			entries[i] = entry
+			return nil
+		}
+	}
+	return errors.New("waitlist entry not found")
+}
+
+func (r *WaitlistRepository) WaitingEntries(restaurantId string) []model.WaitlistEntry {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	waiting := make([]model.WaitlistEntry, 0)
+	for _, entry := range r.Entries[restaurantId] {
+		if entry.Status == model.WaitlistWaiting {
+			waiting = append(waiting, entry)
+		}
+	}
+	return waiting
+}
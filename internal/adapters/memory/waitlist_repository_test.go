@@ -0,0 +1,66 @@
+package memory_test
+
+import (
+	"github.com/bossncn/restaurant-reservation-service/internal/adapters/memory"
+	"github.com/bossncn/restaurant-reservation-service/internal/core/model"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestMemoryWaitlistRepository(t *testing.T) {
+	t.Run("NewWaitlistRepository", func(t *testing.T) {
+		repo := memory.NewWaitlistRepository()
+
+		assert.NotNil(t, repo)
+		assert.Empty(t, repo.WaitingEntries("r1"))
+	})
+	t.Run("AddEntry", func(t *testing.T) {
+		repo := memory.NewWaitlistRepository()
+
+		entry := repo.AddEntry(model.WaitlistEntry{RestaurantId: "r1", PartySize: 4, Status: model.WaitlistWaiting})
+
+		assert.NotEmpty(t, entry.Id)
+		found, err := repo.FindEntryById("r1", entry.Id)
+		assert.NoError(t, err)
+		assert.Equal(t, 4, found.PartySize)
+
+		// Entries are invisible to other restaurants
+		_, err = repo.FindEntryById("r2", entry.Id)
+		assert.EqualError(t, err, "waitlist entry not found")
+	})
+	t.Run("UpdateEntry", func(t *testing.T) {
+		t.Run("Success", func(t *testing.T) {
+			repo := memory.NewWaitlistRepository()
+
+			entry := repo.AddEntry(model.WaitlistEntry{RestaurantId: "r1", PartySize: 4, Status: model.WaitlistWaiting})
+			entry.Status = model.WaitlistLeft
+
+			assert.NoError(t, repo.UpdateEntry(*entry))
+
+			found, _ := repo.FindEntryById("r1", entry.Id)
+			assert.Equal(t, model.WaitlistLeft, found.Status)
+		})
+		t.Run("NotFound", func(t *testing.T) {
+			repo := memory.NewWaitlistRepository()
+
+			err := repo.UpdateEntry(model.WaitlistEntry{Id: "missing", RestaurantId: "r1"})
+
+			assert.EqualError(t, err, "waitlist entry not found")
+		})
+	})
+	t.Run("WaitingEntries", func(t *testing.T) {
+		repo := memory.NewWaitlistRepository()
+
+		repo.Entries["r1"] = []model.WaitlistEntry{
+			{Id: "w1", RestaurantId: "r1", PartySize: 2, Status: model.WaitlistWaiting},
+			{Id: "w2", RestaurantId: "r1", PartySize: 6, Status: model.WaitlistPromoted},
+			{Id: "w3", RestaurantId: "r1", PartySize: 4, Status: model.WaitlistWaiting},
+		}
+
+		waiting := repo.WaitingEntries("r1")
+
+		assert.Len(t, waiting, 2)
+		assert.Equal(t, "w1", waiting[0].Id)
+		assert.Equal(t, "w3", waiting[1].Id)
+	})
+}
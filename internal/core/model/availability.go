@@ -0,0 +1,32 @@
+package model
+
+import "time"
+
+// AvailabilityQuery asks when a party could be seated on a given day. From and To bound the
+// requested start times as offsets from midnight in the restaurant's time zone, like a Shift.
+type AvailabilityQuery struct {
+	RestaurantId string
+	PartySize    int
+	// Date is the day to search in the restaurant's time zone, formatted as 2006-01-02.
+	Date string
+	From time.Duration
+	To   time.Duration
+	// Duration overrides the turn time for the party size when it is set.
+	Duration     time.Duration
+	Requirements Seating
+	// Channel decides which booking window the start times must fall in.
+	Channel Channel
+}
+
+// Slot is a start time the party could book and the tables it would be seated at.
+type Slot struct {
+	StartTime time.Time `json:"start_time"`
+	EndTime   time.Time `json:"end_time"`
+	TableIds  []string  `json:"table_ids"`
+}
+
+type Availability struct {
+	Slots []Slot `json:"slots"`
+	// Alternatives are the bookable times nearest the requested range, given when it has no slots.
+	Alternatives []Slot `json:"alternatives"`
+}
@@ -0,0 +1,29 @@
+package model
+
+import "time"
+
+// TableBlock takes tables out of service from StartTime until EndTime, or until it is lifted when
+// EndTime is zero.
+type TableBlock struct {
+	Id           string    `json:"id"`
+	RestaurantId string    `json:"restaurant_id"`
+	TableIds     []string  `json:"table_ids"`
+	StartTime    time.Time `json:"start_time"`
+	EndTime      time.Time `json:"end_time"`
+	Reason       string    `json:"reason"`
+}
+
+// Overlaps reports whether the block is in force at any point in [start, end).
+func (b TableBlock) Overlaps(start time.Time, end time.Time) bool {
+	return b.StartTime.Before(end) && (b.EndTime.IsZero() || start.Before(b.EndTime))
+}
+
+// HasTable reports whether tableId is blocked.
+func (b TableBlock) HasTable(tableId string) bool {
+	for _, id := range b.TableIds {
+		if id == tableId {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,61 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Channel is where a booking is made.
+type Channel string
+
+const (
+	// ChannelPublic is guests booking online through the public routes.
+	ChannelPublic Channel = "public"
+	// ChannelStaff is staff booking through the secure routes.
+	ChannelStaff Channel = "staff"
+)
+
+var (
+	// ErrBookingTooSoon is returned when a booking is made later than the minimum lead time allows.
+	ErrBookingTooSoon = errors.New("booking is too close to its start time")
+	// ErrBookingTooFarAhead is returned when a booking is made earlier than the maximum advance allows.
+	ErrBookingTooFarAhead = errors.New("booking is too far ahead")
+)
+
+// BookingWindow limits how soon before and how far ahead of its start time a booking may be made.
+// Zero limits are not enforced.
+type BookingWindow struct {
+	MinLeadTime time.Duration
+	MaxAdvance  time.Duration
+}
+
+// Check rejects a booking made at now for start when it falls outside the window.
+func (w BookingWindow) Check(now time.Time, start time.Time) error {
+	if w.MinLeadTime > 0 && start.Before(now.Add(w.MinLeadTime)) {
+		return fmt.Errorf("%w, bookings must be made at least %s ahead", ErrBookingTooSoon, formatPeriod(w.MinLeadTime))
+	}
+	if w.MaxAdvance > 0 && start.After(now.Add(w.MaxAdvance)) {
+		return fmt.Errorf("%w, bookings open %s ahead", ErrBookingTooFarAhead, formatPeriod(w.MaxAdvance))
+	}
+	return nil
+}
+
+// formatPeriod spells out d in the largest whole unit of days, hours or minutes.
+func formatPeriod(d time.Duration) string {
+	switch {
+	case d%(24*time.Hour) == 0:
+		return plural(int(d/(24*time.Hour)), "day")
+	case d%time.Hour == 0:
+		return plural(int(d/time.Hour), "hour")
+	default:
+		return plural(int(d/time.Minute), "minute")
+	}
+}
+
+func plural(n int, unit string) string {
+	if n == 1 {
+		return fmt.Sprintf("%d %s", n, unit)
+	}
+	return fmt.Sprintf("%d %ss", n, unit)
+}
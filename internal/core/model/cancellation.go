@@ -0,0 +1,62 @@
+package model
+
+import "time"
+
+// CancellationPolicy lets a reservation be cancelled free of charge until Cutoff before its start
+// time. Later cancellations forfeit a paid deposit when ForfeitDeposit is set, and are otherwise
+// charged Fee. A zero Cutoff lets every reservation be cancelled free of charge.
+type CancellationPolicy struct {
+	Cutoff time.Duration `json:"cutoff"`
+	// Fee is in the smallest unit of the venue's currency.
+	Fee            int  `json:"fee"`
+	ForfeitDeposit bool `json:"forfeit_deposit"`
+}
+
+// Cancellation records how the cancellation policy applied to a cancelled reservation.
+type Cancellation struct {
+	Policy      CancellationPolicy `json:"policy"`
+	CancelledAt time.Time          `json:"cancelled_at"`
+	// FreeUntil is the last moment the reservation could be cancelled free of charge; it is zero
+	// when the policy has no cutoff.
+	FreeUntil time.Time `json:"free_until"`
+	// Fee is what the guest owes for cancelling late. It is not charged through the payment gateway,
+	// since there is no payment method on file to charge, and is left to the venue to collect.
+	Fee              int  `json:"fee"`
+	DepositForfeited bool `json:"deposit_forfeited"`
+	// Waived is set when staff let a late cancellation off the charge it would have incurred.
+	Waived bool `json:"waived"`
+	// Refunded is the paid deposit returned through the payment gateway.
+	Refunded int `json:"refunded"`
+}
+
+// FeeApplies reports whether the guest is charged for the cancellation, by fee or forfeited deposit.
+func (c Cancellation) FeeApplies() bool {
+	return c.Fee > 0 || c.DepositForfeited
+}
+
+// Evaluate applies the policy to reservation being cancelled at now. Holds and bookings awaiting
+// their deposit are always released free of charge, and waive lets staff override the charge of a
+// late cancellation.
+func (p CancellationPolicy) Evaluate(reservation Reservation, now time.Time, waive bool) Cancellation {
+	cancellation := Cancellation{Policy: p, CancelledAt: now}
+	if p.Cutoff <= 0 {
+		return cancellation
+	}
+	cancellation.FreeUntil = reservation.StartTime.Add(-p.Cutoff)
+	if reservation.Status.IsAwaiting() || !now.After(cancellation.FreeUntil) {
+		return cancellation
+	}
+
+	switch {
+	case p.ForfeitDeposit && reservation.DepositStatus == DepositPaid:
+		cancellation.DepositForfeited = true
+	case p.Fee > 0:
+		cancellation.Fee = p.Fee
+	}
+	if waive && cancellation.FeeApplies() {
+		cancellation.Fee = 0
+		cancellation.DepositForfeited = false
+		cancellation.Waived = true
+	}
+	return cancellation
+}
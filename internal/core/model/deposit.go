@@ -0,0 +1,30 @@
+package model
+
+import (
+	"slices"
+	"time"
+)
+
+// DepositPolicy asks large parties, and every party on the listed dates such as public holidays, to
+// pay a deposit before their booking is confirmed.
+type DepositPolicy struct {
+	// MinPartySize of zero asks no party for a deposit because of its size.
+	MinPartySize int
+	// Dates are days in the restaurant's time zone, formatted as 2006-01-02.
+	Dates []string
+	// AmountPerGuest is in the smallest unit of the venue's currency; zero asks for no deposit.
+	AmountPerGuest int
+	// PaymentTimeout is how long a booking waits for its deposit before its tables are released.
+	PaymentTimeout time.Duration
+}
+
+// Amount returns the deposit a party booking for start must pay, or zero when none is required.
+func (p DepositPolicy) Amount(partySize int, start time.Time, location *time.Location) int {
+	if p.AmountPerGuest <= 0 {
+		return 0
+	}
+	if (p.MinPartySize > 0 && partySize >= p.MinPartySize) || slices.Contains(p.Dates, start.In(location).Format("2006-01-02")) {
+		return p.AmountPerGuest * partySize
+	}
+	return 0
+}
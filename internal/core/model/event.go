@@ -1,9 +1,32 @@
 package model
 
+import "time"
+
 type EventRequest struct {
-	Id        string
-	Action    string
-	NumTables int
-	ResID     string
-	Response  chan interface{}
+	Id           string
+	Action       string
+	RestaurantId string
+	Tables       []Table
+	PartySize    int
+	Guest        Guest
+	Requirements Seating
+	Preferences  Seating
+	ResID        string
+	WaitlistId   string
+	StartTime    time.Time
+	Duration     time.Duration
+	// Slots are the start times checked by an availability search, or the occurrences of a
+	// recurring reservation.
+	Slots    []time.Time
+	SeriesId string
+	// Block is the table block to add, or the one to lift by id.
+	Block         TableBlock
+	DepositStatus DepositStatus
+	// Channel is where a booking is made; staff is assumed when it is empty.
+	Channel Channel
+	// WaiveFee lets staff cancel a reservation without the charge the cancellation policy applies.
+	WaiveFee bool
+	// PaymentToken stands for the payment method a deposit is paid with.
+	PaymentToken string
+	Response     chan interface{}
 }
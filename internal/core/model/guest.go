@@ -0,0 +1,9 @@
+package model
+
+// Guest is the person a reservation is held for, so staff can reach them about the booking.
+type Guest struct {
+	Name  string `json:"name"`
+	Phone string `json:"phone"`
+	Email string `json:"email"`
+	Notes string `json:"notes"`
+}
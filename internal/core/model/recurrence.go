@@ -0,0 +1,60 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// MaxOccurrences caps how many reservations a recurring booking expands into.
+const MaxOccurrences = 52
+
+// Recurrence repeats a reservation every IntervalWeeks weeks at the same time of day.
+type Recurrence struct {
+	// IntervalWeeks defaults to 1, repeating the reservation weekly.
+	IntervalWeeks int
+	// Until is the last day an occurrence may fall on and Count the number of occurrences. At least
+	// one must be given; when both are, the series stops at whichever comes first.
+	Until time.Time
+	Count int
+}
+
+// Occurrences expands the recurrence into the start times of its occurrences, the first being start.
+func (r Recurrence) Occurrences(start time.Time) ([]time.Time, error) {
+	if r.IntervalWeeks < 0 {
+		return nil, errors.New("recurrence interval must not be negative")
+	}
+	if r.Count < 0 {
+		return nil, errors.New("recurrence count must not be negative")
+	}
+	if r.Count == 0 && r.Until.IsZero() {
+		return nil, errors.New("recurrence needs an end date or an occurrence count")
+	}
+	interval := r.IntervalWeeks
+	if interval == 0 {
+		interval = 1
+	}
+
+	// Occurrences may start at any time on the Until day.
+	var last time.Time
+	if !r.Until.IsZero() {
+		last = time.Date(r.Until.Year(), r.Until.Month(), r.Until.Day()+1, 0, 0, 0, 0, start.Location())
+		if !start.Before(last) {
+			return nil, errors.New("recurrence must not end before its first occurrence")
+		}
+	}
+
+	occurrences := make([]time.Time, 0)
+	for i := 0; r.Count == 0 || i < r.Count; i++ {
+		// AddDate keeps the time of day across daylight saving changes.
+		occurrence := start.AddDate(0, 0, 7*interval*i)
+		if !last.IsZero() && !occurrence.Before(last) {
+			break
+		}
+		if len(occurrences) == MaxOccurrences {
+			return nil, fmt.Errorf("recurrence must not exceed %d occurrences", MaxOccurrences)
+		}
+		occurrences = append(occurrences, occurrence)
+	}
+	return occurrences, nil
+}
@@ -1,6 +1,159 @@
 package model
 
+import (
+	"fmt"
+	"time"
+)
+
+type ReservationStatus string
+
+const (
+	ReservationHeld ReservationStatus = "held"
+	// ReservationPendingPayment holds the tables of a booking until its deposit is paid.
+	ReservationPendingPayment ReservationStatus = "pending_payment"
+	ReservationConfirmed      ReservationStatus = "confirmed"
+	ReservationSeated         ReservationStatus = "seated"
+	ReservationCompleted      ReservationStatus = "completed"
+	ReservationNoShow         ReservationStatus = "no_show"
+	ReservationCancelled      ReservationStatus = "cancelled"
+	ReservationExpired        ReservationStatus = "expired"
+	// ReservationPaymentFailed gave its tables back after the deposit payment was declined.
+	ReservationPaymentFailed ReservationStatus = "payment_failed"
+)
+
+type ReservationType string
+
+const (
+	ReservationTypeTable ReservationType = "table"
+	// ReservationTypeEvent books a whole section, or the whole venue, for a private event.
+	ReservationTypeEvent ReservationType = "event"
+)
+
+type DepositStatus string
+
+const (
+	DepositNotRequired DepositStatus = "not_required"
+	DepositPending     DepositStatus = "pending"
+	DepositPaid        DepositStatus = "paid"
+	// DepositForfeited is kept by the venue when a booking is cancelled after the free cancellation
+	// cutoff; only the cancellation policy sets it.
+	DepositForfeited DepositStatus = "forfeited"
+	// DepositRefunded was paid through the payment gateway and returned on cancellation.
+	DepositRefunded DepositStatus = "refunded"
+)
+
+// IsDepositStatus reports whether status is one a deposit may be updated to.
+func IsDepositStatus(status DepositStatus) bool {
+	return status == DepositNotRequired || status == DepositPending || status == DepositPaid
+}
+
+// reservationTransitions lists the statuses each status may move to. Completed, no-show,
+// cancelled, expired and payment failed reservations are final. A hold or a confirmed reservation
+// moves to pending payment when it is confirmed or grown into needing a deposit.
+var reservationTransitions = map[ReservationStatus][]ReservationStatus{
+	ReservationHeld:           {ReservationConfirmed, ReservationPendingPayment, ReservationCancelled, ReservationExpired},
+	ReservationPendingPayment: {ReservationConfirmed, ReservationCancelled, ReservationExpired, ReservationPaymentFailed},
+	ReservationConfirmed:      {ReservationSeated, ReservationNoShow, ReservationCancelled, ReservationPendingPayment},
+	ReservationSeated:         {ReservationCompleted},
+}
+
+// CanTransitionTo reports whether a reservation in status s may move to next.
+func (s ReservationStatus) CanTransitionTo(next ReservationStatus) bool {
+	for _, allowed := range reservationTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// IsFinal reports whether the reservation no longer holds its tables.
+func (s ReservationStatus) IsFinal() bool {
+	return s == ReservationCompleted || s == ReservationNoShow || s == ReservationCancelled || s == ReservationExpired || s == ReservationPaymentFailed
+}
+
+// IsAwaiting reports whether the reservation's tables are only held until the guest confirms or
+// pays, and are given back when that does not happen in time.
+func (s ReservationStatus) IsAwaiting() bool {
+	return s == ReservationHeld || s == ReservationPendingPayment
+}
+
+// Transition moves the reservation to next, rejecting transitions the lifecycle does not allow.
+func (r *Reservation) Transition(next ReservationStatus) error {
+	if !r.Status.CanTransitionTo(next) {
+		return fmt.Errorf("cannot change reservation from %s to %s", r.Status, next)
+	}
+	r.Status = next
+	return nil
+}
+
 type Reservation struct {
-	Id        string `json:"id"`
-	NumTables int    `json:"num_tables"`
+	Id           string            `json:"id"`
+	RestaurantId string            `json:"restaurant_id"`
+	PartySize    int               `json:"party_size"`
+	Guest        Guest             `json:"guest"`
+	NumTables    int               `json:"num_tables"`
+	TableIds     []string          `json:"table_ids"`
+	StartTime    time.Time         `json:"start_time"`
+	Duration     time.Duration     `json:"duration"`
+	Status       ReservationStatus `json:"status"`
+	// HoldExpiresAt is when a held reservation gives its tables back unless it is confirmed first.
+	HoldExpiresAt time.Time `json:"hold_expires_at"`
+	// WalkIn marks a party seated at the host stand without booking ahead.
+	WalkIn bool `json:"walk_in"`
+	// Requirements are always met by the reservation's tables; Preferences are met when possible
+	// and UnmetPreferences lists the ones its tables do not meet.
+	Requirements     Seating  `json:"requirements"`
+	Preferences      Seating  `json:"preferences"`
+	UnmetPreferences []string `json:"unmet_preferences"`
+	// Type may be left empty for table bookings. Event bookings hold every table in the section
+	// given by Requirements, or in the whole venue, and PartySize is the expected headcount.
+	Type          ReservationType `json:"type"`
+	DepositStatus DepositStatus   `json:"deposit_status"`
+	// Deposit is the amount asked of the guest before the booking is confirmed, in the smallest
+	// unit of the venue's currency. PaymentId identifies the charge once the gateway has taken it.
+	Deposit      int       `json:"deposit"`
+	PaymentId    string    `json:"payment_id"`
+	PaymentDueAt time.Time `json:"payment_due_at"`
+	// SeriesId links the occurrences of a recurring reservation.
+	SeriesId string `json:"series_id"`
+	// Cancellation is set once the reservation is cancelled.
+	Cancellation *Cancellation `json:"cancellation"`
+	// Overbooked marks a reservation accepted beyond the free tables under the shift's overbooking
+	// allowance. It holds no tables; OverbookedTables is how many it would need, counted against it.
+	Overbooked       bool `json:"overbooked"`
+	OverbookedTables int  `json:"overbooked_tables"`
+}
+
+// IsEvent reports whether the reservation is a private event booking.
+func (r Reservation) IsEvent() bool {
+	return r.Type == ReservationTypeEvent
+}
+
+// BookingType returns the reservation's type, defaulting to a table booking.
+func (r Reservation) BookingType() ReservationType {
+	if r.Type == "" {
+		return ReservationTypeTable
+	}
+	return r.Type
+}
+
+// EndTime returns the moment the reserved tables become free again.
+func (r Reservation) EndTime() time.Time {
+	return r.StartTime.Add(r.Duration)
+}
+
+// Overlaps reports whether the reservation holds its tables at any point in [start, end).
+func (r Reservation) Overlaps(start time.Time, end time.Time) bool {
+	return r.StartTime.Before(end) && start.Before(r.EndTime())
+}
+
+// HasTable reports whether tableId is assigned to the reservation.
+func (r Reservation) HasTable(tableId string) bool {
+	for _, id := range r.TableIds {
+		if id == tableId {
+			return true
+		}
+	}
+	return false
 }
@@ -0,0 +1,6 @@
+package model
+
+type Restaurant struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+}
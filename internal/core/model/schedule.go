@@ -0,0 +1,154 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrRestaurantClosed is returned when a booking falls outside every shift or on a closure day.
+var ErrRestaurantClosed = errors.New("restaurant is closed at the requested time")
+
+// ErrPacingLimitReached is returned when a shift's pacing interval cannot take another arrival.
+var ErrPacingLimitReached = errors.New("pacing limit reached")
+
+// DefaultPacingInterval is used when a shift sets pacing limits without an interval.
+const DefaultPacingInterval = 15 * time.Minute
+
+// Shift is a weekly service period such as Monday lunch. Opens and Closes are offsets from
+// midnight in the restaurant's time zone; a shift that runs past midnight closes 24 hours or more
+// after the midnight of the day it opened.
+type Shift struct {
+	Name    string        `json:"name"`
+	Weekday time.Weekday  `json:"weekday"`
+	Opens   time.Duration `json:"opens"`
+	Closes  time.Duration `json:"closes"`
+	// MaxTables caps how many tables can be booked at once during the shift; zero means every table.
+	MaxTables   int         `json:"max_tables"`
+	Pacing      Pacing      `json:"pacing"`
+	Overbooking Overbooking `json:"overbooking"`
+}
+
+// Pacing limits how many guests and parties may arrive within each interval of a shift, however
+// many tables are free. Intervals start when the shift opens. Zero limits are not enforced.
+type Pacing struct {
+	Interval   time.Duration `json:"interval"`
+	MaxCovers  int           `json:"max_covers"`
+	MaxParties int           `json:"max_parties"`
+}
+
+// IsEmpty reports whether the pacing sets no limit.
+func (p Pacing) IsEmpty() bool {
+	return p.MaxCovers == 0 && p.MaxParties == 0
+}
+
+// Overbooking lets a shift accept reservations beyond its free tables to make up for no-shows,
+// either as a percentage of its capacity or as an absolute number of tables. Tables takes precedence
+// when both are set.
+type Overbooking struct {
+	Percent int `json:"percent"`
+	Tables  int `json:"tables"`
+}
+
+// IsEmpty reports whether the overbooking allows no extra tables.
+func (o Overbooking) IsEmpty() bool {
+	return o.Percent == 0 && o.Tables == 0
+}
+
+// OverbookingAllowance returns how many tables the shift may overbook in a restaurant with
+// totalTables tables. The percentage applies to the shift's table cap when it has one and is
+// rounded down.
+func (s Shift) OverbookingAllowance(totalTables int) int {
+	if s.Overbooking.Tables > 0 {
+		return s.Overbooking.Tables
+	}
+	capacity := totalTables
+	if s.MaxTables > 0 && s.MaxTables < totalTables {
+		capacity = s.MaxTables
+	}
+	return capacity * s.Overbooking.Percent / 100
+}
+
+// PacingWindow returns the pacing interval of the shift that an arrival at start counts towards.
+func (s Shift) PacingWindow(start time.Time, location *time.Location) (time.Time, time.Time) {
+	interval := s.Pacing.Interval
+	if interval <= 0 {
+		interval = DefaultPacingInterval
+	}
+	local := start.In(location)
+	opens := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, location).Add(s.Opens)
+	if opens.After(start) {
+		// The arrival is after midnight in a shift that opened the day before.
+		opens = time.Date(local.Year(), local.Month(), local.Day()-1, 0, 0, 0, 0, location).Add(s.Opens)
+	}
+	from := opens.Add(start.Sub(opens) / interval * interval)
+	return from, from.Add(interval)
+}
+
+// Closure is a day the restaurant does not open, such as a public holiday.
+type Closure struct {
+	// Date is the closed day in the restaurant's time zone, formatted as 2006-01-02.
+	Date   string `json:"date"`
+	Reason string `json:"reason"`
+}
+
+type Schedule struct {
+	RestaurantId string    `json:"restaurant_id"`
+	TimeZone     string    `json:"time_zone"`
+	Shifts       []Shift   `json:"shifts"`
+	Closures     []Closure `json:"closures"`
+}
+
+// Location returns the schedule's time zone, falling back to UTC when none is set.
+func (s Schedule) Location() (*time.Location, error) {
+	if s.TimeZone == "" {
+		return time.UTC, nil
+	}
+	return time.LoadLocation(s.TimeZone)
+}
+
+// ShiftFor returns the shift a booking from start to end falls entirely within. A booking after
+// midnight in a shift that opened the day before belongs to that day, closures included. A
+// schedule without shifts only applies its closures and returns a nil shift.
+func (s Schedule) ShiftFor(start time.Time, end time.Time) (*Shift, error) {
+	location, err := s.Location()
+	if err != nil {
+		return nil, err
+	}
+
+	local := start.In(location)
+	today := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, location)
+	if len(s.Shifts) == 0 {
+		return nil, s.closedOn(today)
+	}
+
+	for _, midnight := range []time.Time{today, today.AddDate(0, 0, -1)} {
+		opens, closes := start.Sub(midnight), end.Sub(midnight)
+		for i, shift := range s.Shifts {
+			if shift.Weekday == midnight.Weekday() && opens >= shift.Opens && closes <= shift.Closes {
+				if err := s.closedOn(midnight); err != nil {
+					return nil, err
+				}
+				return &s.Shifts[i], nil
+			}
+		}
+	}
+	if err := s.closedOn(today); err != nil {
+		return nil, err
+	}
+	return nil, ErrRestaurantClosed
+}
+
+// closedOn returns ErrRestaurantClosed, with the closure's reason, when the day is a closure day.
+func (s Schedule) closedOn(day time.Time) error {
+	date := day.Format("2006-01-02")
+	for _, closure := range s.Closures {
+		if closure.Date == date {
+			if closure.Reason == "" {
+				return ErrRestaurantClosed
+			}
+			return fmt.Errorf("%w: %s", ErrRestaurantClosed, closure.Reason)
+		}
+	}
+	return nil
+}
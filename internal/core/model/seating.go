@@ -0,0 +1,91 @@
+package model
+
+const (
+	SectionMainRoom    = "main_room"
+	SectionPatio       = "patio"
+	SectionBar         = "bar"
+	SectionPrivateRoom = "private_room"
+)
+
+const (
+	FeatureWheelchairAccessible = "wheelchair_accessible"
+	FeatureHighChair            = "high_chair"
+	FeatureWindow               = "window"
+)
+
+// IsSection reports whether section is one of the known table sections.
+func IsSection(section string) bool {
+	switch section {
+	case SectionMainRoom, SectionPatio, SectionBar, SectionPrivateRoom:
+		return true
+	}
+	return false
+}
+
+// IsFeature reports whether feature is one of the known table features.
+func IsFeature(feature string) bool {
+	switch feature {
+	case FeatureWheelchairAccessible, FeatureHighChair, FeatureWindow:
+		return true
+	}
+	return false
+}
+
+// Seating describes where a party sits: a section and the features every one of its tables has.
+// An empty Seating is met by any table.
+type Seating struct {
+	Section  string   `json:"section,omitempty"`
+	Features []string `json:"features,omitempty"`
+}
+
+func (s Seating) IsEmpty() bool {
+	return s.Section == "" && len(s.Features) == 0
+}
+
+// Matches reports whether the table is in the section and has every feature.
+func (s Seating) Matches(table Table) bool {
+	if s.Section != "" && table.Section != s.Section {
+		return false
+	}
+	for _, feature := range s.Features {
+		if !table.HasFeature(feature) {
+			return false
+		}
+	}
+	return true
+}
+
+// Filter returns the tables that match.
+func (s Seating) Filter(tables []Table) []Table {
+	matching := make([]Table, 0, len(tables))
+	for _, table := range tables {
+		if s.Matches(table) {
+			matching = append(matching, table)
+		}
+	}
+	return matching
+}
+
+// Unmet lists the section and features that not every one of the tables meets, or nil when they
+// meet them all.
+func (s Seating) Unmet(tables []Table) []string {
+	var unmet []string
+	if s.Section != "" && !(Seating{Section: s.Section}).matchesAll(tables) {
+		unmet = append(unmet, s.Section)
+	}
+	for _, feature := range s.Features {
+		if !(Seating{Features: []string{feature}}).matchesAll(tables) {
+			unmet = append(unmet, feature)
+		}
+	}
+	return unmet
+}
+
+func (s Seating) matchesAll(tables []Table) bool {
+	for _, table := range tables {
+		if !s.Matches(table) {
+			return false
+		}
+	}
+	return true
+}
@@ -1,7 +1,63 @@
 package model
 
 type Table struct {
-	TotalTables     int
-	AvailableTables int
-	Reservations    map[string]Reservation
+	Id             string   `json:"id"`
+	Label          string   `json:"label"`
+	Capacity       int      `json:"capacity"`
+	MinPartySize   int      `json:"min_party_size"`
+	CombinableWith []string `json:"combinable_with"`
+	Section        string   `json:"section"`
+	Features       []string `json:"features"`
+}
+
+// Seats reports whether a party of partySize can be seated at this table on its own.
+func (t Table) Seats(partySize int) bool {
+	return partySize >= t.MinPartySize && partySize <= t.Capacity
+}
+
+func (t Table) HasFeature(feature string) bool {
+	for _, f := range t.Features {
+		if f == feature {
+			return true
+		}
+	}
+	return false
+}
+
+// CanCombineWith reports whether the two tables can be pushed together.
+func (t Table) CanCombineWith(other Table) bool {
+	for _, id := range t.CombinableWith {
+		if id == other.Id {
+			return true
+		}
+	}
+	for _, id := range other.CombinableWith {
+		if id == t.Id {
+			return true
+		}
+	}
+	return false
+}
+
+// Combinable reports whether the tables form one group that can be pushed together, i.e. they
+// are connected in the combination graph.
+func Combinable(tables []Table) bool {
+	if len(tables) <= 1 {
+		return true
+	}
+
+	visited := map[string]bool{tables[0].Id: true}
+	queue := []Table{tables[0]}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, table := range tables {
+			if !visited[table.Id] && current.CanCombineWith(table) {
+				visited[table.Id] = true
+				queue = append(queue, table)
+			}
+		}
+	}
+
+	return len(visited) == len(tables)
 }
@@ -0,0 +1,34 @@
+package model
+
+import "time"
+
+type WaitlistStatus string
+
+const (
+	WaitlistWaiting  WaitlistStatus = "waiting"
+	WaitlistPromoted WaitlistStatus = "promoted"
+	WaitlistLeft     WaitlistStatus = "left"
+	// WaitlistExpired marks a party whose time slot started before tables freed up for them.
+	WaitlistExpired WaitlistStatus = "expired"
+)
+
+// WaitlistEntry is a party waiting for tables to free up in the time slot they asked for.
+type WaitlistEntry struct {
+	Id            string         `json:"id"`
+	RestaurantId  string         `json:"restaurant_id"`
+	PartySize     int            `json:"party_size"`
+	Guest         Guest          `json:"guest"`
+	StartTime     time.Time      `json:"start_time"`
+	Duration      time.Duration  `json:"duration"`
+	Status        WaitlistStatus `json:"status"`
+	ReservationId string         `json:"reservation_id"`
+	Requirements  Seating        `json:"requirements"`
+	Preferences   Seating        `json:"preferences"`
+	// Channel is where the party joined from; its booking window still applies when it is promoted.
+	Channel Channel `json:"channel"`
+}
+
+// EndTime returns the end of the time slot the party is waiting for.
+func (w WaitlistEntry) EndTime() time.Time {
+	return w.StartTime.Add(w.Duration)
+}
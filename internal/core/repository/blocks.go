@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"github.com/bossncn/restaurant-reservation-service/internal/core/model"
+	"time"
+)
+
+type BlockRepository interface {
+	AddBlock(block model.TableBlock) *model.TableBlock
+	RemoveBlock(restaurantId string, id string) (*model.TableBlock, error)
+	// BlocksBetween returns the blocks in force at any point in [start, end), ordered by start time.
+	BlocksBetween(restaurantId string, start time.Time, end time.Time) []model.TableBlock
+}
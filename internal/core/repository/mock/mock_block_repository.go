@@ -0,0 +1,85 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/core/repository/blocks.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/core/repository/blocks.go -destination=internal/core/repository/mock/mock_block_repository.go
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	reflect "reflect"
+	time "time"
+
+	model "github.com/bossncn/restaurant-reservation-service/internal/core/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockBlockRepository is a mock of BlockRepository interface.
+type MockBlockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockBlockRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockBlockRepositoryMockRecorder is the mock recorder for MockBlockRepository.
+type MockBlockRepositoryMockRecorder struct {
+	mock *MockBlockRepository
+}
+
+// NewMockBlockRepository creates a new mock instance.
+func NewMockBlockRepository(ctrl *gomock.Controller) *MockBlockRepository {
+	mock := &MockBlockRepository{ctrl: ctrl}
+	mock.recorder = &MockBlockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBlockRepository) EXPECT() *MockBlockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddBlock mocks base method.
+func (m *MockBlockRepository) AddBlock(block model.TableBlock) *model.TableBlock {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddBlock", block)
+	ret0, _ := ret[0].(*model.TableBlock)
+	return ret0
+}
+
+// AddBlock indicates an expected call of AddBlock.
+func (mr *MockBlockRepositoryMockRecorder) AddBlock(block any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBlock", reflect.TypeOf((*MockBlockRepository)(nil).AddBlock), block)
+}
+
+// BlocksBetween mocks base method.
+func (m *MockBlockRepository) BlocksBetween(restaurantId string, start, end time.Time) []model.TableBlock {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlocksBetween", restaurantId, start, end)
+	ret0, _ := ret[0].([]model.TableBlock)
+	return ret0
+}
+
+// BlocksBetween indicates an expected call of BlocksBetween.
+func (mr *MockBlockRepositoryMockRecorder) BlocksBetween(restaurantId, start, end any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlocksBetween", reflect.TypeOf((*MockBlockRepository)(nil).BlocksBetween), restaurantId, start, end)
+}
+
+// RemoveBlock mocks base method.
+func (m *MockBlockRepository) RemoveBlock(restaurantId, id string) (*model.TableBlock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveBlock", restaurantId, id)
+	ret0, _ := ret[0].(*model.TableBlock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveBlock indicates an expected call of RemoveBlock.
+func (mr *MockBlockRepositoryMockRecorder) RemoveBlock(restaurantId, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveBlock", reflect.TypeOf((*MockBlockRepository)(nil).RemoveBlock), restaurantId, id)
+}
@@ -40,45 +40,59 @@ func (m *MockReservationRepository) EXPECT() *MockReservationRepositoryMockRecor
 	return m.recorder
 }
 
-// CancelReservation mocks base method.
-func (m *MockReservationRepository) CancelReservation(reservationID string) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CancelReservation", reservationID)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// CancelReservation indicates an expected call of CancelReservation.
-func (mr *MockReservationRepositoryMockRecorder) CancelReservation(reservationID any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelReservation", reflect.TypeOf((*MockReservationRepository)(nil).CancelReservation), reservationID)
-}
-
 // CreateReservation mocks base method.
-func (m *MockReservationRepository) CreateReservation(numTables int) *model.Reservation {
+func (m *MockReservationRepository) CreateReservation(reservation model.Reservation) *model.Reservation {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateReservation", numTables)
+	ret := m.ctrl.Call(m, "CreateReservation", reservation)
 	ret0, _ := ret[0].(*model.Reservation)
 	return ret0
 }
 
 // CreateReservation indicates an expected call of CreateReservation.
-func (mr *MockReservationRepositoryMockRecorder) CreateReservation(numTables any) *gomock.Call {
+func (mr *MockReservationRepositoryMockRecorder) CreateReservation(reservation any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReservation", reflect.TypeOf((*MockReservationRepository)(nil).CreateReservation), numTables)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReservation", reflect.TypeOf((*MockReservationRepository)(nil).CreateReservation), reservation)
 }
 
 // FindReservationById mocks base method.
-func (m *MockReservationRepository) FindReservationById(id string) (*model.Reservation, error) {
+func (m *MockReservationRepository) FindReservationById(restaurantId, id string) (*model.Reservation, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindReservationById", id)
+	ret := m.ctrl.Call(m, "FindReservationById", restaurantId, id)
 	ret0, _ := ret[0].(*model.Reservation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindReservationById indicates an expected call of FindReservationById.
-func (mr *MockReservationRepositoryMockRecorder) FindReservationById(id any) *gomock.Call {
+func (mr *MockReservationRepositoryMockRecorder) FindReservationById(restaurantId, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindReservationById", reflect.TypeOf((*MockReservationRepository)(nil).FindReservationById), restaurantId, id)
+}
+
+// FindReservationsBySeriesId mocks base method.
+func (m *MockReservationRepository) FindReservationsBySeriesId(restaurantId, seriesId string) []model.Reservation {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindReservationsBySeriesId", restaurantId, seriesId)
+	ret0, _ := ret[0].([]model.Reservation)
+	return ret0
+}
+
+// FindReservationsBySeriesId indicates an expected call of FindReservationsBySeriesId.
+func (mr *MockReservationRepositoryMockRecorder) FindReservationsBySeriesId(restaurantId, seriesId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindReservationsBySeriesId", reflect.TypeOf((*MockReservationRepository)(nil).FindReservationsBySeriesId), restaurantId, seriesId)
+}
+
+// UpdateReservation mocks base method.
+func (m *MockReservationRepository) UpdateReservation(reservation model.Reservation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReservation", reservation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateReservation indicates an expected call of UpdateReservation.
+func (mr *MockReservationRepositoryMockRecorder) UpdateReservation(reservation any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindReservationById", reflect.TypeOf((*MockReservationRepository)(nil).FindReservationById), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReservation", reflect.TypeOf((*MockReservationRepository)(nil).UpdateReservation), reservation)
 }
@@ -0,0 +1,85 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/core/repository/restaurants.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/core/repository/restaurants.go -destination=internal/core/repository/mock/mock_restaurant_repository.go
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	reflect "reflect"
+
+	model "github.com/bossncn/restaurant-reservation-service/internal/core/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRestaurantRepository is a mock of RestaurantRepository interface.
+type MockRestaurantRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRestaurantRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRestaurantRepositoryMockRecorder is the mock recorder for MockRestaurantRepository.
+type MockRestaurantRepositoryMockRecorder struct {
+	mock *MockRestaurantRepository
+}
+
+// NewMockRestaurantRepository creates a new mock instance.
+func NewMockRestaurantRepository(ctrl *gomock.Controller) *MockRestaurantRepository {
+	mock := &MockRestaurantRepository{ctrl: ctrl}
+	mock.recorder = &MockRestaurantRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRestaurantRepository) EXPECT() *MockRestaurantRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateRestaurant mocks base method.
+func (m *MockRestaurantRepository) CreateRestaurant(restaurant model.Restaurant) (*model.Restaurant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRestaurant", restaurant)
+	ret0, _ := ret[0].(*model.Restaurant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRestaurant indicates an expected call of CreateRestaurant.
+func (mr *MockRestaurantRepositoryMockRecorder) CreateRestaurant(restaurant any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRestaurant", reflect.TypeOf((*MockRestaurantRepository)(nil).CreateRestaurant), restaurant)
+}
+
+// FindRestaurantById mocks base method.
+func (m *MockRestaurantRepository) FindRestaurantById(id string) (*model.Restaurant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindRestaurantById", id)
+	ret0, _ := ret[0].(*model.Restaurant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindRestaurantById indicates an expected call of FindRestaurantById.
+func (mr *MockRestaurantRepositoryMockRecorder) FindRestaurantById(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRestaurantById", reflect.TypeOf((*MockRestaurantRepository)(nil).FindRestaurantById), id)
+}
+
+// ListRestaurants mocks base method.
+func (m *MockRestaurantRepository) ListRestaurants() []model.Restaurant {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRestaurants")
+	ret0, _ := ret[0].([]model.Restaurant)
+	return ret0
+}
+
+// ListRestaurants indicates an expected call of ListRestaurants.
+func (mr *MockRestaurantRepositoryMockRecorder) ListRestaurants() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRestaurants", reflect.TypeOf((*MockRestaurantRepository)(nil).ListRestaurants))
+}
@@ -0,0 +1,70 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/core/repository/schedules.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/core/repository/schedules.go -destination=internal/core/repository/mock/mock_schedule_repository.go
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	reflect "reflect"
+
+	model "github.com/bossncn/restaurant-reservation-service/internal/core/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockScheduleRepository is a mock of ScheduleRepository interface.
+type MockScheduleRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockScheduleRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockScheduleRepositoryMockRecorder is the mock recorder for MockScheduleRepository.
+type MockScheduleRepositoryMockRecorder struct {
+	mock *MockScheduleRepository
+}
+
+// NewMockScheduleRepository creates a new mock instance.
+func NewMockScheduleRepository(ctrl *gomock.Controller) *MockScheduleRepository {
+	mock := &MockScheduleRepository{ctrl: ctrl}
+	mock.recorder = &MockScheduleRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockScheduleRepository) EXPECT() *MockScheduleRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindScheduleByRestaurantId mocks base method.
+func (m *MockScheduleRepository) FindScheduleByRestaurantId(restaurantId string) (*model.Schedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindScheduleByRestaurantId", restaurantId)
+	ret0, _ := ret[0].(*model.Schedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindScheduleByRestaurantId indicates an expected call of FindScheduleByRestaurantId.
+func (mr *MockScheduleRepositoryMockRecorder) FindScheduleByRestaurantId(restaurantId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindScheduleByRestaurantId", reflect.TypeOf((*MockScheduleRepository)(nil).FindScheduleByRestaurantId), restaurantId)
+}
+
+// SaveSchedule mocks base method.
+func (m *MockScheduleRepository) SaveSchedule(schedule model.Schedule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveSchedule", schedule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveSchedule indicates an expected call of SaveSchedule.
+func (mr *MockScheduleRepositoryMockRecorder) SaveSchedule(schedule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveSchedule", reflect.TypeOf((*MockScheduleRepository)(nil).SaveSchedule), schedule)
+}
@@ -11,6 +11,7 @@ package mock_repository
 
 import (
 	reflect "reflect"
+	time "time"
 
 	model "github.com/bossncn/restaurant-reservation-service/internal/core/model"
 	gomock "go.uber.org/mock/gomock"
@@ -41,59 +42,101 @@ func (m *MockTableRepository) EXPECT() *MockTableRepositoryMockRecorder {
 }
 
 // AvailableTables mocks base method.
-func (m *MockTableRepository) AvailableTables() int {
+func (m *MockTableRepository) AvailableTables(restaurantId string, start, end time.Time) int {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AvailableTables")
+	ret := m.ctrl.Call(m, "AvailableTables", restaurantId, start, end)
 	ret0, _ := ret[0].(int)
 	return ret0
 }
 
 // AvailableTables indicates an expected call of AvailableTables.
-func (mr *MockTableRepositoryMockRecorder) AvailableTables() *gomock.Call {
+func (mr *MockTableRepositoryMockRecorder) AvailableTables(restaurantId, start, end any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AvailableTables", reflect.TypeOf((*MockTableRepository)(nil).AvailableTables))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AvailableTables", reflect.TypeOf((*MockTableRepository)(nil).AvailableTables), restaurantId, start, end)
 }
 
 // CancelReservedTable mocks base method.
-func (m *MockTableRepository) CancelReservedTable(reservationId string) error {
+func (m *MockTableRepository) CancelReservedTable(restaurantId, reservationId string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CancelReservedTable", reservationId)
+	ret := m.ctrl.Call(m, "CancelReservedTable", restaurantId, reservationId)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CancelReservedTable indicates an expected call of CancelReservedTable.
-func (mr *MockTableRepositoryMockRecorder) CancelReservedTable(reservationId any) *gomock.Call {
+func (mr *MockTableRepositoryMockRecorder) CancelReservedTable(restaurantId, reservationId any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelReservedTable", reflect.TypeOf((*MockTableRepository)(nil).CancelReservedTable), reservationId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelReservedTable", reflect.TypeOf((*MockTableRepository)(nil).CancelReservedTable), restaurantId, reservationId)
+}
+
+// FreeTables mocks base method.
+func (m *MockTableRepository) FreeTables(restaurantId string, start, end time.Time) []model.Table {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FreeTables", restaurantId, start, end)
+	ret0, _ := ret[0].([]model.Table)
+	return ret0
+}
+
+// FreeTables indicates an expected call of FreeTables.
+func (mr *MockTableRepositoryMockRecorder) FreeTables(restaurantId, start, end any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FreeTables", reflect.TypeOf((*MockTableRepository)(nil).FreeTables), restaurantId, start, end)
 }
 
 // InitializeTables mocks base method.
-func (m *MockTableRepository) InitializeTables(numTables int) error {
+func (m *MockTableRepository) InitializeTables(restaurantId string, tables []model.Table) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "InitializeTables", numTables)
+	ret := m.ctrl.Call(m, "InitializeTables", restaurantId, tables)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // InitializeTables indicates an expected call of InitializeTables.
-func (mr *MockTableRepositoryMockRecorder) InitializeTables(numTables any) *gomock.Call {
+func (mr *MockTableRepositoryMockRecorder) InitializeTables(restaurantId, tables any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitializeTables", reflect.TypeOf((*MockTableRepository)(nil).InitializeTables), numTables)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitializeTables", reflect.TypeOf((*MockTableRepository)(nil).InitializeTables), restaurantId, tables)
 }
 
 // IsTableInitialized mocks base method.
-func (m *MockTableRepository) IsTableInitialized() bool {
+func (m *MockTableRepository) IsTableInitialized(restaurantId string) bool {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IsTableInitialized")
+	ret := m.ctrl.Call(m, "IsTableInitialized", restaurantId)
 	ret0, _ := ret[0].(bool)
 	return ret0
 }
 
 // IsTableInitialized indicates an expected call of IsTableInitialized.
-func (mr *MockTableRepositoryMockRecorder) IsTableInitialized() *gomock.Call {
+func (mr *MockTableRepositoryMockRecorder) IsTableInitialized(restaurantId any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsTableInitialized", reflect.TypeOf((*MockTableRepository)(nil).IsTableInitialized))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsTableInitialized", reflect.TypeOf((*MockTableRepository)(nil).IsTableInitialized), restaurantId)
+}
+
+// ReplaceTables mocks base method.
+func (m *MockTableRepository) ReplaceTables(restaurantId string, tables []model.Table) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceTables", restaurantId, tables)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceTables indicates an expected call of ReplaceTables.
+func (mr *MockTableRepositoryMockRecorder) ReplaceTables(restaurantId, tables any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceTables", reflect.TypeOf((*MockTableRepository)(nil).ReplaceTables), restaurantId, tables)
+}
+
+// ReservationsBetween mocks base method.
+func (m *MockTableRepository) ReservationsBetween(restaurantId string, start, end time.Time) []model.Reservation {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReservationsBetween", restaurantId, start, end)
+	ret0, _ := ret[0].([]model.Reservation)
+	return ret0
+}
+
+// ReservationsBetween indicates an expected call of ReservationsBetween.
+func (mr *MockTableRepositoryMockRecorder) ReservationsBetween(restaurantId, start, end any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReservationsBetween", reflect.TypeOf((*MockTableRepository)(nil).ReservationsBetween), restaurantId, start, end)
 }
 
 // ReserveTables mocks base method.
@@ -109,3 +152,31 @@ func (mr *MockTableRepositoryMockRecorder) ReserveTables(reservation any) *gomoc
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReserveTables", reflect.TypeOf((*MockTableRepository)(nil).ReserveTables), reservation)
 }
+
+// Tables mocks base method.
+func (m *MockTableRepository) Tables(restaurantId string) []model.Table {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Tables", restaurantId)
+	ret0, _ := ret[0].([]model.Table)
+	return ret0
+}
+
+// Tables indicates an expected call of Tables.
+func (mr *MockTableRepositoryMockRecorder) Tables(restaurantId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Tables", reflect.TypeOf((*MockTableRepository)(nil).Tables), restaurantId)
+}
+
+// TotalTables mocks base method.
+func (m *MockTableRepository) TotalTables(restaurantId string) int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TotalTables", restaurantId)
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// TotalTables indicates an expected call of TotalTables.
+func (mr *MockTableRepositoryMockRecorder) TotalTables(restaurantId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TotalTables", reflect.TypeOf((*MockTableRepository)(nil).TotalTables), restaurantId)
+}
@@ -0,0 +1,98 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/core/repository/waitlist.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/core/repository/waitlist.go -destination=internal/core/repository/mock/mock_waitlist_repository.go
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	reflect "reflect"
+
+	model "github.com/bossncn/restaurant-reservation-service/internal/core/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWaitlistRepository is a mock of WaitlistRepository interface.
+type MockWaitlistRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWaitlistRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockWaitlistRepositoryMockRecorder is the mock recorder for MockWaitlistRepository.
+type MockWaitlistRepositoryMockRecorder struct {
+	mock *MockWaitlistRepository
+}
+
+// NewMockWaitlistRepository creates a new mock instance.
+func NewMockWaitlistRepository(ctrl *gomock.Controller) *MockWaitlistRepository {
+	mock := &MockWaitlistRepository{ctrl: ctrl}
+	mock.recorder = &MockWaitlistRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWaitlistRepository) EXPECT() *MockWaitlistRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddEntry mocks base method.
+func (m *MockWaitlistRepository) AddEntry(entry model.WaitlistEntry) *model.WaitlistEntry {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddEntry", entry)
+	ret0, _ := ret[0].(*model.WaitlistEntry)
+	return ret0
+}
+
+// AddEntry indicates an expected call of AddEntry.
+func (mr *MockWaitlistRepositoryMockRecorder) AddEntry(entry any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEntry", reflect.TypeOf((*MockWaitlistRepository)(nil).AddEntry), entry)
+}
+
+// FindEntryById mocks base method.
+func (m *MockWaitlistRepository) FindEntryById(restaurantId, id string) (*model.WaitlistEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindEntryById", restaurantId, id)
+	ret0, _ := ret[0].(*model.WaitlistEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindEntryById indicates an expected call of FindEntryById.
+func (mr *MockWaitlistRepositoryMockRecorder) FindEntryById(restaurantId, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindEntryById", reflect.TypeOf((*MockWaitlistRepository)(nil).FindEntryById), restaurantId, id)
+}
+
+// UpdateEntry mocks base method.
+func (m *MockWaitlistRepository) UpdateEntry(entry model.WaitlistEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEntry", entry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEntry indicates an expected call of UpdateEntry.
+func (mr *MockWaitlistRepositoryMockRecorder) UpdateEntry(entry any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEntry", reflect.TypeOf((*MockWaitlistRepository)(nil).UpdateEntry), entry)
+}
+
+// WaitingEntries mocks base method.
+func (m *MockWaitlistRepository) WaitingEntries(restaurantId string) []model.WaitlistEntry {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitingEntries", restaurantId)
+	ret0, _ := ret[0].([]model.WaitlistEntry)
+	return ret0
+}
+
+// WaitingEntries indicates an expected call of WaitingEntries.
+func (mr *MockWaitlistRepositoryMockRecorder) WaitingEntries(restaurantId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitingEntries", reflect.TypeOf((*MockWaitlistRepository)(nil).WaitingEntries), restaurantId)
+}
@@ -3,7 +3,9 @@ package repository
 import "github.com/bossncn/restaurant-reservation-service/internal/core/model"
 
 type ReservationRepository interface {
-	CreateReservation(numTables int) *model.Reservation
-	FindReservationById(id string) (*model.Reservation, error)
-	CancelReservation(reservationID string) error
+	CreateReservation(reservation model.Reservation) *model.Reservation
+	FindReservationById(restaurantId string, id string) (*model.Reservation, error)
+	UpdateReservation(reservation model.Reservation) error
+	// FindReservationsBySeriesId returns the occurrences of a recurring reservation by start time.
+	FindReservationsBySeriesId(restaurantId string, seriesId string) []model.Reservation
 }
@@ -0,0 +1,9 @@
+package repository
+
+import "github.com/bossncn/restaurant-reservation-service/internal/core/model"
+
+type RestaurantRepository interface {
+	CreateRestaurant(restaurant model.Restaurant) (*model.Restaurant, error)
+	FindRestaurantById(id string) (*model.Restaurant, error)
+	ListRestaurants() []model.Restaurant
+}
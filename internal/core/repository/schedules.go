@@ -0,0 +1,8 @@
+package repository
+
+import "github.com/bossncn/restaurant-reservation-service/internal/core/model"
+
+type ScheduleRepository interface {
+	SaveSchedule(schedule model.Schedule) error
+	FindScheduleByRestaurantId(restaurantId string) (*model.Schedule, error)
+}
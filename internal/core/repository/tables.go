@@ -1,11 +1,23 @@
 package repository
 
-import "github.com/bossncn/restaurant-reservation-service/internal/core/model"
+import (
+	"github.com/bossncn/restaurant-reservation-service/internal/core/model"
+	"time"
+)
 
 type TableRepository interface {
-	InitializeTables(numTables int) error
+	InitializeTables(restaurantId string, tables []model.Table) error
+	// ReplaceTables swaps the inventory of an initialized restaurant, keeping its reservations.
+	ReplaceTables(restaurantId string, tables []model.Table) error
 	ReserveTables(reservation model.Reservation) error
-	CancelReservedTable(reservationId string) error
-	AvailableTables() int
-	IsTableInitialized() bool
+	CancelReservedTable(restaurantId string, reservationId string) error
+	Tables(restaurantId string) []model.Table
+	TotalTables(restaurantId string) int
+	// AvailableTables returns the number of tables not held by any reservation overlapping [start, end).
+	AvailableTables(restaurantId string, start time.Time, end time.Time) int
+	// FreeTables returns the tables not held by any reservation overlapping [start, end).
+	FreeTables(restaurantId string, start time.Time, end time.Time) []model.Table
+	// ReservationsBetween returns the reservations overlapping [start, end).
+	ReservationsBetween(restaurantId string, start time.Time, end time.Time) []model.Reservation
+	IsTableInitialized(restaurantId string) bool
 }
@@ -0,0 +1,11 @@
+package repository
+
+import "github.com/bossncn/restaurant-reservation-service/internal/core/model"
+
+type WaitlistRepository interface {
+	AddEntry(entry model.WaitlistEntry) *model.WaitlistEntry
+	FindEntryById(restaurantId string, id string) (*model.WaitlistEntry, error)
+	UpdateEntry(entry model.WaitlistEntry) error
+	// WaitingEntries returns the parties still waiting, in the order they joined.
+	WaitingEntries(restaurantId string) []model.WaitlistEntry
+}
@@ -0,0 +1,300 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"github.com/bossncn/restaurant-reservation-service/internal/core/model"
+	"sort"
+)
+
+const (
+	AllocatorBestFit         = "best-fit"
+	AllocatorBinPacking      = "bin-packing"
+	AllocatorCombineAdjacent = "combine-adjacent"
+)
+
+var ErrNotEnoughTables = errors.New("not enough tables available")
+
+// TableAllocator decides which of the free tables a party is seated at. When a party needs more
+// than one table, the tables returned are always a valid combination (see model.Combinable), and
+// never more than maxTables of them unless maxTables is zero.
+type TableAllocator interface {
+	Allocate(partySize int, free []model.Table, maxTables int) ([]model.Table, error)
+}
+
+func NewTableAllocator(strategy string) (TableAllocator, error) {
+	switch strategy {
+	case AllocatorBestFit, "":
+		return &BestFitAllocator{}, nil
+	case AllocatorBinPacking:
+		return &BinPackingAllocator{}, nil
+	case AllocatorCombineAdjacent:
+		return &CombineAdjacentAllocator{}, nil
+	default:
+		return nil, fmt.Errorf("unknown table allocator %q", strategy)
+	}
+}
+
+// BestFitAllocator seats the party at the smallest table that fits. When no single table is large
+// enough it starts from the largest free tables and keeps pushing the largest combinable neighbour
+// onto the group until every guest has a seat, keeping the first group with the fewest tables.
+type BestFitAllocator struct{}
+
+func (a *BestFitAllocator) Allocate(partySize int, free []model.Table, maxTables int) ([]model.Table, error) {
+	if table, ok := smallestFittingTable(partySize, free); ok {
+		return []model.Table{table}, nil
+	}
+
+	seeds := append([]model.Table{}, free...)
+	sort.SliceStable(seeds, func(i, j int) bool {
+		return seeds[i].Capacity > seeds[j].Capacity
+	})
+
+	var best []model.Table
+	for _, seed := range seeds {
+		group := []model.Table{seed}
+		seats := seed.Capacity
+		for seats < partySize && (maxTables == 0 || len(group) < maxTables) {
+			next, ok := largestNeighbour(group, free)
+			if !ok {
+				break
+			}
+			group = append(group, next)
+			seats += next.Capacity
+		}
+
+		// A single table that covers the party on its own was already rejected by its minimum party size.
+		if seats < partySize || len(group) < 2 {
+			continue
+		}
+		if best == nil || len(group) < len(best) {
+			best = group
+		}
+	}
+
+	if best == nil {
+		return nil, ErrNotEnoughTables
+	}
+
+	return best, nil
+}
+
+// BinPackingAllocator searches every valid combination of free tables for the one leaving the
+// fewest empty seats, preferring fewer tables when two combinations waste the same number of seats.
+type BinPackingAllocator struct{}
+
+func (a *BinPackingAllocator) Allocate(partySize int, free []model.Table, maxTables int) ([]model.Table, error) {
+	tables, _, err := searchCombinations(partySize, free, maxTables, fewestEmptySeats)
+	return tables, err
+}
+
+func fewestEmptySeats(tables int, waste int, bestTables int, bestWaste int) bool {
+	return waste < bestWaste || (waste == bestWaste && tables < bestTables)
+}
+
+// CombineAdjacentAllocator seats the party at a single table when one fits and otherwise pushes
+// together as few adjacent tables as possible, so staff have the least furniture to move.
+type CombineAdjacentAllocator struct{}
+
+func (a *CombineAdjacentAllocator) Allocate(partySize int, free []model.Table, maxTables int) ([]model.Table, error) {
+	if table, ok := smallestFittingTable(partySize, free); ok {
+		return []model.Table{table}, nil
+	}
+
+	tables, _, err := searchCombinations(partySize, free, maxTables, fewestTables)
+	return tables, err
+}
+
+func fewestTables(tables int, waste int, bestTables int, bestWaste int) bool {
+	return tables < bestTables || (tables == bestTables && waste < bestWaste)
+}
+
+// searchCombinations explores the groups of free tables that can be pushed together and seat the
+// party, and keeps the one ranked best by better. It also returns how many groups it grew. Only
+// connected groups are grown, each from its first table in capacity order, so every group is
+// visited once. Adding a table to a group that seats the party only adds tables and empty seats, so
+// a group stops growing as soon as it does. A group is also dropped when even its best completion
+// from the tables after its seed - the fewest of them, and the fewest empty seats their sums leave -
+// would take more than maxTables or not beat the best group found so far. Tables are tried largest
+// first, and between equally ranked groups the first found wins.
+func searchCombinations(partySize int, free []model.Table, maxTables int, better func(tables int, waste int, bestTables int, bestWaste int) bool) ([]model.Table, int, error) {
+	candidates := append([]model.Table{}, free...)
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Capacity > candidates[j].Capacity
+	})
+	if len(candidates) == 0 || candidates[0].Capacity <= 0 {
+		return nil, 0, ErrNotEnoughTables
+	}
+	largest := candidates[0].Capacity
+
+	var best []int
+	bestWaste := 0
+	expanded := 0
+
+	// fewestTables returns the fewest tables a group can grow to and seat the party, by adding the
+	// largest tables after its seed, and false when even all of them would leave guests standing.
+	fewestTables := func(group []int, seats int) (int, bool) {
+		tables := len(group)
+		for i := group[0] + 1; seats < partySize && i < len(candidates); i++ {
+			if !containsIndex(group, i) {
+				seats += candidates[i].Capacity
+				tables++
+			}
+		}
+		return tables, seats >= partySize
+	}
+	// leastWaste returns the fewest empty seats a group can be left with once grown to seat the
+	// party from the tables after its seed, and false when none of their sums seat it.
+	leastWaste := func(group []int, seats int) (int, bool) {
+		if seats >= partySize {
+			return seats - partySize, true
+		}
+		// A smallest sum covering need overshoots it by less than the largest table.
+		need := partySize - seats
+		reachable := make([]bool, need+largest)
+		reachable[0] = true
+		for i := group[0] + 1; i < len(candidates); i++ {
+			if containsIndex(group, i) {
+				continue
+			}
+			for sum := len(reachable) - 1; sum >= candidates[i].Capacity; sum-- {
+				reachable[sum] = reachable[sum] || reachable[sum-candidates[i].Capacity]
+			}
+		}
+		for sum := need; sum < len(reachable); sum++ {
+			if reachable[sum] {
+				return sum - need, true
+			}
+		}
+		return 0, false
+	}
+	// promising reports whether a group needing at least tables and leaving at least waste empty
+	// seats could still beat the best so far.
+	promising := func(tables int, waste int) bool {
+		if maxTables > 0 && tables > maxTables {
+			return false
+		}
+		return best == nil || better(tables, waste, len(best), bestWaste)
+	}
+
+	combines := make([][]bool, len(candidates))
+	for i := range candidates {
+		combines[i] = make([]bool, len(candidates))
+		for j := range candidates {
+			combines[i][j] = i != j && candidates[i].CanCombineWith(candidates[j])
+		}
+	}
+	// adjacent reports whether the table at index can be pushed onto any table of the group.
+	adjacent := func(group []int, index int) bool {
+		for _, member := range group {
+			if member == index || combines[member][index] {
+				return true
+			}
+		}
+		return false
+	}
+
+	// grow explores the group, whose last table was just added, and the groups grown from it. The
+	// group may grow by the tables inherited from the group it was grown from, and by the tables
+	// next to its last table that are not next to the rest of it.
+	var grow func(group []int, seats int, inherited []int)
+	grow = func(group []int, seats int, inherited []int) {
+		needed, ok := fewestTables(group, seats)
+		if !ok {
+			return
+		}
+		waste, ok := leastWaste(group, seats)
+		if !ok || !promising(needed, waste) {
+			return
+		}
+		expanded++
+		// A group of several tables is valid once connected, but a single table may still be
+		// below its minimum party size and need a neighbour.
+		if seats >= partySize && (len(group) > 1 || candidates[group[0]].Seats(partySize)) {
+			if best == nil || better(len(group), seats-partySize, len(best), bestWaste) {
+				best = append([]int{}, group...)
+				bestWaste = seats - partySize
+			}
+			return
+		}
+		last := group[len(group)-1]
+		extension := append([]int{}, inherited...)
+		for i := group[0] + 1; i < len(candidates); i++ {
+			if combines[last][i] && !adjacent(group[:len(group)-1], i) && !containsIndex(extension, i) {
+				extension = append(extension, i)
+			}
+		}
+		for len(extension) > 0 && promising(needed, waste) {
+			next := extension[0]
+			extension = extension[1:]
+			grow(append(append([]int{}, group...), next), seats+candidates[next].Capacity, extension)
+		}
+	}
+	for seed := range candidates {
+		grow([]int{seed}, candidates[seed].Capacity, nil)
+	}
+
+	if best == nil {
+		return nil, expanded, ErrNotEnoughTables
+	}
+
+	sort.Ints(best)
+	tables := make([]model.Table, 0, len(best))
+	for _, index := range best {
+		tables = append(tables, candidates[index])
+	}
+	return tables, expanded, nil
+}
+
+func containsIndex(indices []int, index int) bool {
+	for _, i := range indices {
+		if i == index {
+			return true
+		}
+	}
+	return false
+}
+
+func smallestFittingTable(partySize int, free []model.Table) (model.Table, bool) {
+	var best *model.Table
+	for i, table := range free {
+		if table.Seats(partySize) && (best == nil || table.Capacity < best.Capacity) {
+			best = &free[i]
+		}
+	}
+	if best == nil {
+		return model.Table{}, false
+	}
+	return *best, true
+}
+
+// largestNeighbour returns the largest free table that can be pushed onto the group.
+func largestNeighbour(group []model.Table, free []model.Table) (model.Table, bool) {
+	var best *model.Table
+	for i, table := range free {
+		if containsTable(group, table.Id) {
+			continue
+		}
+		for _, member := range group {
+			if member.CanCombineWith(table) {
+				if best == nil || table.Capacity > best.Capacity {
+					best = &free[i]
+				}
+				break
+			}
+		}
+	}
+	if best == nil {
+		return model.Table{}, false
+	}
+	return *best, true
+}
+
+func containsTable(tables []model.Table, id string) bool {
+	for _, table := range tables {
+		if table.Id == id {
+			return true
+		}
+	}
+	return false
+}
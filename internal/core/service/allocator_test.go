@@ -0,0 +1,286 @@
+package service_test
+
+import (
+	"github.com/bossncn/restaurant-reservation-service/internal/core/model"
+	"github.com/bossncn/restaurant-reservation-service/internal/core/service"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// seatParties allocates every party in order against a shared floor and reports the seated covers
+// and the seats of the tables they occupy.
+func seatParties(t *testing.T, allocator service.TableAllocator, tables []model.Table, parties []int) (int, int, int) {
+	t.Helper()
+
+	free := append([]model.Table{}, tables...)
+	covers, seats, turnedAway := 0, 0, 0
+	for _, party := range parties {
+		allocated, err := allocator.Allocate(party, free, 0)
+		if err != nil {
+			assert.ErrorIs(t, err, service.ErrNotEnoughTables)
+			turnedAway++
+			continue
+		}
+
+		covers += party
+		for _, table := range allocated {
+			seats += table.Capacity
+			for i := range free {
+				if free[i].Id == table.Id {
+					free = append(free[:i], free[i+1:]...)
+					break
+				}
+			}
+		}
+	}
+	return covers, seats, turnedAway
+}
+
+// combinableWithAll makes every table combinable with every other table.
+func combinableWithAll(tables []model.Table) []model.Table {
+	for i := range tables {
+		for _, other := range tables {
+			if other.Id != tables[i].Id {
+				tables[i].CombinableWith = append(tables[i].CombinableWith, other.Id)
+			}
+		}
+	}
+	return tables
+}
+
+func TestTableAllocator(t *testing.T) {
+	floor := combinableWithAll([]model.Table{
+		{Id: "T1", Capacity: 2, MinPartySize: 1},
+		{Id: "T2", Capacity: 2, MinPartySize: 1},
+		{Id: "T3", Capacity: 4, MinPartySize: 1},
+		{Id: "T4", Capacity: 4, MinPartySize: 1},
+		{Id: "T5", Capacity: 6, MinPartySize: 1},
+		{Id: "T6", Capacity: 8, MinPartySize: 1},
+	})
+	parties := []int{10, 6, 3, 2}
+
+	// A room where tables only combine along two walls: T1-T2-T3 and T4-T5.
+	room := []model.Table{
+		{Id: "T1", Capacity: 4, MinPartySize: 1, CombinableWith: []string{"T2"}},
+		{Id: "T2", Capacity: 2, MinPartySize: 1, CombinableWith: []string{"T1", "T3"}},
+		{Id: "T3", Capacity: 4, MinPartySize: 1, CombinableWith: []string{"T2"}},
+		{Id: "T4", Capacity: 6, MinPartySize: 1, CombinableWith: []string{"T5"}},
+		{Id: "T5", Capacity: 2, MinPartySize: 1, CombinableWith: []string{"T4"}},
+	}
+
+	t.Run("NewTableAllocator", func(t *testing.T) {
+		bestFit, err := service.NewTableAllocator(service.AllocatorBestFit)
+		assert.NoError(t, err)
+		assert.IsType(t, &service.BestFitAllocator{}, bestFit)
+
+		binPacking, err := service.NewTableAllocator(service.AllocatorBinPacking)
+		assert.NoError(t, err)
+		assert.IsType(t, &service.BinPackingAllocator{}, binPacking)
+
+		combineAdjacent, err := service.NewTableAllocator(service.AllocatorCombineAdjacent)
+		assert.NoError(t, err)
+		assert.IsType(t, &service.CombineAdjacentAllocator{}, combineAdjacent)
+
+		_, err = service.NewTableAllocator("random")
+		assert.EqualError(t, err, `unknown table allocator "random"`)
+	})
+	t.Run("BestFit", func(t *testing.T) {
+		allocator := &service.BestFitAllocator{}
+
+		t.Run("SmallestFittingTable", func(t *testing.T) {
+			tables, err := allocator.Allocate(3, floor, 0)
+
+			assert.NoError(t, err)
+			assert.Equal(t, []model.Table{floor[2]}, tables)
+		})
+		t.Run("RespectsMinPartySize", func(t *testing.T) {
+			tables, err := allocator.Allocate(1, []model.Table{{Id: "T1", Capacity: 6, MinPartySize: 3}}, 0)
+
+			assert.ErrorIs(t, err, service.ErrNotEnoughTables)
+			assert.Nil(t, tables)
+		})
+		t.Run("CombinesLargestTables", func(t *testing.T) {
+			tables, err := allocator.Allocate(10, floor, 0)
+
+			assert.NoError(t, err)
+			assert.Equal(t, []model.Table{floor[5], floor[4]}, tables)
+		})
+		t.Run("OnlyCombinesAdjacentTables", func(t *testing.T) {
+			tables, err := allocator.Allocate(10, room, 0)
+
+			assert.NoError(t, err)
+			assert.Equal(t, []model.Table{room[0], room[1], room[2]}, tables)
+			assert.True(t, model.Combinable(tables))
+		})
+		t.Run("NoValidCombination", func(t *testing.T) {
+			tables, err := allocator.Allocate(12, room, 0)
+
+			assert.ErrorIs(t, err, service.ErrNotEnoughTables)
+			assert.Nil(t, tables)
+		})
+		t.Run("SeatUtilisation", func(t *testing.T) {
+			covers, seats, turnedAway := seatParties(t, allocator, floor, parties)
+
+			// 10 -> 8+6, 6 -> 4+4, 3 -> 2+2, the party of 2 finds nothing left.
+			assert.Equal(t, 19, covers)
+			assert.Equal(t, 26, seats)
+			assert.Equal(t, 1, turnedAway)
+		})
+	})
+	t.Run("BinPacking", func(t *testing.T) {
+		allocator := &service.BinPackingAllocator{}
+
+		t.Run("PrefersSingleTable", func(t *testing.T) {
+			tables, err := allocator.Allocate(8, floor, 0)
+
+			assert.NoError(t, err)
+			assert.Equal(t, []model.Table{floor[5]}, tables)
+		})
+		t.Run("RespectsMinPartySize", func(t *testing.T) {
+			tables, err := allocator.Allocate(1, []model.Table{{Id: "T1", Capacity: 6, MinPartySize: 3}}, 0)
+
+			assert.ErrorIs(t, err, service.ErrNotEnoughTables)
+			assert.Nil(t, tables)
+		})
+		t.Run("CombinesWithoutWaste", func(t *testing.T) {
+			tables, err := allocator.Allocate(10, floor, 0)
+
+			assert.NoError(t, err)
+			assert.Equal(t, []model.Table{floor[5], floor[0]}, tables)
+		})
+		t.Run("NotEnoughSeats", func(t *testing.T) {
+			tables, err := allocator.Allocate(27, floor, 0)
+
+			assert.ErrorIs(t, err, service.ErrNotEnoughTables)
+			assert.Nil(t, tables)
+		})
+		t.Run("OnlyCombinesAdjacentTables", func(t *testing.T) {
+			// T1+T3 would seat 8 exactly but they are not next to each other, so T2 joins them.
+			tables, err := allocator.Allocate(8, room, 0)
+
+			assert.NoError(t, err)
+			assert.Equal(t, []model.Table{room[3], room[4]}, tables)
+
+			tables, err = allocator.Allocate(8, room[:3], 0)
+
+			assert.NoError(t, err)
+			assert.ElementsMatch(t, room[:3], tables)
+		})
+		t.Run("SeatUtilisation", func(t *testing.T) {
+			covers, seats, turnedAway := seatParties(t, allocator, floor, parties)
+
+			// 10 -> 8+2, 6 -> 6, 3 -> 4, 2 -> 2: every party is seated.
+			assert.Equal(t, 21, covers)
+			assert.Equal(t, 22, seats)
+			assert.Equal(t, 0, turnedAway)
+		})
+	})
+	t.Run("CombineAdjacent", func(t *testing.T) {
+		allocator := &service.CombineAdjacentAllocator{}
+
+		t.Run("SmallestFittingTable", func(t *testing.T) {
+			tables, err := allocator.Allocate(5, floor, 0)
+
+			assert.NoError(t, err)
+			assert.Equal(t, []model.Table{floor[4]}, tables)
+		})
+		t.Run("FewestTablesFirst", func(t *testing.T) {
+			// 4+2+4 wastes no seat but moves three tables; 6+2 moves two and wastes one.
+			tables, err := allocator.Allocate(7, room, 0)
+
+			assert.NoError(t, err)
+			assert.Equal(t, []model.Table{room[3], room[4]}, tables)
+		})
+		t.Run("NoValidCombination", func(t *testing.T) {
+			tables, err := allocator.Allocate(11, room, 0)
+
+			assert.ErrorIs(t, err, service.ErrNotEnoughTables)
+			assert.Nil(t, tables)
+		})
+		t.Run("SeatUtilisation", func(t *testing.T) {
+			covers, seats, turnedAway := seatParties(t, allocator, floor, parties)
+
+			// 10 -> 8+2, 6 -> 6, 3 -> 4, 2 -> 2: every party is seated.
+			assert.Equal(t, 21, covers)
+			assert.Equal(t, 22, seats)
+			assert.Equal(t, 0, turnedAway)
+		})
+	})
+	t.Run("LargeFloor", func(t *testing.T) {
+		// 32 four-tops that cannot be pushed together, and a row of 40 where each joins its neighbours.
+		separate := make([]model.Table, 0, 32)
+		for _, table := range service.UniformTables(32) {
+			table.CombinableWith = nil
+			separate = append(separate, table)
+		}
+		row := service.UniformTables(40)
+
+		for name, better := range map[string]func(int, int, int, int) bool{
+			"BinPacking":      service.FewestEmptySeats,
+			"CombineAdjacent": service.FewestTables,
+		} {
+			t.Run(name, func(t *testing.T) {
+				tables, expanded, err := service.SearchCombinations(6, separate, 0, better)
+				assert.ErrorIs(t, err, service.ErrNotEnoughTables)
+				assert.Nil(t, tables)
+				// Each table is tried on its own and none is grown.
+				assert.LessOrEqual(t, expanded, 32)
+
+				// The processor allocates inline, so the search must not grow with every subset of the floor.
+				tables, expanded, err = service.SearchCombinations(13, row, 0, better)
+				assert.NoError(t, err)
+				assert.Len(t, tables, 4)
+				assert.True(t, model.Combinable(tables))
+				assert.LessOrEqual(t, expanded, 10)
+			})
+		}
+	})
+	t.Run("DenseFloor", func(t *testing.T) {
+		// 24 four-tops that can all be pushed together: a party of 48 fills exactly 12 of them.
+		dense := combinableWithAll(service.UniformTables(24))
+
+		for name, better := range map[string]func(int, int, int, int) bool{
+			"BinPacking":      service.FewestEmptySeats,
+			"CombineAdjacent": service.FewestTables,
+		} {
+			t.Run(name, func(t *testing.T) {
+				tables, expanded, err := service.SearchCombinations(48, dense, 0, better)
+				assert.NoError(t, err)
+				assert.Len(t, tables, 12)
+				assert.True(t, model.Combinable(tables))
+				// Once an exact fit is found no other group can beat it, so nothing else is grown.
+				assert.LessOrEqual(t, expanded, 12)
+
+				// 45 guests still need 12 tables and leave 3 seats empty whichever are picked.
+				tables, expanded, err = service.SearchCombinations(45, dense, 0, better)
+				assert.NoError(t, err)
+				assert.Len(t, tables, 12)
+				assert.LessOrEqual(t, expanded, 12)
+
+				// Groups beyond the sizing policy's table limit are never built.
+				tables, expanded, err = service.SearchCombinations(48, dense, 6, better)
+				assert.ErrorIs(t, err, service.ErrNotEnoughTables)
+				assert.Nil(t, tables)
+				assert.Zero(t, expanded)
+			})
+		}
+	})
+	t.Run("MaxTables", func(t *testing.T) {
+		for name, allocator := range map[string]service.TableAllocator{
+			"BestFit":         &service.BestFitAllocator{},
+			"BinPacking":      &service.BinPackingAllocator{},
+			"CombineAdjacent": &service.CombineAdjacentAllocator{},
+		} {
+			t.Run(name, func(t *testing.T) {
+				// 10 guests need at least two tables of the floor.
+				tables, err := allocator.Allocate(10, floor, 1)
+				assert.ErrorIs(t, err, service.ErrNotEnoughTables)
+				assert.Nil(t, tables)
+
+				tables, err = allocator.Allocate(10, floor, 2)
+				assert.NoError(t, err)
+				assert.Len(t, tables, 2)
+			})
+		}
+	})
+}
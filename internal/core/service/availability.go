@@ -0,0 +1,105 @@
+package service
+
+import (
+	"errors"
+	"github.com/bossncn/restaurant-reservation-service/internal/core/model"
+	"github.com/bossncn/restaurant-reservation-service/internal/core/repository"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"sort"
+	"time"
+)
+
+// SlotInterval is the spacing between the start times offered by an availability search.
+const SlotInterval = 15 * time.Minute
+
+// MaxAlternatives is how many nearby times are suggested when the requested range is full.
+const MaxAlternatives = 3
+
+type AvailabilityService interface {
+	SearchAvailability(query model.AvailabilityQuery) (*model.Availability, error)
+}
+
+type AvailabilityServiceImpl struct {
+	scheduleRepo repository.ScheduleRepository
+	logger       *zap.Logger
+	requests     chan model.EventRequest
+}
+
+func NewAvailabilityService(scheduleRepo repository.ScheduleRepository, logger *zap.Logger, eventRequest *chan model.EventRequest) *AvailabilityServiceImpl {
+	return &AvailabilityServiceImpl{
+		scheduleRepo: scheduleRepo,
+		logger:       logger,
+		requests:     *eventRequest,
+	}
+}
+
+// SearchAvailability lists the start times in the requested range the party could book. Every
+// start time of the day is checked in one processor step, so the slots and the alternatives come
+// from the same view of the tables and nothing is booked.
+func (s *AvailabilityServiceImpl) SearchAvailability(query model.AvailabilityQuery) (*model.Availability, error) {
+	if query.PartySize <= 0 {
+		return nil, errors.New("number of customers must be greater than zero")
+	}
+	if query.Duration < 0 {
+		return nil, errors.New("duration must not be negative")
+	}
+	if query.From < 0 || query.To >= 24*time.Hour || query.From > query.To {
+		return nil, errors.New("from must not be after to")
+	}
+
+	location := time.UTC
+	if schedule, err := s.scheduleRepo.FindScheduleByRestaurantId(query.RestaurantId); err == nil {
+		if location, err = schedule.Location(); err != nil {
+			return nil, err
+		}
+	}
+	day, err := time.ParseInLocation("2006-01-02", query.Date, location)
+	if err != nil {
+		return nil, errors.New("date must be formatted as YYYY-MM-DD")
+	}
+
+	candidates := make([]time.Time, 0)
+	for offset := time.Duration(0); offset < 24*time.Hour; offset += SlotInterval {
+		candidates = append(candidates, day.Add(offset))
+	}
+
+	resp := make(chan interface{})
+	s.requests <- model.EventRequest{Id: (uuid.New()).String(), Action: "availability", RestaurantId: query.RestaurantId, PartySize: query.PartySize, Duration: query.Duration, Requirements: query.Requirements, Slots: candidates, Channel: query.Channel, Response: resp}
+	result := <-resp
+
+	if err, ok := result.(error); ok {
+		return nil, err
+	}
+
+	from, to := day.Add(query.From), day.Add(query.To)
+	availability := model.Availability{Slots: make([]model.Slot, 0), Alternatives: make([]model.Slot, 0)}
+	others := make([]model.Slot, 0)
+	for _, slot := range result.([]model.Slot) {
+		if !slot.StartTime.Before(from) && !slot.StartTime.After(to) {
+			availability.Slots = append(availability.Slots, slot)
+		} else {
+			others = append(others, slot)
+		}
+	}
+	if len(availability.Slots) == 0 {
+		availability.Alternatives = nearest(others, from, to, MaxAlternatives)
+	}
+	return &availability, nil
+}
+
+// nearest returns up to limit slots closest to the range [from, to], in time order.
+func nearest(slots []model.Slot, from time.Time, to time.Time, limit int) []model.Slot {
+	distance := func(slot model.Slot) time.Duration {
+		if slot.StartTime.Before(from) {
+			return from.Sub(slot.StartTime)
+		}
+		return slot.StartTime.Sub(to)
+	}
+	sort.SliceStable(slots, func(i, j int) bool { return distance(slots[i]) < distance(slots[j]) })
+	if len(slots) > limit {
+		slots = slots[:limit]
+	}
+	sort.Slice(slots, func(i, j int) bool { return slots[i].StartTime.Before(slots[j].StartTime) })
+	return slots
+}
@@ -0,0 +1,124 @@
+package service_test
+
+import (
+	"errors"
+	"github.com/bossncn/restaurant-reservation-service/internal/core/model"
+	mockRepository "github.com/bossncn/restaurant-reservation-service/internal/core/repository/mock"
+	"github.com/bossncn/restaurant-reservation-service/internal/core/service"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"testing"
+	"time"
+)
+
+func TestAvailabilityService(t *testing.T) {
+	// bookable mocks the event processor, offering the given local times of day.
+	bookable := func(eventRequest chan model.EventRequest, times ...time.Duration) {
+		for req := range eventRequest {
+			if req.Action != "availability" {
+				continue
+			}
+			slots := make([]model.Slot, 0)
+			for _, start := range req.Slots {
+				midnight := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, start.Location())
+				for _, offset := range times {
+					if start.Sub(midnight) == offset {
+						slots = append(slots, model.Slot{StartTime: start, EndTime: start.Add(2 * time.Hour), TableIds: []string{"T1"}})
+					}
+				}
+			}
+			req.Response <- slots
+		}
+	}
+
+	t.Run("SearchAvailability", func(t *testing.T) {
+		t.Run("Success", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockRepository.NewMockScheduleRepository(ctrl)
+			eventRequest := make(chan model.EventRequest, 100)
+			logger := zap.NewNop()
+			svc := service.NewAvailabilityService(mockRepo, logger, &eventRequest)
+
+			mockRepo.EXPECT().FindScheduleByRestaurantId("r1").Return(nil, errors.New("schedule not found")).Times(1)
+			go bookable(eventRequest, 18*time.Hour, 19*time.Hour, 21*time.Hour)
+
+			availability, err := svc.SearchAvailability(model.AvailabilityQuery{RestaurantId: "r1", PartySize: 2, Date: "2025-01-10", From: 18 * time.Hour, To: 20 * time.Hour})
+
+			assert.NoError(t, err)
+			assert.Len(t, availability.Slots, 2)
+			assert.Equal(t, time.Date(2025, 1, 10, 18, 0, 0, 0, time.UTC), availability.Slots[0].StartTime)
+			assert.Equal(t, time.Date(2025, 1, 10, 19, 0, 0, 0, time.UTC), availability.Slots[1].StartTime)
+			assert.Empty(t, availability.Alternatives)
+		})
+		t.Run("Alternatives", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockRepository.NewMockScheduleRepository(ctrl)
+			eventRequest := make(chan model.EventRequest, 100)
+			logger := zap.NewNop()
+			svc := service.NewAvailabilityService(mockRepo, logger, &eventRequest)
+
+			mockRepo.EXPECT().FindScheduleByRestaurantId("r1").Return(&model.Schedule{RestaurantId: "r1", TimeZone: "Asia/Bangkok"}, nil).Times(1)
+			go bookable(eventRequest, 12*time.Hour, 17*time.Hour+30*time.Minute, 18*time.Hour+15*time.Minute, 20*time.Hour, 22*time.Hour)
+
+			availability, err := svc.SearchAvailability(model.AvailabilityQuery{RestaurantId: "r1", PartySize: 2, Date: "2025-01-10", From: 19 * time.Hour, To: 19 * time.Hour})
+
+			assert.NoError(t, err)
+			assert.Empty(t, availability.Slots)
+			bangkok, _ := time.LoadLocation("Asia/Bangkok")
+			assert.Len(t, availability.Alternatives, 3)
+			assert.True(t, availability.Alternatives[0].StartTime.Equal(time.Date(2025, 1, 10, 17, 30, 0, 0, bangkok)))
+			assert.True(t, availability.Alternatives[1].StartTime.Equal(time.Date(2025, 1, 10, 18, 15, 0, 0, bangkok)))
+			assert.True(t, availability.Alternatives[2].StartTime.Equal(time.Date(2025, 1, 10, 20, 0, 0, 0, bangkok)))
+		})
+		t.Run("ProcessorError", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockRepository.NewMockScheduleRepository(ctrl)
+			eventRequest := make(chan model.EventRequest, 100)
+			logger := zap.NewNop()
+			svc := service.NewAvailabilityService(mockRepo, logger, &eventRequest)
+
+			mockRepo.EXPECT().FindScheduleByRestaurantId("r1").Return(nil, errors.New("schedule not found")).Times(1)
+			go func() {
+				for req := range eventRequest {
+					req.Response <- errors.New("tables has not been initialized")
+				}
+			}()
+
+			_, err := svc.SearchAvailability(model.AvailabilityQuery{RestaurantId: "r1", PartySize: 2, Date: "2025-01-10"})
+
+			assert.EqualError(t, err, "tables has not been initialized")
+		})
+		t.Run("Invalid", func(t *testing.T) {
+			tests := []struct {
+				name  string
+				query model.AvailabilityQuery
+				err   string
+			}{
+				{"PartySize", model.AvailabilityQuery{RestaurantId: "r1", Date: "2025-01-10"}, "number of customers must be greater than zero"},
+				{"Duration", model.AvailabilityQuery{RestaurantId: "r1", PartySize: 2, Date: "2025-01-10", Duration: -time.Minute}, "duration must not be negative"},
+				{"Range", model.AvailabilityQuery{RestaurantId: "r1", PartySize: 2, Date: "2025-01-10", From: 20 * time.Hour, To: 18 * time.Hour}, "from must not be after to"},
+			}
+			for _, tt := range tests {
+				t.Run(tt.name, func(t *testing.T) {
+					ctrl := gomock.NewController(t)
+					defer ctrl.Finish()
+
+					mockRepo := mockRepository.NewMockScheduleRepository(ctrl)
+					eventRequest := make(chan model.EventRequest, 100)
+					svc := service.NewAvailabilityService(mockRepo, zap.NewNop(), &eventRequest)
+
+					_, err := svc.SearchAvailability(tt.query)
+
+					assert.EqualError(t, err, tt.err)
+				})
+			}
+		})
+	})
+}
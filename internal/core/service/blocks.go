@@ -0,0 +1,67 @@
+package service
+
+import (
+	"errors"
+	"github.com/bossncn/restaurant-reservation-service/internal/core/model"
+	"github.com/bossncn/restaurant-reservation-service/internal/core/repository"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"sync"
+	"time"
+)
+
+type BlockService interface {
+	// BlockTables takes tables out of service from start, or from now when start is zero, until end,
+	// or until the block is lifted when end is zero.
+	BlockTables(restaurantId string, tableIds []string, start time.Time, end time.Time, reason string) (*model.TableBlock, error)
+	UnblockTables(restaurantId string, id string) (*model.TableBlock, error)
+	BlocksBetween(restaurantId string, start time.Time, end time.Time) []model.TableBlock
+}
+
+type BlockServiceImpl struct {
+	blockRepo repository.BlockRepository
+	logger    *zap.Logger
+	requests  chan model.EventRequest
+	wg        sync.WaitGroup
+}
+
+func NewBlockService(repo repository.BlockRepository, logger *zap.Logger, eventRequest *chan model.EventRequest) *BlockServiceImpl {
+	blockService := &BlockServiceImpl{
+		blockRepo: repo,
+		logger:    logger,
+		requests:  *eventRequest,
+	}
+	blockService.wg.Add(1)
+	return blockService
+}
+
+func (s *BlockServiceImpl) BlockTables(restaurantId string, tableIds []string, start time.Time, end time.Time, reason string) (*model.TableBlock, error) {
+	if len(tableIds) == 0 {
+		return nil, errors.New("at least one table must be blocked")
+	}
+
+	block := model.TableBlock{TableIds: tableIds, StartTime: start, EndTime: end, Reason: reason}
+	resp := make(chan interface{})
+	s.requests <- model.EventRequest{Id: (uuid.New()).String(), Action: "block", RestaurantId: restaurantId, Block: block, Response: resp}
+	result := <-resp
+	if err, ok := result.(error); ok {
+		return nil, err
+	}
+	block = result.(model.TableBlock)
+	return &block, nil
+}
+
+func (s *BlockServiceImpl) UnblockTables(restaurantId string, id string) (*model.TableBlock, error) {
+	resp := make(chan interface{})
+	s.requests <- model.EventRequest{Id: (uuid.New()).String(), Action: "unblock", RestaurantId: restaurantId, Block: model.TableBlock{Id: id}, Response: resp}
+	result := <-resp
+	if err, ok := result.(error); ok {
+		return nil, err
+	}
+	block := result.(model.TableBlock)
+	return &block, nil
+}
+
+func (s *BlockServiceImpl) BlocksBetween(restaurantId string, start time.Time, end time.Time) []model.TableBlock {
+	return s.blockRepo.BlocksBetween(restaurantId, start, end)
+}
@@ -0,0 +1,118 @@
+package service_test
+
+import (
+	"errors"
+	"github.com/bossncn/restaurant-reservation-service/internal/core/model"
+	mockRepository "github.com/bossncn/restaurant-reservation-service/internal/core/repository/mock"
+	"github.com/bossncn/restaurant-reservation-service/internal/core/service"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"testing"
+	"time"
+)
+
+func TestBlockService(t *testing.T) {
+	start := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+	end := start.Add(2 * time.Hour)
+
+	t.Run("BlockTables", func(t *testing.T) {
+		t.Run("Success", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockRepository.NewMockBlockRepository(ctrl)
+			eventRequest := make(chan model.EventRequest, 100)
+			logger := zap.NewNop()
+			svc := service.NewBlockService(mockRepo, logger, &eventRequest)
+
+			// Mock event processor
+			go func() {
+				for req := range eventRequest {
+					if req.Action == "block" {
+						block := req.Block
+						block.Id = "blk-1"
+						block.RestaurantId = req.RestaurantId
+						req.Response <- block
+					}
+				}
+			}()
+
+			block, err := svc.BlockTables("r1", []string{"T1"}, start, end, "staff meal")
+
+			assert.NoError(t, err)
+			assert.Equal(t, &model.TableBlock{Id: "blk-1", RestaurantId: "r1", TableIds: []string{"T1"}, StartTime: start, EndTime: end, Reason: "staff meal"}, block)
+		})
+		t.Run("NoTables", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockRepository.NewMockBlockRepository(ctrl)
+			eventRequest := make(chan model.EventRequest, 100)
+			logger := zap.NewNop()
+			svc := service.NewBlockService(mockRepo, logger, &eventRequest)
+
+			_, err := svc.BlockTables("r1", nil, start, end, "")
+
+			assert.EqualError(t, err, "at least one table must be blocked")
+			assert.Empty(t, eventRequest)
+		})
+		t.Run("Error", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockRepository.NewMockBlockRepository(ctrl)
+			eventRequest := make(chan model.EventRequest, 100)
+			logger := zap.NewNop()
+			svc := service.NewBlockService(mockRepo, logger, &eventRequest)
+
+			// Mock event processor
+			go func() {
+				for req := range eventRequest {
+					req.Response <- errors.New("table T9 not found")
+				}
+			}()
+
+			_, err := svc.BlockTables("r1", []string{"T9"}, start, end, "")
+
+			assert.EqualError(t, err, "table T9 not found")
+		})
+	})
+	t.Run("UnblockTables", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mockRepository.NewMockBlockRepository(ctrl)
+		eventRequest := make(chan model.EventRequest, 100)
+		logger := zap.NewNop()
+		svc := service.NewBlockService(mockRepo, logger, &eventRequest)
+
+		// Mock event processor
+		go func() {
+			for req := range eventRequest {
+				if req.Action == "unblock" {
+					req.Response <- model.TableBlock{Id: req.Block.Id, RestaurantId: req.RestaurantId}
+				}
+			}
+		}()
+
+		block, err := svc.UnblockTables("r1", "blk-1")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "blk-1", block.Id)
+	})
+	t.Run("BlocksBetween", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mockRepository.NewMockBlockRepository(ctrl)
+		eventRequest := make(chan model.EventRequest, 100)
+		logger := zap.NewNop()
+		svc := service.NewBlockService(mockRepo, logger, &eventRequest)
+
+		blocks := []model.TableBlock{{Id: "blk-1", TableIds: []string{"T1"}, StartTime: start}}
+		mockRepo.EXPECT().BlocksBetween("r1", start, end).Return(blocks).Times(1)
+
+		assert.Equal(t, blocks, svc.BlocksBetween("r1", start, end))
+	})
+}
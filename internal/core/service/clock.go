@@ -0,0 +1,18 @@
+package service
+
+import "time"
+
+// DefaultHoldTTL is how long held tables stay locked before the hold expires.
+const DefaultHoldTTL = 10 * time.Minute
+
+// Clock tells the core what time it is, so time-based rules such as hold expiry can be tested
+// without sleeping.
+type Clock interface {
+	Now() time.Time
+}
+
+type SystemClock struct{}
+
+func (SystemClock) Now() time.Time {
+	return time.Now()
+}
@@ -0,0 +1,10 @@
+package service
+
+// SearchCombinations exposes the combination search, with the number of groups it grew, so tests
+// can bound its work by input rather than by wall-clock time.
+var SearchCombinations = searchCombinations
+
+var (
+	FewestEmptySeats = fewestEmptySeats
+	FewestTables     = fewestTables
+)
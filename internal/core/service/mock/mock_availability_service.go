@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/core/service/availability.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/core/service/availability.go -destination=internal/core/service/mock/mock_availability_service.go
+//
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	reflect "reflect"
+
+	model "github.com/bossncn/restaurant-reservation-service/internal/core/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAvailabilityService is a mock of AvailabilityService interface.
+type MockAvailabilityService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAvailabilityServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockAvailabilityServiceMockRecorder is the mock recorder for MockAvailabilityService.
+type MockAvailabilityServiceMockRecorder struct {
+	mock *MockAvailabilityService
+}
+
+// NewMockAvailabilityService creates a new mock instance.
+func NewMockAvailabilityService(ctrl *gomock.Controller) *MockAvailabilityService {
+	mock := &MockAvailabilityService{ctrl: ctrl}
+	mock.recorder = &MockAvailabilityServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAvailabilityService) EXPECT() *MockAvailabilityServiceMockRecorder {
+	return m.recorder
+}
+
+// SearchAvailability mocks base method.
+func (m *MockAvailabilityService) SearchAvailability(query model.AvailabilityQuery) (*model.Availability, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchAvailability", query)
+	ret0, _ := ret[0].(*model.Availability)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchAvailability indicates an expected call of SearchAvailability.
+func (mr *MockAvailabilityServiceMockRecorder) SearchAvailability(query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchAvailability", reflect.TypeOf((*MockAvailabilityService)(nil).SearchAvailability), query)
+}
@@ -0,0 +1,86 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/core/service/blocks.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/core/service/blocks.go -destination=internal/core/service/mock/mock_block_service.go
+//
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	reflect "reflect"
+	time "time"
+
+	model "github.com/bossncn/restaurant-reservation-service/internal/core/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockBlockService is a mock of BlockService interface.
+type MockBlockService struct {
+	ctrl     *gomock.Controller
+	recorder *MockBlockServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockBlockServiceMockRecorder is the mock recorder for MockBlockService.
+type MockBlockServiceMockRecorder struct {
+	mock *MockBlockService
+}
+
+// NewMockBlockService creates a new mock instance.
+func NewMockBlockService(ctrl *gomock.Controller) *MockBlockService {
+	mock := &MockBlockService{ctrl: ctrl}
+	mock.recorder = &MockBlockServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBlockService) EXPECT() *MockBlockServiceMockRecorder {
+	return m.recorder
+}
+
+// BlockTables mocks base method.
+func (m *MockBlockService) BlockTables(restaurantId string, tableIds []string, start, end time.Time, reason string) (*model.TableBlock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockTables", restaurantId, tableIds, start, end, reason)
+	ret0, _ := ret[0].(*model.TableBlock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlockTables indicates an expected call of BlockTables.
+func (mr *MockBlockServiceMockRecorder) BlockTables(restaurantId, tableIds, start, end, reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockTables", reflect.TypeOf((*MockBlockService)(nil).BlockTables), restaurantId, tableIds, start, end, reason)
+}
+
+// BlocksBetween mocks base method.
+func (m *MockBlockService) BlocksBetween(restaurantId string, start, end time.Time) []model.TableBlock {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlocksBetween", restaurantId, start, end)
+	ret0, _ := ret[0].([]model.TableBlock)
+	return ret0
+}
+
+// BlocksBetween indicates an expected call of BlocksBetween.
+func (mr *MockBlockServiceMockRecorder) BlocksBetween(restaurantId, start, end any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlocksBetween", reflect.TypeOf((*MockBlockService)(nil).BlocksBetween), restaurantId, start, end)
+}
+
+// UnblockTables mocks base method.
+func (m *MockBlockService) UnblockTables(restaurantId, id string) (*model.TableBlock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnblockTables", restaurantId, id)
+	ret0, _ := ret[0].(*model.TableBlock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnblockTables indicates an expected call of UnblockTables.
+func (mr *MockBlockServiceMockRecorder) UnblockTables(restaurantId, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnblockTables", reflect.TypeOf((*MockBlockService)(nil).UnblockTables), restaurantId, id)
+}
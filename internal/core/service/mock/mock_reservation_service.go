@@ -11,7 +11,10 @@ package mock_service
 
 import (
 	reflect "reflect"
+	time "time"
 
+	model "github.com/bossncn/restaurant-reservation-service/internal/core/model"
+	service "github.com/bossncn/restaurant-reservation-service/internal/core/service"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -39,33 +42,242 @@ func (m *MockReservationService) EXPECT() *MockReservationServiceMockRecorder {
 	return m.recorder
 }
 
+// BookEvent mocks base method.
+func (m *MockReservationService) BookEvent(restaurantId string, headcount int, contact model.Guest, section string, startTime, endTime time.Time, depositStatus model.DepositStatus) (*model.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BookEvent", restaurantId, headcount, contact, section, startTime, endTime, depositStatus)
+	ret0, _ := ret[0].(*model.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BookEvent indicates an expected call of BookEvent.
+func (mr *MockReservationServiceMockRecorder) BookEvent(restaurantId, headcount, contact, section, startTime, endTime, depositStatus any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BookEvent", reflect.TypeOf((*MockReservationService)(nil).BookEvent), restaurantId, headcount, contact, section, startTime, endTime, depositStatus)
+}
+
 // CancelReservation mocks base method.
-func (m *MockReservationService) CancelReservation(reservationID string) (int, error) {
+func (m *MockReservationService) CancelReservation(restaurantId, reservationID string, waiveFee bool) (*model.Reservation, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CancelReservation", reservationID)
-	ret0, _ := ret[0].(int)
+	ret := m.ctrl.Call(m, "CancelReservation", restaurantId, reservationID, waiveFee)
+	ret0, _ := ret[0].(*model.Reservation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CancelReservation indicates an expected call of CancelReservation.
-func (mr *MockReservationServiceMockRecorder) CancelReservation(reservationID any) *gomock.Call {
+func (mr *MockReservationServiceMockRecorder) CancelReservation(restaurantId, reservationID, waiveFee any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelReservation", reflect.TypeOf((*MockReservationService)(nil).CancelReservation), restaurantId, reservationID, waiveFee)
+}
+
+// CancelSeries mocks base method.
+func (m *MockReservationService) CancelSeries(restaurantId, seriesId string, waiveFee bool) (*service.SeriesCancellation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelSeries", restaurantId, seriesId, waiveFee)
+	ret0, _ := ret[0].(*service.SeriesCancellation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelSeries indicates an expected call of CancelSeries.
+func (mr *MockReservationServiceMockRecorder) CancelSeries(restaurantId, seriesId, waiveFee any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelSeries", reflect.TypeOf((*MockReservationService)(nil).CancelSeries), restaurantId, seriesId, waiveFee)
+}
+
+// CompleteReservation mocks base method.
+func (m *MockReservationService) CompleteReservation(restaurantId, reservationID string) (*model.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteReservation", restaurantId, reservationID)
+	ret0, _ := ret[0].(*model.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteReservation indicates an expected call of CompleteReservation.
+func (mr *MockReservationServiceMockRecorder) CompleteReservation(restaurantId, reservationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteReservation", reflect.TypeOf((*MockReservationService)(nil).CompleteReservation), restaurantId, reservationID)
+}
+
+// ConfirmReservation mocks base method.
+func (m *MockReservationService) ConfirmReservation(restaurantId, reservationID string, guest model.Guest) (*model.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmReservation", restaurantId, reservationID, guest)
+	ret0, _ := ret[0].(*model.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmReservation indicates an expected call of ConfirmReservation.
+func (mr *MockReservationServiceMockRecorder) ConfirmReservation(restaurantId, reservationID, guest any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmReservation", reflect.TypeOf((*MockReservationService)(nil).ConfirmReservation), restaurantId, reservationID, guest)
+}
+
+// FindReservation mocks base method.
+func (m *MockReservationService) FindReservation(restaurantId, reservationID string) (*model.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindReservation", restaurantId, reservationID)
+	ret0, _ := ret[0].(*model.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindReservation indicates an expected call of FindReservation.
+func (mr *MockReservationServiceMockRecorder) FindReservation(restaurantId, reservationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindReservation", reflect.TypeOf((*MockReservationService)(nil).FindReservation), restaurantId, reservationID)
+}
+
+// HoldTables mocks base method.
+func (m *MockReservationService) HoldTables(restaurantId string, numCustomers int, startTime time.Time, duration time.Duration) (*model.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HoldTables", restaurantId, numCustomers, startTime, duration)
+	ret0, _ := ret[0].(*model.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HoldTables indicates an expected call of HoldTables.
+func (mr *MockReservationServiceMockRecorder) HoldTables(restaurantId, numCustomers, startTime, duration any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HoldTables", reflect.TypeOf((*MockReservationService)(nil).HoldTables), restaurantId, numCustomers, startTime, duration)
+}
+
+// MarkNoShow mocks base method.
+func (m *MockReservationService) MarkNoShow(restaurantId, reservationID string) (*model.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkNoShow", restaurantId, reservationID)
+	ret0, _ := ret[0].(*model.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkNoShow indicates an expected call of MarkNoShow.
+func (mr *MockReservationServiceMockRecorder) MarkNoShow(restaurantId, reservationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkNoShow", reflect.TypeOf((*MockReservationService)(nil).MarkNoShow), restaurantId, reservationID)
+}
+
+// ModifyReservation mocks base method.
+func (m *MockReservationService) ModifyReservation(restaurantId, reservationID string, numCustomers int) (*model.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyReservation", restaurantId, reservationID, numCustomers)
+	ret0, _ := ret[0].(*model.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifyReservation indicates an expected call of ModifyReservation.
+func (mr *MockReservationServiceMockRecorder) ModifyReservation(restaurantId, reservationID, numCustomers any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyReservation", reflect.TypeOf((*MockReservationService)(nil).ModifyReservation), restaurantId, reservationID, numCustomers)
+}
+
+// PayDeposit mocks base method.
+func (m *MockReservationService) PayDeposit(restaurantId, reservationID, token string) (*model.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PayDeposit", restaurantId, reservationID, token)
+	ret0, _ := ret[0].(*model.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PayDeposit indicates an expected call of PayDeposit.
+func (mr *MockReservationServiceMockRecorder) PayDeposit(restaurantId, reservationID, token any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelReservation", reflect.TypeOf((*MockReservationService)(nil).CancelReservation), reservationID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PayDeposit", reflect.TypeOf((*MockReservationService)(nil).PayDeposit), restaurantId, reservationID, token)
+}
+
+// ReserveOnline mocks base method.
+func (m *MockReservationService) ReserveOnline(restaurantId string, numCustomers int, guest model.Guest, startTime time.Time, requirements, preferences model.Seating) (*model.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReserveOnline", restaurantId, numCustomers, guest, startTime, requirements, preferences)
+	ret0, _ := ret[0].(*model.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReserveOnline indicates an expected call of ReserveOnline.
+func (mr *MockReservationServiceMockRecorder) ReserveOnline(restaurantId, numCustomers, guest, startTime, requirements, preferences any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReserveOnline", reflect.TypeOf((*MockReservationService)(nil).ReserveOnline), restaurantId, numCustomers, guest, startTime, requirements, preferences)
+}
+
+// ReserveSeries mocks base method.
+func (m *MockReservationService) ReserveSeries(restaurantId string, numCustomers int, guest model.Guest, startTime time.Time, duration time.Duration, requirements, preferences model.Seating, recurrence model.Recurrence) ([]model.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReserveSeries", restaurantId, numCustomers, guest, startTime, duration, requirements, preferences, recurrence)
+	ret0, _ := ret[0].([]model.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReserveSeries indicates an expected call of ReserveSeries.
+func (mr *MockReservationServiceMockRecorder) ReserveSeries(restaurantId, numCustomers, guest, startTime, duration, requirements, preferences, recurrence any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReserveSeries", reflect.TypeOf((*MockReservationService)(nil).ReserveSeries), restaurantId, numCustomers, guest, startTime, duration, requirements, preferences, recurrence)
 }
 
 // ReserveTables mocks base method.
-func (m *MockReservationService) ReserveTables(numCustomers int) (string, int, error) {
+func (m *MockReservationService) ReserveTables(restaurantId string, numCustomers int, guest model.Guest, startTime time.Time, duration time.Duration, requirements, preferences model.Seating) (*model.Reservation, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReserveTables", numCustomers)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(int)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "ReserveTables", restaurantId, numCustomers, guest, startTime, duration, requirements, preferences)
+	ret0, _ := ret[0].(*model.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // ReserveTables indicates an expected call of ReserveTables.
-func (mr *MockReservationServiceMockRecorder) ReserveTables(numCustomers any) *gomock.Call {
+func (mr *MockReservationServiceMockRecorder) ReserveTables(restaurantId, numCustomers, guest, startTime, duration, requirements, preferences any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReserveTables", reflect.TypeOf((*MockReservationService)(nil).ReserveTables), restaurantId, numCustomers, guest, startTime, duration, requirements, preferences)
+}
+
+// SeatReservation mocks base method.
+func (m *MockReservationService) SeatReservation(restaurantId, reservationID string) (*model.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeatReservation", restaurantId, reservationID)
+	ret0, _ := ret[0].(*model.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SeatReservation indicates an expected call of SeatReservation.
+func (mr *MockReservationServiceMockRecorder) SeatReservation(restaurantId, reservationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeatReservation", reflect.TypeOf((*MockReservationService)(nil).SeatReservation), restaurantId, reservationID)
+}
+
+// SeatWalkIn mocks base method.
+func (m *MockReservationService) SeatWalkIn(restaurantId string, numCustomers int, guest model.Guest, duration time.Duration) (*model.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeatWalkIn", restaurantId, numCustomers, guest, duration)
+	ret0, _ := ret[0].(*model.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SeatWalkIn indicates an expected call of SeatWalkIn.
+func (mr *MockReservationServiceMockRecorder) SeatWalkIn(restaurantId, numCustomers, guest, duration any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeatWalkIn", reflect.TypeOf((*MockReservationService)(nil).SeatWalkIn), restaurantId, numCustomers, guest, duration)
+}
+
+// UpdateDepositStatus mocks base method.
+func (m *MockReservationService) UpdateDepositStatus(restaurantId, reservationID string, status model.DepositStatus) (*model.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDepositStatus", restaurantId, reservationID, status)
+	ret0, _ := ret[0].(*model.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDepositStatus indicates an expected call of UpdateDepositStatus.
+func (mr *MockReservationServiceMockRecorder) UpdateDepositStatus(restaurantId, reservationID, status any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReserveTables", reflect.TypeOf((*MockReservationService)(nil).ReserveTables), numCustomers)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDepositStatus", reflect.TypeOf((*MockReservationService)(nil).UpdateDepositStatus), restaurantId, reservationID, status)
 }
@@ -0,0 +1,85 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/core/service/restaurants.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/core/service/restaurants.go -destination=internal/core/service/mock/mock_restaurant_service.go
+//
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	reflect "reflect"
+
+	model "github.com/bossncn/restaurant-reservation-service/internal/core/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRestaurantService is a mock of RestaurantService interface.
+type MockRestaurantService struct {
+	ctrl     *gomock.Controller
+	recorder *MockRestaurantServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockRestaurantServiceMockRecorder is the mock recorder for MockRestaurantService.
+type MockRestaurantServiceMockRecorder struct {
+	mock *MockRestaurantService
+}
+
+// NewMockRestaurantService creates a new mock instance.
+func NewMockRestaurantService(ctrl *gomock.Controller) *MockRestaurantService {
+	mock := &MockRestaurantService{ctrl: ctrl}
+	mock.recorder = &MockRestaurantServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRestaurantService) EXPECT() *MockRestaurantServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateRestaurant mocks base method.
+func (m *MockRestaurantService) CreateRestaurant(restaurant model.Restaurant) (*model.Restaurant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRestaurant", restaurant)
+	ret0, _ := ret[0].(*model.Restaurant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRestaurant indicates an expected call of CreateRestaurant.
+func (mr *MockRestaurantServiceMockRecorder) CreateRestaurant(restaurant any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRestaurant", reflect.TypeOf((*MockRestaurantService)(nil).CreateRestaurant), restaurant)
+}
+
+// FindRestaurant mocks base method.
+func (m *MockRestaurantService) FindRestaurant(id string) (*model.Restaurant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindRestaurant", id)
+	ret0, _ := ret[0].(*model.Restaurant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindRestaurant indicates an expected call of FindRestaurant.
+func (mr *MockRestaurantServiceMockRecorder) FindRestaurant(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRestaurant", reflect.TypeOf((*MockRestaurantService)(nil).FindRestaurant), id)
+}
+
+// ListRestaurants mocks base method.
+func (m *MockRestaurantService) ListRestaurants() []model.Restaurant {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRestaurants")
+	ret0, _ := ret[0].([]model.Restaurant)
+	return ret0
+}
+
+// ListRestaurants indicates an expected call of ListRestaurants.
+func (mr *MockRestaurantServiceMockRecorder) ListRestaurants() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRestaurants", reflect.TypeOf((*MockRestaurantService)(nil).ListRestaurants))
+}
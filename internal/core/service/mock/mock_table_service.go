@@ -11,7 +11,9 @@ package mock_service
 
 import (
 	reflect "reflect"
+	time "time"
 
+	model "github.com/bossncn/restaurant-reservation-service/internal/core/model"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -40,29 +42,85 @@ func (m *MockTableService) EXPECT() *MockTableServiceMockRecorder {
 }
 
 // AvailableTables mocks base method.
-func (m *MockTableService) AvailableTables() int {
+func (m *MockTableService) AvailableTables(restaurantId string, start, end time.Time) int {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AvailableTables")
+	ret := m.ctrl.Call(m, "AvailableTables", restaurantId, start, end)
 	ret0, _ := ret[0].(int)
 	return ret0
 }
 
 // AvailableTables indicates an expected call of AvailableTables.
-func (mr *MockTableServiceMockRecorder) AvailableTables() *gomock.Call {
+func (mr *MockTableServiceMockRecorder) AvailableTables(restaurantId, start, end any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AvailableTables", reflect.TypeOf((*MockTableService)(nil).AvailableTables))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AvailableTables", reflect.TypeOf((*MockTableService)(nil).AvailableTables), restaurantId, start, end)
 }
 
 // InitializeTables mocks base method.
-func (m *MockTableService) InitializeTables(numTables int) error {
+func (m *MockTableService) InitializeTables(restaurantId string, tables []model.Table) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "InitializeTables", numTables)
+	ret := m.ctrl.Call(m, "InitializeTables", restaurantId, tables)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // InitializeTables indicates an expected call of InitializeTables.
-func (mr *MockTableServiceMockRecorder) InitializeTables(numTables any) *gomock.Call {
+func (mr *MockTableServiceMockRecorder) InitializeTables(restaurantId, tables any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitializeTables", reflect.TypeOf((*MockTableService)(nil).InitializeTables), numTables)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitializeTables", reflect.TypeOf((*MockTableService)(nil).InitializeTables), restaurantId, tables)
+}
+
+// ReservationsBetween mocks base method.
+func (m *MockTableService) ReservationsBetween(restaurantId string, start, end time.Time) []model.Reservation {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReservationsBetween", restaurantId, start, end)
+	ret0, _ := ret[0].([]model.Reservation)
+	return ret0
+}
+
+// ReservationsBetween indicates an expected call of ReservationsBetween.
+func (mr *MockTableServiceMockRecorder) ReservationsBetween(restaurantId, start, end any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReservationsBetween", reflect.TypeOf((*MockTableService)(nil).ReservationsBetween), restaurantId, start, end)
+}
+
+// ResizeTables mocks base method.
+func (m *MockTableService) ResizeTables(restaurantId string, tables []model.Table) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResizeTables", restaurantId, tables)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResizeTables indicates an expected call of ResizeTables.
+func (mr *MockTableServiceMockRecorder) ResizeTables(restaurantId, tables any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResizeTables", reflect.TypeOf((*MockTableService)(nil).ResizeTables), restaurantId, tables)
+}
+
+// Tables mocks base method.
+func (m *MockTableService) Tables(restaurantId string) []model.Table {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Tables", restaurantId)
+	ret0, _ := ret[0].([]model.Table)
+	return ret0
+}
+
+// Tables indicates an expected call of Tables.
+func (mr *MockTableServiceMockRecorder) Tables(restaurantId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Tables", reflect.TypeOf((*MockTableService)(nil).Tables), restaurantId)
+}
+
+// TotalTables mocks base method.
+func (m *MockTableService) TotalTables(restaurantId string) int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TotalTables", restaurantId)
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// TotalTables indicates an expected call of TotalTables.
+func (mr *MockTableServiceMockRecorder) TotalTables(restaurantId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TotalTables", reflect.TypeOf((*MockTableService)(nil).TotalTables), restaurantId)
 }
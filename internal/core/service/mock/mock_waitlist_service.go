@@ -0,0 +1,88 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/core/service/waitlist.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/core/service/waitlist.go -destination=internal/core/service/mock/mock_waitlist_service.go
+//
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	reflect "reflect"
+	time "time"
+
+	model "github.com/bossncn/restaurant-reservation-service/internal/core/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWaitlistService is a mock of WaitlistService interface.
+type MockWaitlistService struct {
+	ctrl     *gomock.Controller
+	recorder *MockWaitlistServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockWaitlistServiceMockRecorder is the mock recorder for MockWaitlistService.
+type MockWaitlistServiceMockRecorder struct {
+	mock *MockWaitlistService
+}
+
+// NewMockWaitlistService creates a new mock instance.
+func NewMockWaitlistService(ctrl *gomock.Controller) *MockWaitlistService {
+	mock := &MockWaitlistService{ctrl: ctrl}
+	mock.recorder = &MockWaitlistServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWaitlistService) EXPECT() *MockWaitlistServiceMockRecorder {
+	return m.recorder
+}
+
+// JoinWaitlist mocks base method.
+func (m *MockWaitlistService) JoinWaitlist(restaurantId string, numCustomers int, guest model.Guest, startTime time.Time, duration time.Duration, requirements, preferences model.Seating) (*model.WaitlistEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JoinWaitlist", restaurantId, numCustomers, guest, startTime, duration, requirements, preferences)
+	ret0, _ := ret[0].(*model.WaitlistEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JoinWaitlist indicates an expected call of JoinWaitlist.
+func (mr *MockWaitlistServiceMockRecorder) JoinWaitlist(restaurantId, numCustomers, guest, startTime, duration, requirements, preferences any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinWaitlist", reflect.TypeOf((*MockWaitlistService)(nil).JoinWaitlist), restaurantId, numCustomers, guest, startTime, duration, requirements, preferences)
+}
+
+// LeaveWaitlist mocks base method.
+func (m *MockWaitlistService) LeaveWaitlist(restaurantId, id string) (*model.WaitlistEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LeaveWaitlist", restaurantId, id)
+	ret0, _ := ret[0].(*model.WaitlistEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LeaveWaitlist indicates an expected call of LeaveWaitlist.
+func (mr *MockWaitlistServiceMockRecorder) LeaveWaitlist(restaurantId, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LeaveWaitlist", reflect.TypeOf((*MockWaitlistService)(nil).LeaveWaitlist), restaurantId, id)
+}
+
+// WaitlistPosition mocks base method.
+func (m *MockWaitlistService) WaitlistPosition(restaurantId, id string) (*model.WaitlistEntry, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitlistPosition", restaurantId, id)
+	ret0, _ := ret[0].(*model.WaitlistEntry)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// WaitlistPosition indicates an expected call of WaitlistPosition.
+func (mr *MockWaitlistServiceMockRecorder) WaitlistPosition(restaurantId, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitlistPosition", reflect.TypeOf((*MockWaitlistService)(nil).WaitlistPosition), restaurantId, id)
+}
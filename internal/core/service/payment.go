@@ -0,0 +1,15 @@
+package service
+
+import "errors"
+
+// ErrPaymentDeclined is returned when the guest's payment method is refused.
+var ErrPaymentDeclined = errors.New("payment declined")
+
+// PaymentGateway takes and returns deposits. Amounts are in the smallest unit of the venue's currency.
+type PaymentGateway interface {
+	// Charge takes amount from the payment method token stands for and returns the payment id.
+	// reference ties the payment to the reservation it pays for.
+	Charge(token string, amount int, reference string) (string, error)
+	// Refund returns amount of an earlier payment.
+	Refund(paymentId string, amount int) error
+}
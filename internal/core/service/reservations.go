@@ -2,16 +2,98 @@ package service
 
 import (
 	"errors"
+	"fmt"
 	"github.com/bossncn/restaurant-reservation-service/internal/core/model"
 	"github.com/bossncn/restaurant-reservation-service/internal/core/repository"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
+	"math"
+	"strings"
 	"sync"
+	"time"
 )
 
+// DefaultReservationDuration is used when a booking does not specify how long it holds its tables
+// and no turn time applies to its party size.
+const DefaultReservationDuration = 2 * time.Hour
+
+// WaitError is returned when a walk-in cannot be seated now but tables are expected to free up.
+type WaitError struct {
+	AvailableAt   time.Time
+	EstimatedWait time.Duration
+}
+
+func (e *WaitError) Error() string {
+	return fmt.Sprintf("no table is free, estimated wait is %d minutes", int(math.Ceil(e.EstimatedWait.Minutes())))
+}
+
+func (e *WaitError) Unwrap() error {
+	return ErrNotEnoughTables
+}
+
+// OccurrenceConflict is an occurrence of a recurring reservation that cannot be booked.
+type OccurrenceConflict struct {
+	StartTime time.Time
+	Reason    string
+}
+
+// RecurrenceConflictError is returned when occurrences of a recurring reservation cannot be booked.
+// None of the series is booked in that case.
+type RecurrenceConflictError struct {
+	Occurrences []OccurrenceConflict
+}
+
+func (e *RecurrenceConflictError) Error() string {
+	startTimes := make([]string, 0, len(e.Occurrences))
+	for _, occurrence := range e.Occurrences {
+		startTimes = append(startTimes, occurrence.StartTime.Format(time.RFC3339))
+	}
+	return fmt.Sprintf("cannot book the occurrences starting %s", strings.Join(startTimes, ", "))
+}
+
+// SeriesCancellation is the outcome of cancelling a recurring reservation, occurrence by occurrence.
+// An occurrence that cannot be cancelled, such as when refunding its deposit fails, is left as it
+// was and listed in Failed, so the others are not held back by it.
+type SeriesCancellation struct {
+	Cancelled []model.Reservation
+	Failed    []OccurrenceFailure
+}
+
+// OccurrenceFailure is an occurrence of a recurring reservation that could not be cancelled.
+type OccurrenceFailure struct {
+	ReservationId string
+	StartTime     time.Time
+	Reason        string
+}
+
 type ReservationService interface {
-	ReserveTables(numCustomers int) (string, int, error)
-	CancelReservation(reservationID string) (int, error)
+	ReserveTables(restaurantId string, numCustomers int, guest model.Guest, startTime time.Time, duration time.Duration, requirements model.Seating, preferences model.Seating) (*model.Reservation, error)
+	// ReserveOnline books tables for a guest booking through the public channel, for the turn time of
+	// the party size and within the public booking window.
+	ReserveOnline(restaurantId string, numCustomers int, guest model.Guest, startTime time.Time, requirements model.Seating, preferences model.Seating) (*model.Reservation, error)
+	HoldTables(restaurantId string, numCustomers int, startTime time.Time, duration time.Duration) (*model.Reservation, error)
+	// ReserveSeries books every occurrence of a recurring reservation, or returns a
+	// *RecurrenceConflictError listing the occurrences that cannot be booked.
+	ReserveSeries(restaurantId string, numCustomers int, guest model.Guest, startTime time.Time, duration time.Duration, requirements model.Seating, preferences model.Seating, recurrence model.Recurrence) ([]model.Reservation, error)
+	// CancelSeries cancels the upcoming occurrences of a recurring reservation, each under the
+	// cancellation policy; waiveFee lets staff override the charge of late cancellations.
+	CancelSeries(restaurantId string, seriesId string, waiveFee bool) (*SeriesCancellation, error)
+	SeatWalkIn(restaurantId string, numCustomers int, guest model.Guest, duration time.Duration) (*model.Reservation, error)
+	// BookEvent reserves a whole section, or the whole venue when section is empty, for a private event.
+	BookEvent(restaurantId string, headcount int, contact model.Guest, section string, startTime time.Time, endTime time.Time, depositStatus model.DepositStatus) (*model.Reservation, error)
+	UpdateDepositStatus(restaurantId string, reservationID string, status model.DepositStatus) (*model.Reservation, error)
+	// PayDeposit pays the deposit of a booking awaiting payment with the payment method token stands
+	// for, confirming the booking. A declined payment releases the booking's tables.
+	PayDeposit(restaurantId string, reservationID string, token string) (*model.Reservation, error)
+	ConfirmReservation(restaurantId string, reservationID string, guest model.Guest) (*model.Reservation, error)
+	ModifyReservation(restaurantId string, reservationID string, numCustomers int) (*model.Reservation, error)
+	// CancelReservation cancels a reservation under the cancellation policy; waiveFee lets staff
+	// override the charge of a late cancellation. The outcome is recorded in its Cancellation.
+	CancelReservation(restaurantId string, reservationID string, waiveFee bool) (*model.Reservation, error)
+	SeatReservation(restaurantId string, reservationID string) (*model.Reservation, error)
+	CompleteReservation(restaurantId string, reservationID string) (*model.Reservation, error)
+	MarkNoShow(restaurantId string, reservationID string) (*model.Reservation, error)
+	FindReservation(restaurantId string, reservationID string) (*model.Reservation, error)
 }
 
 type ReservationServiceImpl struct {
@@ -31,29 +113,216 @@ func NewReservationService(repo repository.ReservationRepository, logger *zap.Lo
 	return repositoryService
 }
 
-func (s *ReservationServiceImpl) ReserveTables(numCustomers int) (string, int, error) {
+// ReserveTables books tables for the party. Every table meets the requirements; the preferences
+// are met when possible and the reservation lists the ones that are not.
+func (s *ReservationServiceImpl) ReserveTables(restaurantId string, numCustomers int, guest model.Guest, startTime time.Time, duration time.Duration, requirements model.Seating, preferences model.Seating) (*model.Reservation, error) {
+	return s.reserve("reserve", model.ChannelStaff, restaurantId, numCustomers, guest, startTime, duration, requirements, preferences)
+}
+
+func (s *ReservationServiceImpl) ReserveOnline(restaurantId string, numCustomers int, guest model.Guest, startTime time.Time, requirements model.Seating, preferences model.Seating) (*model.Reservation, error) {
+	return s.reserve("reserve", model.ChannelPublic, restaurantId, numCustomers, guest, startTime, 0, requirements, preferences)
+}
+
+// HoldTables locks tables for the party while the guest completes their booking. The hold expires
+// and the tables are released unless it is confirmed within the processor's hold TTL.
+func (s *ReservationServiceImpl) HoldTables(restaurantId string, numCustomers int, startTime time.Time, duration time.Duration) (*model.Reservation, error) {
+	return s.reserve("hold", model.ChannelStaff, restaurantId, numCustomers, model.Guest{}, startTime, duration, model.Seating{}, model.Seating{})
+}
+
+// ReserveSeries expands the recurrence from startTime and books each occurrence under a new series
+// id. The occurrences are booked in a single processor step, so the series is booked in full or
+// not at all.
+func (s *ReservationServiceImpl) ReserveSeries(restaurantId string, numCustomers int, guest model.Guest, startTime time.Time, duration time.Duration, requirements model.Seating, preferences model.Seating, recurrence model.Recurrence) ([]model.Reservation, error) {
+	if numCustomers <= 0 {
+		return nil, errors.New("number of customers must be greater than zero")
+	}
+	if startTime.IsZero() {
+		return nil, errors.New("start time is required")
+	}
+	if duration < 0 {
+		return nil, errors.New("duration must not be negative")
+	}
+	occurrences, err := recurrence.Occurrences(startTime)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make(chan interface{})
+	s.requests <- model.EventRequest{Id: (uuid.New()).String(), Action: "reserve_series", RestaurantId: restaurantId, PartySize: numCustomers, Guest: guest, StartTime: startTime, Duration: duration, Requirements: requirements, Preferences: preferences, Slots: occurrences, SeriesId: (uuid.New()).String(), Channel: model.ChannelStaff, Response: resp}
+	result := <-resp
+	if err, ok := result.(error); ok {
+		return nil, err
+	}
+	return result.([]model.Reservation), nil
+}
+
+func (s *ReservationServiceImpl) CancelSeries(restaurantId string, seriesId string, waiveFee bool) (*SeriesCancellation, error) {
+	resp := make(chan interface{})
+	s.requests <- model.EventRequest{Id: (uuid.New()).String(), Action: "cancel_series", RestaurantId: restaurantId, SeriesId: seriesId, WaiveFee: waiveFee, Response: resp}
+	result := <-resp
+	if err, ok := result.(error); ok {
+		return nil, err
+	}
+	cancellation := result.(SeriesCancellation)
+	return &cancellation, nil
+}
+
+// SeatWalkIn seats a party that arrived without booking on tables free from now, recording it as
+// a seated walk-in. When nothing is free it returns a *WaitError with the estimated wait, or
+// ErrNotEnoughTables when no table is expected to free up soon.
+func (s *ReservationServiceImpl) SeatWalkIn(restaurantId string, numCustomers int, guest model.Guest, duration time.Duration) (*model.Reservation, error) {
 	if numCustomers <= 0 {
-		return "", 0, errors.New("number of customers must be greater than zero")
+		return nil, errors.New("number of customers must be greater than zero")
+	}
+	if duration < 0 {
+		return nil, errors.New("duration must not be negative")
 	}
-	numTables := (numCustomers + 3) / 4 // Calculate required tables
 
 	resp := make(chan interface{})
-	s.requests <- model.EventRequest{Id: (uuid.New()).String(), Action: "reserve", NumTables: numTables, Response: resp}
+	s.requests <- model.EventRequest{Id: (uuid.New()).String(), Action: "walk_in", RestaurantId: restaurantId, PartySize: numCustomers, Guest: guest, Duration: duration, Response: resp}
 	result := <-resp
+	if err, ok := result.(error); ok {
+		return nil, err
+	}
+	reservation := result.(model.Reservation)
+	return &reservation, nil
+}
 
+// ConfirmReservation turns a hold into a confirmed reservation for the guest.
+func (s *ReservationServiceImpl) ConfirmReservation(restaurantId string, reservationID string, guest model.Guest) (*model.Reservation, error) {
+	resp := make(chan interface{})
+	s.requests <- model.EventRequest{Id: (uuid.New()).String(), Action: "confirm", RestaurantId: restaurantId, ResID: reservationID, Guest: guest, Response: resp}
+	result := <-resp
 	if err, ok := result.(error); ok {
-		return "", 0, err
+		return nil, err
 	}
+	reservation := result.(model.Reservation)
+	return &reservation, nil
+}
+
+func (s *ReservationServiceImpl) BookEvent(restaurantId string, headcount int, contact model.Guest, section string, startTime time.Time, endTime time.Time, depositStatus model.DepositStatus) (*model.Reservation, error) {
+	if headcount <= 0 {
+		return nil, errors.New("headcount must be greater than zero")
+	}
+	if startTime.IsZero() || endTime.IsZero() {
+		return nil, errors.New("start and end time are required")
+	}
+	if !endTime.After(startTime) {
+		return nil, errors.New("end time must be after start time")
+	}
+
+	resp := make(chan interface{})
+	s.requests <- model.EventRequest{Id: (uuid.New()).String(), Action: "book_event", RestaurantId: restaurantId, PartySize: headcount, Guest: contact, StartTime: startTime, Duration: endTime.Sub(startTime), Requirements: model.Seating{Section: section}, DepositStatus: depositStatus, Response: resp}
+	result := <-resp
+	if err, ok := result.(error); ok {
+		return nil, err
+	}
+	reservation := result.(model.Reservation)
+	return &reservation, nil
+}
+
+func (s *ReservationServiceImpl) UpdateDepositStatus(restaurantId string, reservationID string, status model.DepositStatus) (*model.Reservation, error) {
+	resp := make(chan interface{})
+	s.requests <- model.EventRequest{Id: (uuid.New()).String(), Action: "update_deposit", RestaurantId: restaurantId, ResID: reservationID, DepositStatus: status, Response: resp}
+	result := <-resp
+	if err, ok := result.(error); ok {
+		return nil, err
+	}
+	reservation := result.(model.Reservation)
+	return &reservation, nil
+}
+
+func (s *ReservationServiceImpl) PayDeposit(restaurantId string, reservationID string, token string) (*model.Reservation, error) {
+	if token == "" {
+		return nil, errors.New("payment token must not be empty")
+	}
+
+	resp := make(chan interface{})
+	s.requests <- model.EventRequest{Id: (uuid.New()).String(), Action: "pay", RestaurantId: restaurantId, ResID: reservationID, PaymentToken: token, Response: resp}
+	result := <-resp
+	if err, ok := result.(error); ok {
+		return nil, err
+	}
+	reservation := result.(model.Reservation)
+	return &reservation, nil
+}
+
+func (s *ReservationServiceImpl) reserve(action string, channel model.Channel, restaurantId string, numCustomers int, guest model.Guest, startTime time.Time, duration time.Duration, requirements model.Seating, preferences model.Seating) (*model.Reservation, error) {
+	if numCustomers <= 0 {
+		return nil, errors.New("number of customers must be greater than zero")
+	}
+	if startTime.IsZero() {
+		return nil, errors.New("start time is required")
+	}
+	if duration < 0 {
+		return nil, errors.New("duration must not be negative")
+	}
+
+	resp := make(chan interface{})
+	s.requests <- model.EventRequest{Id: (uuid.New()).String(), Action: action, RestaurantId: restaurantId, PartySize: numCustomers, Guest: guest, StartTime: startTime, Duration: duration, Requirements: requirements, Preferences: preferences, Channel: channel, Response: resp}
+	result := <-resp
+
+	if err, ok := result.(error); ok {
+		return nil, err
+	}
+
+	reservation := result.(model.Reservation)
+	return &reservation, nil
+}
+
+// ModifyReservation changes the party size of a reservation, moving it to tables that fit the new
+// size in a single processor step so it never loses its original tables on failure.
+func (s *ReservationServiceImpl) ModifyReservation(restaurantId string, reservationID string, numCustomers int) (*model.Reservation, error) {
+	if numCustomers <= 0 {
+		return nil, errors.New("number of customers must be greater than zero")
+	}
+
+	resp := make(chan interface{})
+	s.requests <- model.EventRequest{Id: (uuid.New()).String(), Action: "modify", RestaurantId: restaurantId, ResID: reservationID, PartySize: numCustomers, Response: resp}
+	result := <-resp
+	if err, ok := result.(error); ok {
+		return nil, err
+	}
+	reservation := result.(model.Reservation)
+	return &reservation, nil
+}
+
+func (s *ReservationServiceImpl) CancelReservation(restaurantId string, reservationID string, waiveFee bool) (*model.Reservation, error) {
+	resp := make(chan interface{})
+	s.requests <- model.EventRequest{Id: (uuid.New()).String(), Action: "cancel", RestaurantId: restaurantId, ResID: reservationID, WaiveFee: waiveFee, Response: resp}
+	result := <-resp
+	if err, ok := result.(error); ok {
+		return nil, err
+	}
+	reservation := result.(model.Reservation)
+	return &reservation, nil
+}
+
+func (s *ReservationServiceImpl) SeatReservation(restaurantId string, reservationID string) (*model.Reservation, error) {
+	return s.updateStatus(restaurantId, reservationID, "seat")
+}
+
+func (s *ReservationServiceImpl) CompleteReservation(restaurantId string, reservationID string) (*model.Reservation, error) {
+	return s.updateStatus(restaurantId, reservationID, "complete")
+}
+
+func (s *ReservationServiceImpl) MarkNoShow(restaurantId string, reservationID string) (*model.Reservation, error) {
+	return s.updateStatus(restaurantId, reservationID, "no_show")
+}
 
-	return result.(string), numTables, nil
+func (s *ReservationServiceImpl) FindReservation(restaurantId string, reservationID string) (*model.Reservation, error) {
+	return s.reservationRepo.FindReservationById(restaurantId, reservationID)
 }
 
-func (s *ReservationServiceImpl) CancelReservation(reservationID string) (int, error) {
+// updateStatus asks the processor to move a reservation along its lifecycle; the processor rejects
+// transitions the reservation's current status does not allow.
+func (s *ReservationServiceImpl) updateStatus(restaurantId string, reservationID string, action string) (*model.Reservation, error) {
 	resp := make(chan interface{})
-	s.requests <- model.EventRequest{Id: (uuid.New()).String(), Action: "cancel", ResID: reservationID, Response: resp}
+	s.requests <- model.EventRequest{Id: (uuid.New()).String(), Action: action, RestaurantId: restaurantId, ResID: reservationID, Response: resp}
 	result := <-resp
 	if err, ok := result.(error); ok {
-		return 0, err
+		return nil, err
 	}
-	return result.(int), nil
+	reservation := result.(model.Reservation)
+	return &reservation, nil
 }
@@ -10,9 +10,13 @@ import (
 	"go.uber.org/mock/gomock"
 	"go.uber.org/zap"
 	"testing"
+	"time"
 )
 
 func TestReservationService(t *testing.T) {
+	startTime := time.Date(2025, 1, 10, 19, 0, 0, 0, time.UTC)
+	guest := model.Guest{Name: "Alex Tan", Phone: "+66812345678", Email: "alex@example.com", Notes: "Window seat"}
+
 	t.Run("NewReservationService", func(t *testing.T) {
 		eventRequest := make(chan model.EventRequest, 100)
 		logger := zap.NewNop()
@@ -39,17 +43,23 @@ func TestReservationService(t *testing.T) {
 			go func() {
 				for req := range eventRequest {
 					if req.Action == "reserve" {
-						req.Response <- uuid.New().String()
+						req.Response <- model.Reservation{Id: uuid.New().String(), RestaurantId: req.RestaurantId, PartySize: req.PartySize, Guest: req.Guest, NumTables: 2, TableIds: []string{"T1", "T2"}, StartTime: req.StartTime, Duration: req.Duration}
 					}
 				}
 			}()
 
 			// Test reservation
-			resID, numTables, err := svc.ReserveTables(6)
+			reservation, err := svc.ReserveTables("r1", 6, guest, startTime, 0, model.Seating{}, model.Seating{})
 
 			assert.NoError(t, err)
-			assert.NotEmpty(t, resID)
-			assert.Equal(t, 2, numTables) // 6 customers require 2 tables
+			assert.NotEmpty(t, reservation.Id)
+			assert.Equal(t, "r1", reservation.RestaurantId)
+			assert.Equal(t, 6, reservation.PartySize)
+			assert.Equal(t, guest, reservation.Guest)
+			assert.Equal(t, []string{"T1", "T2"}, reservation.TableIds)
+			assert.Equal(t, startTime, reservation.StartTime)
+			// The processor picks the turn time when no duration is given
+			assert.Zero(t, reservation.Duration)
 		})
 		t.Run("InvalidCustomers", func(t *testing.T) {
 			ctrl := gomock.NewController(t)
@@ -61,12 +71,26 @@ func TestReservationService(t *testing.T) {
 			svc := service.NewReservationService(mockRepo, logger, &eventRequest)
 
 			// Test with invalid customer count
-			resID, numTables, err := svc.ReserveTables(0)
+			reservation, err := svc.ReserveTables("r1", 0, guest, startTime, time.Hour, model.Seating{}, model.Seating{})
 
 			assert.Error(t, err)
 			assert.Equal(t, "number of customers must be greater than zero", err.Error())
-			assert.Empty(t, resID)
-			assert.Equal(t, 0, numTables)
+			assert.Nil(t, reservation)
+		})
+		t.Run("MissingStartTime", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockRepository.NewMockReservationRepository(ctrl)
+			eventRequest := make(chan model.EventRequest, 100)
+			logger := zap.NewNop()
+			svc := service.NewReservationService(mockRepo, logger, &eventRequest)
+
+			reservation, err := svc.ReserveTables("r1", 2, guest, time.Time{}, time.Hour, model.Seating{}, model.Seating{})
+
+			assert.Error(t, err)
+			assert.Equal(t, "start time is required", err.Error())
+			assert.Nil(t, reservation)
 		})
 		t.Run("ErrorFromProcessor", func(t *testing.T) {
 			ctrl := gomock.NewController(t)
@@ -87,12 +111,145 @@ func TestReservationService(t *testing.T) {
 			}()
 
 			// Test reservation
-			resID, numTables, err := svc.ReserveTables(6)
+			reservation, err := svc.ReserveTables("r1", 6, guest, startTime, time.Hour, model.Seating{}, model.Seating{})
 
 			assert.Error(t, err)
 			assert.Equal(t, "reservation failed", err.Error())
-			assert.Empty(t, resID)
-			assert.Equal(t, 0, numTables)
+			assert.Nil(t, reservation)
+		})
+	})
+	t.Run("HoldTables", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mockRepository.NewMockReservationRepository(ctrl)
+		eventRequest := make(chan model.EventRequest, 100)
+		logger := zap.NewNop()
+		svc := service.NewReservationService(mockRepo, logger, &eventRequest)
+
+		// Mock event processor
+		go func() {
+			for req := range eventRequest {
+				if req.Action == "hold" {
+					req.Response <- model.Reservation{Id: "res-1", PartySize: req.PartySize, StartTime: req.StartTime, Duration: req.Duration, Status: model.ReservationHeld}
+				}
+			}
+		}()
+
+		reservation, err := svc.HoldTables("r1", 4, startTime, 0)
+
+		assert.NoError(t, err)
+		assert.Equal(t, model.ReservationHeld, reservation.Status)
+		assert.Zero(t, reservation.Duration)
+	})
+	t.Run("ReserveOnline", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mockRepository.NewMockReservationRepository(ctrl)
+		eventRequest := make(chan model.EventRequest, 100)
+		logger := zap.NewNop()
+		svc := service.NewReservationService(mockRepo, logger, &eventRequest)
+
+		// Mock event processor
+		go func() {
+			for req := range eventRequest {
+				if req.Action == "reserve" {
+					assert.Equal(t, model.ChannelPublic, req.Channel)
+					req.Response <- model.Reservation{Id: "res-1", PartySize: req.PartySize, Guest: req.Guest, StartTime: req.StartTime, Duration: req.Duration}
+				}
+			}
+		}()
+
+		reservation, err := svc.ReserveOnline("r1", 2, guest, startTime, model.Seating{}, model.Seating{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "res-1", reservation.Id)
+		assert.Zero(t, reservation.Duration)
+	})
+	t.Run("ConfirmReservation", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mockRepository.NewMockReservationRepository(ctrl)
+		eventRequest := make(chan model.EventRequest, 100)
+		logger := zap.NewNop()
+		svc := service.NewReservationService(mockRepo, logger, &eventRequest)
+
+		// Mock event processor
+		go func() {
+			for req := range eventRequest {
+				if req.Action == "confirm" {
+					req.Response <- model.Reservation{Id: req.ResID, Guest: req.Guest, Status: model.ReservationConfirmed}
+				}
+			}
+		}()
+
+		reservation, err := svc.ConfirmReservation("r1", "res-1", guest)
+
+		assert.NoError(t, err)
+		assert.Equal(t, model.ReservationConfirmed, reservation.Status)
+		assert.Equal(t, guest, reservation.Guest)
+	})
+	t.Run("ModifyReservation", func(t *testing.T) {
+		t.Run("Success", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockRepository.NewMockReservationRepository(ctrl)
+			eventRequest := make(chan model.EventRequest, 100)
+			logger := zap.NewNop()
+			svc := service.NewReservationService(mockRepo, logger, &eventRequest)
+
+			// Mock event processor
+			go func() {
+				for req := range eventRequest {
+					if req.Action == "modify" {
+						req.Response <- model.Reservation{Id: req.ResID, PartySize: req.PartySize, NumTables: 2, TableIds: []string{"T1", "T2"}}
+					}
+				}
+			}()
+
+			reservation, err := svc.ModifyReservation("r1", "res-1", 7)
+
+			assert.NoError(t, err)
+			assert.Equal(t, 7, reservation.PartySize)
+			assert.Equal(t, []string{"T1", "T2"}, reservation.TableIds)
+		})
+		t.Run("InvalidNumCustomers", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockRepository.NewMockReservationRepository(ctrl)
+			eventRequest := make(chan model.EventRequest, 100)
+			logger := zap.NewNop()
+			svc := service.NewReservationService(mockRepo, logger, &eventRequest)
+
+			reservation, err := svc.ModifyReservation("r1", "res-1", 0)
+
+			assert.EqualError(t, err, "number of customers must be greater than zero")
+			assert.Nil(t, reservation)
+		})
+		t.Run("ErrorFromProcessor", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockRepository.NewMockReservationRepository(ctrl)
+			eventRequest := make(chan model.EventRequest, 100)
+			logger := zap.NewNop()
+			svc := service.NewReservationService(mockRepo, logger, &eventRequest)
+
+			// Mock event processor
+			go func() {
+				for req := range eventRequest {
+					req.Response <- service.ErrNotEnoughTables
+				}
+			}()
+
+			reservation, err := svc.ModifyReservation("r1", "res-1", 12)
+
+			assert.ErrorIs(t, err, service.ErrNotEnoughTables)
+			assert.Nil(t, reservation)
 		})
 	})
 	t.Run("CancelReservation", func(t *testing.T) {
@@ -109,16 +266,40 @@ func TestReservationService(t *testing.T) {
 			go func() {
 				for req := range eventRequest {
 					if req.Action == "cancel" {
-						req.Response <- 2 // Mock returning 2 tables freed
+						req.Response <- model.Reservation{Id: req.ResID, NumTables: 2} // Mock returning 2 tables freed
 					}
 				}
 			}()
 
 			// Test cancellation
-			numTables, err := svc.CancelReservation("res-1")
+			reservation, err := svc.CancelReservation("r1", "res-1", false)
+
+			assert.NoError(t, err)
+			assert.Equal(t, 2, reservation.NumTables)
+		})
+		t.Run("WaiveFee", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockRepository.NewMockReservationRepository(ctrl)
+			eventRequest := make(chan model.EventRequest, 100)
+			logger := zap.NewNop()
+			svc := service.NewReservationService(mockRepo, logger, &eventRequest)
+
+			// Mock event processor
+			go func() {
+				for req := range eventRequest {
+					if req.Action == "cancel" {
+						assert.True(t, req.WaiveFee)
+						req.Response <- model.Reservation{Id: req.ResID, Status: model.ReservationCancelled, Cancellation: &model.Cancellation{Waived: true}}
+					}
+				}
+			}()
+
+			reservation, err := svc.CancelReservation("r1", "res-1", true)
 
 			assert.NoError(t, err)
-			assert.Equal(t, 2, numTables)
+			assert.True(t, reservation.Cancellation.Waived)
 		})
 		t.Run("ErrorFromProcessor", func(t *testing.T) {
 			ctrl := gomock.NewController(t)
@@ -139,11 +320,410 @@ func TestReservationService(t *testing.T) {
 			}()
 
 			// Test cancellation
-			numTables, err := svc.CancelReservation("res-1")
+			reservation, err := svc.CancelReservation("r1", "res-1", false)
 
 			assert.Error(t, err)
 			assert.Equal(t, "cancellation failed", err.Error())
-			assert.Equal(t, 0, numTables)
+			assert.Nil(t, reservation)
+		})
+	})
+	t.Run("UpdateStatus", func(t *testing.T) {
+		for _, tc := range []struct {
+			action string
+			status model.ReservationStatus
+			call   func(svc *service.ReservationServiceImpl) (*model.Reservation, error)
+		}{
+			{"seat", model.ReservationSeated, func(svc *service.ReservationServiceImpl) (*model.Reservation, error) {
+				return svc.SeatReservation("r1", "res-1")
+			}},
+			{"complete", model.ReservationCompleted, func(svc *service.ReservationServiceImpl) (*model.Reservation, error) {
+				return svc.CompleteReservation("r1", "res-1")
+			}},
+			{"no_show", model.ReservationNoShow, func(svc *service.ReservationServiceImpl) (*model.Reservation, error) {
+				return svc.MarkNoShow("r1", "res-1")
+			}},
+		} {
+			t.Run(tc.action, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				mockRepo := mockRepository.NewMockReservationRepository(ctrl)
+				eventRequest := make(chan model.EventRequest, 100)
+				logger := zap.NewNop()
+				svc := service.NewReservationService(mockRepo, logger, &eventRequest)
+
+				// Mock event processor
+				go func() {
+					for req := range eventRequest {
+						if req.Action == tc.action {
+							req.Response <- model.Reservation{Id: req.ResID, RestaurantId: req.RestaurantId, Status: tc.status}
+						} else {
+							req.Response <- errors.New("unexpected action")
+						}
+					}
+				}()
+
+				reservation, err := tc.call(svc)
+
+				assert.NoError(t, err)
+				assert.Equal(t, tc.status, reservation.Status)
+			})
+		}
+	})
+	t.Run("FindReservation", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mockRepository.NewMockReservationRepository(ctrl)
+		eventRequest := make(chan model.EventRequest, 100)
+		logger := zap.NewNop()
+		svc := service.NewReservationService(mockRepo, logger, &eventRequest)
+
+		mockRepo.EXPECT().FindReservationById("r1", "res-1").Return(&model.Reservation{Id: "res-1", Status: model.ReservationCancelled}, nil).Times(1)
+
+		reservation, err := svc.FindReservation("r1", "res-1")
+
+		assert.NoError(t, err)
+		assert.Equal(t, model.ReservationCancelled, reservation.Status)
+	})
+	t.Run("SeatWalkIn", func(t *testing.T) {
+		t.Run("Success", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockRepository.NewMockReservationRepository(ctrl)
+			eventRequest := make(chan model.EventRequest, 100)
+			logger := zap.NewNop()
+			svc := service.NewReservationService(mockRepo, logger, &eventRequest)
+
+			// Mock event processor
+			go func() {
+				for req := range eventRequest {
+					if req.Action == "walk_in" {
+						req.Response <- model.Reservation{Id: "res-1", PartySize: req.PartySize, Guest: req.Guest, Status: model.ReservationSeated, WalkIn: true}
+					}
+				}
+			}()
+
+			reservation, err := svc.SeatWalkIn("r1", 2, model.Guest{Name: "Sam Lee"}, 0)
+
+			assert.NoError(t, err)
+			assert.Equal(t, model.ReservationSeated, reservation.Status)
+			assert.True(t, reservation.WalkIn)
+			assert.Equal(t, "Sam Lee", reservation.Guest.Name)
+		})
+		t.Run("Wait", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockRepository.NewMockReservationRepository(ctrl)
+			eventRequest := make(chan model.EventRequest, 100)
+			logger := zap.NewNop()
+			svc := service.NewReservationService(mockRepo, logger, &eventRequest)
+
+			// Mock event processor
+			go func() {
+				for req := range eventRequest {
+					req.Response <- &service.WaitError{EstimatedWait: 44*time.Minute + 30*time.Second}
+				}
+			}()
+
+			_, err := svc.SeatWalkIn("r1", 2, model.Guest{}, 0)
+
+			assert.EqualError(t, err, "no table is free, estimated wait is 45 minutes")
+			assert.ErrorIs(t, err, service.ErrNotEnoughTables)
 		})
+		t.Run("InvalidCustomers", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockRepository.NewMockReservationRepository(ctrl)
+			eventRequest := make(chan model.EventRequest, 100)
+			logger := zap.NewNop()
+			svc := service.NewReservationService(mockRepo, logger, &eventRequest)
+
+			_, err := svc.SeatWalkIn("r1", 0, model.Guest{}, 0)
+
+			assert.EqualError(t, err, "number of customers must be greater than zero")
+		})
+	})
+	t.Run("BookEvent", func(t *testing.T) {
+		start := time.Date(2025, 1, 10, 18, 0, 0, 0, time.UTC)
+		end := start.Add(4 * time.Hour)
+
+		t.Run("Success", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockRepository.NewMockReservationRepository(ctrl)
+			eventRequest := make(chan model.EventRequest, 100)
+			logger := zap.NewNop()
+			svc := service.NewReservationService(mockRepo, logger, &eventRequest)
+
+			// Mock event processor
+			requests := make(chan model.EventRequest, 1)
+			go func() {
+				for req := range eventRequest {
+					if req.Action == "book_event" {
+						requests <- req
+						req.Response <- model.Reservation{Id: "res-1", PartySize: req.PartySize, Type: model.ReservationTypeEvent, DepositStatus: req.DepositStatus}
+					}
+				}
+			}()
+
+			reservation, err := svc.BookEvent("r1", 40, model.Guest{Name: "Sam Lee"}, model.SectionPrivateRoom, start, end, model.DepositPending)
+
+			assert.NoError(t, err)
+			assert.True(t, reservation.IsEvent())
+			assert.Equal(t, model.DepositPending, reservation.DepositStatus)
+
+			req := <-requests
+			assert.Equal(t, start, req.StartTime)
+			assert.Equal(t, 4*time.Hour, req.Duration)
+			assert.Equal(t, model.Seating{Section: model.SectionPrivateRoom}, req.Requirements)
+		})
+		t.Run("Invalid", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockRepository.NewMockReservationRepository(ctrl)
+			eventRequest := make(chan model.EventRequest, 100)
+			logger := zap.NewNop()
+			svc := service.NewReservationService(mockRepo, logger, &eventRequest)
+
+			_, err := svc.BookEvent("r1", 0, model.Guest{}, "", start, end, "")
+			assert.EqualError(t, err, "headcount must be greater than zero")
+
+			_, err = svc.BookEvent("r1", 40, model.Guest{}, "", start, time.Time{}, "")
+			assert.EqualError(t, err, "start and end time are required")
+
+			_, err = svc.BookEvent("r1", 40, model.Guest{}, "", start, start, "")
+			assert.EqualError(t, err, "end time must be after start time")
+			assert.Empty(t, eventRequest)
+		})
+	})
+	t.Run("UpdateDepositStatus", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mockRepository.NewMockReservationRepository(ctrl)
+		eventRequest := make(chan model.EventRequest, 100)
+		logger := zap.NewNop()
+		svc := service.NewReservationService(mockRepo, logger, &eventRequest)
+
+		// Mock event processor
+		go func() {
+			for req := range eventRequest {
+				if req.Action == "update_deposit" {
+					req.Response <- model.Reservation{Id: req.ResID, Type: model.ReservationTypeEvent, DepositStatus: req.DepositStatus}
+				}
+			}
+		}()
+
+		reservation, err := svc.UpdateDepositStatus("r1", "res-1", model.DepositPaid)
+
+		assert.NoError(t, err)
+		assert.Equal(t, model.DepositPaid, reservation.DepositStatus)
+	})
+	t.Run("PayDeposit", func(t *testing.T) {
+		t.Run("Success", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockRepository.NewMockReservationRepository(ctrl)
+			eventRequest := make(chan model.EventRequest, 100)
+			logger := zap.NewNop()
+			svc := service.NewReservationService(mockRepo, logger, &eventRequest)
+
+			// Mock event processor
+			go func() {
+				for req := range eventRequest {
+					if req.Action == "pay" {
+						assert.Equal(t, "tok_visa", req.PaymentToken)
+						req.Response <- model.Reservation{Id: req.ResID, Status: model.ReservationConfirmed, DepositStatus: model.DepositPaid, PaymentId: "pay-1"}
+					}
+				}
+			}()
+
+			reservation, err := svc.PayDeposit("r1", "res-1", "tok_visa")
+
+			assert.NoError(t, err)
+			assert.Equal(t, model.ReservationConfirmed, reservation.Status)
+			assert.Equal(t, "pay-1", reservation.PaymentId)
+		})
+		t.Run("Declined", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockRepository.NewMockReservationRepository(ctrl)
+			eventRequest := make(chan model.EventRequest, 100)
+			logger := zap.NewNop()
+			svc := service.NewReservationService(mockRepo, logger, &eventRequest)
+
+			// Mock event processor
+			go func() {
+				for req := range eventRequest {
+					if req.Action == "pay" {
+						req.Response <- service.ErrPaymentDeclined
+					}
+				}
+			}()
+
+			reservation, err := svc.PayDeposit("r1", "res-1", "tok_declined")
+
+			assert.ErrorIs(t, err, service.ErrPaymentDeclined)
+			assert.Nil(t, reservation)
+		})
+		t.Run("MissingToken", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockRepository.NewMockReservationRepository(ctrl)
+			eventRequest := make(chan model.EventRequest, 100)
+			logger := zap.NewNop()
+			svc := service.NewReservationService(mockRepo, logger, &eventRequest)
+
+			reservation, err := svc.PayDeposit("r1", "res-1", "")
+
+			assert.EqualError(t, err, "payment token must not be empty")
+			assert.Nil(t, reservation)
+		})
+	})
+	t.Run("ReserveSeries", func(t *testing.T) {
+		t.Run("Success", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockRepository.NewMockReservationRepository(ctrl)
+			eventRequest := make(chan model.EventRequest, 100)
+			logger := zap.NewNop()
+			svc := service.NewReservationService(mockRepo, logger, &eventRequest)
+
+			// Mock event processor
+			requests := make(chan model.EventRequest, 1)
+			go func() {
+				for req := range eventRequest {
+					if req.Action == "reserve_series" {
+						requests <- req
+						occurrences := make([]model.Reservation, 0, len(req.Slots))
+						for _, start := range req.Slots {
+							occurrences = append(occurrences, model.Reservation{Id: uuid.New().String(), StartTime: start, SeriesId: req.SeriesId})
+						}
+						req.Response <- occurrences
+					}
+				}
+			}()
+
+			occurrences, err := svc.ReserveSeries("r1", 2, guest, startTime, 0, model.Seating{}, model.Seating{}, model.Recurrence{IntervalWeeks: 2, Until: time.Date(2025, 2, 7, 0, 0, 0, 0, time.UTC)})
+
+			assert.NoError(t, err)
+			assert.Len(t, occurrences, 3)
+
+			// Occurrences may fall on the Until day itself.
+			req := <-requests
+			assert.Equal(t, []time.Time{startTime, startTime.AddDate(0, 0, 14), startTime.AddDate(0, 0, 28)}, req.Slots)
+			assert.NotEmpty(t, req.SeriesId)
+			assert.Equal(t, req.SeriesId, occurrences[0].SeriesId)
+		})
+		t.Run("Count", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockRepository.NewMockReservationRepository(ctrl)
+			eventRequest := make(chan model.EventRequest, 100)
+			logger := zap.NewNop()
+			svc := service.NewReservationService(mockRepo, logger, &eventRequest)
+
+			// Mock event processor
+			requests := make(chan model.EventRequest, 1)
+			go func() {
+				for req := range eventRequest {
+					if req.Action == "reserve_series" {
+						requests <- req
+						req.Response <- []model.Reservation{}
+					}
+				}
+			}()
+
+			// The series stops at whichever of the count and the end date comes first.
+			_, err := svc.ReserveSeries("r1", 2, guest, startTime, 0, model.Seating{}, model.Seating{}, model.Recurrence{Count: 2, Until: time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC)})
+
+			assert.NoError(t, err)
+			req := <-requests
+			assert.Equal(t, []time.Time{startTime, startTime.AddDate(0, 0, 7)}, req.Slots)
+		})
+		t.Run("Conflict", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockRepository.NewMockReservationRepository(ctrl)
+			eventRequest := make(chan model.EventRequest, 100)
+			logger := zap.NewNop()
+			svc := service.NewReservationService(mockRepo, logger, &eventRequest)
+
+			// Mock event processor
+			go func() {
+				for req := range eventRequest {
+					if req.Action == "reserve_series" {
+						req.Response <- &service.RecurrenceConflictError{Occurrences: []service.OccurrenceConflict{{StartTime: req.Slots[1], Reason: "not enough tables available"}}}
+					}
+				}
+			}()
+
+			_, err := svc.ReserveSeries("r1", 2, guest, startTime, 0, model.Seating{}, model.Seating{}, model.Recurrence{Count: 2})
+
+			var conflictErr *service.RecurrenceConflictError
+			assert.ErrorAs(t, err, &conflictErr)
+			assert.EqualError(t, err, "cannot book the occurrences starting 2025-01-17T19:00:00Z")
+		})
+		t.Run("Invalid", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockRepository.NewMockReservationRepository(ctrl)
+			eventRequest := make(chan model.EventRequest, 100)
+			logger := zap.NewNop()
+			svc := service.NewReservationService(mockRepo, logger, &eventRequest)
+
+			_, err := svc.ReserveSeries("r1", 0, guest, startTime, 0, model.Seating{}, model.Seating{}, model.Recurrence{Count: 2})
+			assert.EqualError(t, err, "number of customers must be greater than zero")
+
+			_, err = svc.ReserveSeries("r1", 2, guest, startTime, 0, model.Seating{}, model.Seating{}, model.Recurrence{})
+			assert.EqualError(t, err, "recurrence needs an end date or an occurrence count")
+
+			_, err = svc.ReserveSeries("r1", 2, guest, startTime, 0, model.Seating{}, model.Seating{}, model.Recurrence{IntervalWeeks: -1, Count: 2})
+			assert.EqualError(t, err, "recurrence interval must not be negative")
+
+			_, err = svc.ReserveSeries("r1", 2, guest, startTime, 0, model.Seating{}, model.Seating{}, model.Recurrence{Until: time.Date(2025, 1, 9, 0, 0, 0, 0, time.UTC)})
+			assert.EqualError(t, err, "recurrence must not end before its first occurrence")
+
+			_, err = svc.ReserveSeries("r1", 2, guest, startTime, 0, model.Seating{}, model.Seating{}, model.Recurrence{Count: model.MaxOccurrences + 1})
+			assert.EqualError(t, err, "recurrence must not exceed 52 occurrences")
+			assert.Empty(t, eventRequest)
+		})
+	})
+	t.Run("CancelSeries", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mockRepository.NewMockReservationRepository(ctrl)
+		eventRequest := make(chan model.EventRequest, 100)
+		logger := zap.NewNop()
+		svc := service.NewReservationService(mockRepo, logger, &eventRequest)
+
+		// Mock event processor
+		go func() {
+			for req := range eventRequest {
+				if req.Action == "cancel_series" {
+					assert.True(t, req.WaiveFee)
+					req.Response <- service.SeriesCancellation{Cancelled: []model.Reservation{{Id: "res-2", SeriesId: req.SeriesId, Status: model.ReservationCancelled}}}
+				}
+			}
+		}()
+
+		cancelled, err := svc.CancelSeries("r1", "series-1", true)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []model.Reservation{{Id: "res-2", SeriesId: "series-1", Status: model.ReservationCancelled}}, cancelled.Cancelled)
 	})
 }
@@ -0,0 +1,48 @@
+package service
+
+import (
+	"errors"
+	"github.com/bossncn/restaurant-reservation-service/internal/core/model"
+	"github.com/bossncn/restaurant-reservation-service/internal/core/repository"
+	"go.uber.org/zap"
+	"regexp"
+)
+
+var restaurantIdPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+
+type RestaurantService interface {
+	CreateRestaurant(restaurant model.Restaurant) (*model.Restaurant, error)
+	FindRestaurant(id string) (*model.Restaurant, error)
+	ListRestaurants() []model.Restaurant
+}
+
+type RestaurantServiceImpl struct {
+	restaurantRepo repository.RestaurantRepository
+	logger         *zap.Logger
+}
+
+func NewRestaurantService(repo repository.RestaurantRepository, logger *zap.Logger) *RestaurantServiceImpl {
+	return &RestaurantServiceImpl{
+		restaurantRepo: repo,
+		logger:         logger,
+	}
+}
+
+func (s *RestaurantServiceImpl) CreateRestaurant(restaurant model.Restaurant) (*model.Restaurant, error) {
+	if restaurant.Name == "" {
+		return nil, errors.New("restaurant name is required")
+	}
+	if restaurant.Id != "" && !restaurantIdPattern.MatchString(restaurant.Id) {
+		return nil, errors.New("restaurant id must contain only lowercase letters, digits and dashes")
+	}
+
+	return s.restaurantRepo.CreateRestaurant(restaurant)
+}
+
+func (s *RestaurantServiceImpl) FindRestaurant(id string) (*model.Restaurant, error) {
+	return s.restaurantRepo.FindRestaurantById(id)
+}
+
+func (s *RestaurantServiceImpl) ListRestaurants() []model.Restaurant {
+	return s.restaurantRepo.ListRestaurants()
+}
@@ -0,0 +1,80 @@
+package service_test
+
+import (
+	"errors"
+	"github.com/bossncn/restaurant-reservation-service/internal/core/model"
+	mockRepository "github.com/bossncn/restaurant-reservation-service/internal/core/repository/mock"
+	"github.com/bossncn/restaurant-reservation-service/internal/core/service"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"testing"
+)
+
+func TestRestaurantService(t *testing.T) {
+	t.Run("CreateRestaurant", func(t *testing.T) {
+		t.Run("Success", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockRepository.NewMockRestaurantRepository(ctrl)
+			svc := service.NewRestaurantService(mockRepo, zap.NewNop())
+
+			mockRepo.EXPECT().CreateRestaurant(model.Restaurant{Id: "downtown", Name: "Downtown"}).Return(&model.Restaurant{Id: "downtown", Name: "Downtown"}, nil).Times(1)
+
+			restaurant, err := svc.CreateRestaurant(model.Restaurant{Id: "downtown", Name: "Downtown"})
+
+			assert.NoError(t, err)
+			assert.Equal(t, "downtown", restaurant.Id)
+		})
+		t.Run("MissingName", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockRepository.NewMockRestaurantRepository(ctrl)
+			svc := service.NewRestaurantService(mockRepo, zap.NewNop())
+
+			restaurant, err := svc.CreateRestaurant(model.Restaurant{Id: "downtown"})
+
+			assert.EqualError(t, err, "restaurant name is required")
+			assert.Nil(t, restaurant)
+		})
+		t.Run("InvalidId", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockRepository.NewMockRestaurantRepository(ctrl)
+			svc := service.NewRestaurantService(mockRepo, zap.NewNop())
+
+			restaurant, err := svc.CreateRestaurant(model.Restaurant{Id: "Down Town", Name: "Downtown"})
+
+			assert.EqualError(t, err, "restaurant id must contain only lowercase letters, digits and dashes")
+			assert.Nil(t, restaurant)
+		})
+		t.Run("RepositoryError", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockRepository.NewMockRestaurantRepository(ctrl)
+			svc := service.NewRestaurantService(mockRepo, zap.NewNop())
+
+			mockRepo.EXPECT().CreateRestaurant(gomock.Any()).Return(nil, errors.New("restaurant downtown already exists")).Times(1)
+
+			restaurant, err := svc.CreateRestaurant(model.Restaurant{Id: "downtown", Name: "Downtown"})
+
+			assert.EqualError(t, err, "restaurant downtown already exists")
+			assert.Nil(t, restaurant)
+		})
+	})
+	t.Run("ListRestaurants", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mockRepository.NewMockRestaurantRepository(ctrl)
+		svc := service.NewRestaurantService(mockRepo, zap.NewNop())
+
+		mockRepo.EXPECT().ListRestaurants().Return([]model.Restaurant{{Id: "downtown", Name: "Downtown"}}).Times(1)
+
+		assert.Len(t, svc.ListRestaurants(), 1)
+	})
+}
@@ -0,0 +1,95 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"github.com/bossncn/restaurant-reservation-service/internal/core/model"
+	"github.com/bossncn/restaurant-reservation-service/internal/core/repository"
+	"go.uber.org/zap"
+	"time"
+)
+
+type ScheduleService interface {
+	SetSchedule(schedule model.Schedule) (*model.Schedule, error)
+	FindSchedule(restaurantId string) (*model.Schedule, error)
+}
+
+type ScheduleServiceImpl struct {
+	scheduleRepo repository.ScheduleRepository
+	logger       *zap.Logger
+}
+
+func NewScheduleService(repo repository.ScheduleRepository, logger *zap.Logger) *ScheduleServiceImpl {
+	return &ScheduleServiceImpl{
+		scheduleRepo: repo,
+		logger:       logger,
+	}
+}
+
+// SetSchedule replaces the restaurant's opening hours and closures.
+func (s *ScheduleServiceImpl) SetSchedule(schedule model.Schedule) (*model.Schedule, error) {
+	if _, err := schedule.Location(); err != nil {
+		return nil, fmt.Errorf("unknown time zone %s", schedule.TimeZone)
+	}
+	for i, shift := range schedule.Shifts {
+		if shift.Name == "" {
+			return nil, fmt.Errorf("shift %d name is required", i+1)
+		}
+		if shift.Weekday < time.Sunday || shift.Weekday > time.Saturday {
+			return nil, fmt.Errorf("shift %s has an invalid weekday", shift.Name)
+		}
+		if shift.Opens < 0 || shift.Opens >= 24*time.Hour || shift.Opens >= shift.Closes || shift.Closes > shift.Opens+24*time.Hour {
+			return nil, fmt.Errorf("shift %s must open before midnight and close within a day of opening", shift.Name)
+		}
+		if shift.MaxTables < 0 {
+			return nil, fmt.Errorf("shift %s max tables must not be negative", shift.Name)
+		}
+		if shift.Pacing.Interval < 0 || shift.Pacing.MaxCovers < 0 || shift.Pacing.MaxParties < 0 {
+			return nil, fmt.Errorf("shift %s pacing must not be negative", shift.Name)
+		}
+		if shift.Overbooking.Percent < 0 || shift.Overbooking.Percent > 100 {
+			return nil, fmt.Errorf("shift %s overbooking percent must be between 0 and 100", shift.Name)
+		}
+		if shift.Overbooking.Tables < 0 {
+			return nil, fmt.Errorf("shift %s overbooking tables must not be negative", shift.Name)
+		}
+		if !shift.Pacing.IsEmpty() && shift.Pacing.Interval == 0 {
+			schedule.Shifts[i].Pacing.Interval = model.DefaultPacingInterval
+		}
+		for _, other := range schedule.Shifts[:i] {
+			if overlaps(shift, other) {
+				return nil, fmt.Errorf("shift %s overlaps shift %s", shift.Name, other.Name)
+			}
+		}
+	}
+	for _, closure := range schedule.Closures {
+		if _, err := time.Parse("2006-01-02", closure.Date); err != nil {
+			return nil, errors.New("closure date must be formatted as YYYY-MM-DD")
+		}
+	}
+
+	if err := s.scheduleRepo.SaveSchedule(schedule); err != nil {
+		return nil, err
+	}
+	return &schedule, nil
+}
+
+func (s *ScheduleServiceImpl) FindSchedule(restaurantId string) (*model.Schedule, error) {
+	return s.scheduleRepo.FindScheduleByRestaurantId(restaurantId)
+}
+
+// overlaps reports whether two shifts are open at the same time in the week, including a shift that
+// runs past midnight into the next day, or past Saturday midnight into Sunday.
+func overlaps(a model.Shift, b model.Shift) bool {
+	const day, week = 24 * time.Hour, 7 * 24 * time.Hour
+	aOpens := time.Duration(a.Weekday)*day + a.Opens
+	aCloses := time.Duration(a.Weekday)*day + a.Closes
+	for _, offset := range []time.Duration{-week, 0, week} {
+		bOpens := time.Duration(b.Weekday)*day + b.Opens + offset
+		bCloses := time.Duration(b.Weekday)*day + b.Closes + offset
+		if aOpens < bCloses && bOpens < aCloses {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,141 @@
+package service_test
+
+import (
+	"errors"
+	"github.com/bossncn/restaurant-reservation-service/internal/core/model"
+	mockRepository "github.com/bossncn/restaurant-reservation-service/internal/core/repository/mock"
+	"github.com/bossncn/restaurant-reservation-service/internal/core/service"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"testing"
+	"time"
+)
+
+func TestScheduleService(t *testing.T) {
+	lunch := model.Shift{Name: "Lunch", Weekday: time.Monday, Opens: 11 * time.Hour, Closes: 14 * time.Hour}
+	dinner := model.Shift{Name: "Dinner", Weekday: time.Monday, Opens: 17 * time.Hour, Closes: 22 * time.Hour, MaxTables: 5}
+
+	t.Run("SetSchedule", func(t *testing.T) {
+		t.Run("Success", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockRepository.NewMockScheduleRepository(ctrl)
+			svc := service.NewScheduleService(mockRepo, zap.NewNop())
+
+			schedule := model.Schedule{
+				RestaurantId: "r1",
+				TimeZone:     "Asia/Bangkok",
+				Shifts:       []model.Shift{lunch, dinner},
+				Closures:     []model.Closure{{Date: "2025-04-13", Reason: "Songkran"}},
+			}
+			mockRepo.EXPECT().SaveSchedule(schedule).Return(nil).Times(1)
+
+			saved, err := svc.SetSchedule(schedule)
+
+			assert.NoError(t, err)
+			assert.Equal(t, schedule, *saved)
+		})
+		t.Run("Invalid", func(t *testing.T) {
+			tests := []struct {
+				name     string
+				schedule model.Schedule
+				err      string
+			}{
+				{"UnknownTimeZone", model.Schedule{TimeZone: "Mars/Olympus"}, "unknown time zone Mars/Olympus"},
+				{"MissingName", model.Schedule{Shifts: []model.Shift{{Weekday: time.Monday, Opens: time.Hour, Closes: 2 * time.Hour}}}, "shift 1 name is required"},
+				{"InvalidWeekday", model.Schedule{Shifts: []model.Shift{{Name: "Lunch", Weekday: 7, Opens: time.Hour, Closes: 2 * time.Hour}}}, "shift Lunch has an invalid weekday"},
+				{"ClosesBeforeOpening", model.Schedule{Shifts: []model.Shift{{Name: "Lunch", Weekday: time.Monday, Opens: 14 * time.Hour, Closes: 11 * time.Hour}}}, "shift Lunch must open before midnight and close within a day of opening"},
+				{"ClosesMoreThanADayLater", model.Schedule{Shifts: []model.Shift{{Name: "Late", Weekday: time.Monday, Opens: 18 * time.Hour, Closes: 43 * time.Hour}}}, "shift Late must open before midnight and close within a day of opening"},
+				{"NegativeMaxTables", model.Schedule{Shifts: []model.Shift{{Name: "Lunch", Weekday: time.Monday, Opens: time.Hour, Closes: 2 * time.Hour, MaxTables: -1}}}, "shift Lunch max tables must not be negative"},
+				{"NegativePacing", model.Schedule{Shifts: []model.Shift{{Name: "Lunch", Weekday: time.Monday, Opens: time.Hour, Closes: 2 * time.Hour, Pacing: model.Pacing{MaxCovers: -1}}}}, "shift Lunch pacing must not be negative"},
+				{"OverbookingPercentTooHigh", model.Schedule{Shifts: []model.Shift{{Name: "Lunch", Weekday: time.Monday, Opens: time.Hour, Closes: 2 * time.Hour, Overbooking: model.Overbooking{Percent: 101}}}}, "shift Lunch overbooking percent must be between 0 and 100"},
+				{"NegativeOverbookingTables", model.Schedule{Shifts: []model.Shift{{Name: "Lunch", Weekday: time.Monday, Opens: time.Hour, Closes: 2 * time.Hour, Overbooking: model.Overbooking{Tables: -1}}}}, "shift Lunch overbooking tables must not be negative"},
+				{"Overlapping", model.Schedule{Shifts: []model.Shift{lunch, {Name: "Brunch", Weekday: time.Monday, Opens: 10 * time.Hour, Closes: 12 * time.Hour}}}, "shift Brunch overlaps shift Lunch"},
+				{"OverlappingNextMorning", model.Schedule{Shifts: []model.Shift{{Name: "Late", Weekday: time.Saturday, Opens: 18 * time.Hour, Closes: 26 * time.Hour}, {Name: "Breakfast", Weekday: time.Sunday, Opens: time.Hour, Closes: 4 * time.Hour}}}, "shift Breakfast overlaps shift Late"},
+				{"InvalidClosureDate", model.Schedule{Closures: []model.Closure{{Date: "13/04/2025"}}}, "closure date must be formatted as YYYY-MM-DD"},
+			}
+			for _, tt := range tests {
+				t.Run(tt.name, func(t *testing.T) {
+					ctrl := gomock.NewController(t)
+					defer ctrl.Finish()
+
+					mockRepo := mockRepository.NewMockScheduleRepository(ctrl)
+					svc := service.NewScheduleService(mockRepo, zap.NewNop())
+
+					_, err := svc.SetSchedule(tt.schedule)
+
+					assert.EqualError(t, err, tt.err)
+				})
+			}
+		})
+		t.Run("DefaultPacingInterval", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockRepository.NewMockScheduleRepository(ctrl)
+			svc := service.NewScheduleService(mockRepo, zap.NewNop())
+
+			paced := dinner
+			paced.Pacing = model.Pacing{MaxCovers: 20}
+			expected := paced
+			expected.Pacing.Interval = model.DefaultPacingInterval
+			mockRepo.EXPECT().SaveSchedule(model.Schedule{RestaurantId: "r1", Shifts: []model.Shift{lunch, expected}}).Return(nil).Times(1)
+
+			saved, err := svc.SetSchedule(model.Schedule{RestaurantId: "r1", Shifts: []model.Shift{lunch, paced}})
+
+			assert.NoError(t, err)
+			assert.Equal(t, 15*time.Minute, saved.Shifts[1].Pacing.Interval)
+			// Shifts without limits are not paced.
+			assert.Zero(t, saved.Shifts[0].Pacing.Interval)
+		})
+		t.Run("SameHoursOnAnotherDay", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockRepository.NewMockScheduleRepository(ctrl)
+			svc := service.NewScheduleService(mockRepo, zap.NewNop())
+
+			tuesdayLunch := lunch
+			tuesdayLunch.Weekday = time.Tuesday
+			schedule := model.Schedule{RestaurantId: "r1", Shifts: []model.Shift{lunch, tuesdayLunch}}
+			mockRepo.EXPECT().SaveSchedule(schedule).Return(nil).Times(1)
+
+			_, err := svc.SetSchedule(schedule)
+
+			assert.NoError(t, err)
+		})
+		t.Run("PastMidnight", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockRepository.NewMockScheduleRepository(ctrl)
+			svc := service.NewScheduleService(mockRepo, zap.NewNop())
+
+			// Monday's late shift closes at 2am on Tuesday, before Tuesday lunch opens.
+			late := model.Shift{Name: "Late", Weekday: time.Monday, Opens: 22 * time.Hour, Closes: 26 * time.Hour}
+			tuesdayLunch := lunch
+			tuesdayLunch.Weekday = time.Tuesday
+			schedule := model.Schedule{RestaurantId: "r1", Shifts: []model.Shift{late, tuesdayLunch}}
+			mockRepo.EXPECT().SaveSchedule(schedule).Return(nil).Times(1)
+
+			_, err := svc.SetSchedule(schedule)
+
+			assert.NoError(t, err)
+		})
+	})
+	t.Run("FindSchedule", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mockRepository.NewMockScheduleRepository(ctrl)
+		svc := service.NewScheduleService(mockRepo, zap.NewNop())
+
+		mockRepo.EXPECT().FindScheduleByRestaurantId("r1").Return(nil, errors.New("schedule not found")).Times(1)
+
+		_, err := svc.FindSchedule("r1")
+
+		assert.EqualError(t, err, "schedule not found")
+	})
+}
@@ -0,0 +1,114 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+const (
+	SizingFixed  = "fixed"
+	SizingLookup = "lookup"
+)
+
+// SizingPolicy decides which parties a venue takes and how many tables a party may be spread across.
+type SizingPolicy interface {
+	// Check rejects party sizes the venue does not seat.
+	Check(partySize int) error
+	// MaxTables returns the most tables the party may be seated at, or zero when any combination will do.
+	MaxTables(partySize int) int
+}
+
+// NewSizingPolicy builds the named policy. Every policy enforces the party size limits; the empty
+// policy leaves the number of tables to the allocator.
+func NewSizingPolicy(policy string, limits PartySizeLimits, seatsPerTable int, partyTables map[int]int) (SizingPolicy, error) {
+	if limits.Min < 0 || limits.Max < 0 {
+		return nil, errors.New("party size limits must not be negative")
+	}
+	if limits.Max > 0 && limits.Max < limits.Min {
+		return nil, errors.New("maximum party size must not be below the minimum party size")
+	}
+
+	switch policy {
+	case "":
+		return limits, nil
+	case SizingFixed:
+		if seatsPerTable <= 0 {
+			return nil, errors.New("seats per table must be greater than zero")
+		}
+		return &FixedSizing{PartySizeLimits: limits, SeatsPerTable: seatsPerTable}, nil
+	case SizingLookup:
+		if len(partyTables) == 0 {
+			return nil, errors.New("party tables must not be empty")
+		}
+		rules := make([]PartyTablesRule, 0, len(partyTables))
+		for partySize, maxTables := range partyTables {
+			if partySize <= 0 {
+				return nil, errors.New("party tables party size must be greater than zero")
+			}
+			if maxTables <= 0 {
+				return nil, errors.New("party tables table count must be greater than zero")
+			}
+			rules = append(rules, PartyTablesRule{MinPartySize: partySize, MaxTables: maxTables})
+		}
+		sort.Slice(rules, func(i, j int) bool { return rules[i].MinPartySize < rules[j].MinPartySize })
+		return &LookupSizing{PartySizeLimits: limits, Rules: rules}, nil
+	default:
+		return nil, fmt.Errorf("unknown sizing policy %q", policy)
+	}
+}
+
+// PartySizeLimits seats parties of Min to Max guests. A zero limit is not enforced.
+type PartySizeLimits struct {
+	Min int
+	Max int
+}
+
+func (l PartySizeLimits) Check(partySize int) error {
+	if l.Max > 0 && partySize > l.Max {
+		return fmt.Errorf("party size %d exceeds the maximum of %d", partySize, l.Max)
+	}
+	if partySize < l.Min {
+		return fmt.Errorf("party size %d is below the minimum of %d", partySize, l.Min)
+	}
+	return nil
+}
+
+func (l PartySizeLimits) MaxTables(int) int {
+	return 0
+}
+
+// FixedSizing gives a party one table per SeatsPerTable guests, rounding up.
+type FixedSizing struct {
+	PartySizeLimits
+	SeatsPerTable int
+}
+
+func (s *FixedSizing) MaxTables(partySize int) int {
+	return (partySize + s.SeatsPerTable - 1) / s.SeatsPerTable
+}
+
+// PartyTablesRule lets parties of at least MinPartySize guests use up to MaxTables tables.
+type PartyTablesRule struct {
+	MinPartySize int
+	MaxTables    int
+}
+
+// LookupSizing reads the table count from the largest rule the party reaches. Parties smaller
+// than every rule may use any combination.
+type LookupSizing struct {
+	PartySizeLimits
+	// Rules are ordered by MinPartySize.
+	Rules []PartyTablesRule
+}
+
+func (s *LookupSizing) MaxTables(partySize int) int {
+	maxTables := 0
+	for _, rule := range s.Rules {
+		if partySize < rule.MinPartySize {
+			break
+		}
+		maxTables = rule.MaxTables
+	}
+	return maxTables
+}
@@ -0,0 +1,73 @@
+package service_test
+
+import (
+	"github.com/bossncn/restaurant-reservation-service/internal/core/service"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestSizingPolicy(t *testing.T) {
+	t.Run("NewSizingPolicy", func(t *testing.T) {
+		t.Run("Success", func(t *testing.T) {
+			limits := service.PartySizeLimits{Min: 1, Max: 12}
+
+			policy, err := service.NewSizingPolicy("", limits, 0, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, limits, policy)
+
+			policy, err = service.NewSizingPolicy(service.SizingFixed, limits, 4, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, &service.FixedSizing{PartySizeLimits: limits, SeatsPerTable: 4}, policy)
+
+			policy, err = service.NewSizingPolicy(service.SizingLookup, limits, 0, map[int]int{5: 2, 1: 1})
+			assert.NoError(t, err)
+			assert.Equal(t, &service.LookupSizing{PartySizeLimits: limits, Rules: []service.PartyTablesRule{{MinPartySize: 1, MaxTables: 1}, {MinPartySize: 5, MaxTables: 2}}}, policy)
+		})
+		t.Run("Invalid", func(t *testing.T) {
+			_, err := service.NewSizingPolicy("", service.PartySizeLimits{Min: -1}, 0, nil)
+			assert.EqualError(t, err, "party size limits must not be negative")
+
+			_, err = service.NewSizingPolicy("", service.PartySizeLimits{Min: 4, Max: 2}, 0, nil)
+			assert.EqualError(t, err, "maximum party size must not be below the minimum party size")
+
+			_, err = service.NewSizingPolicy(service.SizingFixed, service.PartySizeLimits{}, 0, nil)
+			assert.EqualError(t, err, "seats per table must be greater than zero")
+
+			_, err = service.NewSizingPolicy(service.SizingLookup, service.PartySizeLimits{}, 0, nil)
+			assert.EqualError(t, err, "party tables must not be empty")
+
+			_, err = service.NewSizingPolicy(service.SizingLookup, service.PartySizeLimits{}, 0, map[int]int{0: 1})
+			assert.EqualError(t, err, "party tables party size must be greater than zero")
+
+			_, err = service.NewSizingPolicy(service.SizingLookup, service.PartySizeLimits{}, 0, map[int]int{2: 0})
+			assert.EqualError(t, err, "party tables table count must be greater than zero")
+
+			_, err = service.NewSizingPolicy("per-seat", service.PartySizeLimits{}, 0, nil)
+			assert.EqualError(t, err, `unknown sizing policy "per-seat"`)
+		})
+	})
+	t.Run("Check", func(t *testing.T) {
+		limits := service.PartySizeLimits{Min: 2, Max: 8}
+
+		assert.NoError(t, limits.Check(2))
+		assert.NoError(t, limits.Check(8))
+		assert.EqualError(t, limits.Check(9), "party size 9 exceeds the maximum of 8")
+		assert.EqualError(t, limits.Check(1), "party size 1 is below the minimum of 2")
+		assert.NoError(t, service.PartySizeLimits{}.Check(40))
+	})
+	t.Run("MaxTables", func(t *testing.T) {
+		fixed := &service.FixedSizing{SeatsPerTable: 4}
+		assert.Equal(t, 1, fixed.MaxTables(1))
+		assert.Equal(t, 1, fixed.MaxTables(4))
+		assert.Equal(t, 2, fixed.MaxTables(5))
+		assert.Equal(t, 3, fixed.MaxTables(10))
+
+		lookup, _ := service.NewSizingPolicy(service.SizingLookup, service.PartySizeLimits{}, 0, map[int]int{3: 1, 7: 2})
+		assert.Equal(t, 0, lookup.MaxTables(2))
+		assert.Equal(t, 1, lookup.MaxTables(6))
+		assert.Equal(t, 2, lookup.MaxTables(7))
+		assert.Equal(t, 2, lookup.MaxTables(20))
+
+		assert.Equal(t, 0, service.PartySizeLimits{}.MaxTables(20))
+	})
+}
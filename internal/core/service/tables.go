@@ -1,16 +1,43 @@
 package service
 
 import (
+	"errors"
+	"fmt"
 	"github.com/bossncn/restaurant-reservation-service/internal/core/model"
 	"github.com/bossncn/restaurant-reservation-service/internal/core/repository"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
+	"strings"
 	"sync"
+	"time"
 )
 
+// DefaultTableCapacity is the seat count of tables created from a plain table count.
+const DefaultTableCapacity = 4
+
+// InventoryConflictError is returned when resizing the inventory would leave upcoming reservations
+// without their seats, either because a table they hold is removed or because their tables would
+// no longer seat the party.
+type InventoryConflictError struct {
+	Reservations []model.Reservation
+}
+
+func (e *InventoryConflictError) Error() string {
+	ids := make([]string, 0, len(e.Reservations))
+	for _, reservation := range e.Reservations {
+		ids = append(ids, reservation.Id)
+	}
+	return fmt.Sprintf("tables are still reserved by %s", strings.Join(ids, ", "))
+}
+
 type TableService interface {
-	InitializeTables(numTables int) error
-	AvailableTables() int
+	InitializeTables(restaurantId string, tables []model.Table) error
+	// ResizeTables replaces the inventory of an initialized restaurant while keeping its reservations.
+	ResizeTables(restaurantId string, tables []model.Table) error
+	Tables(restaurantId string) []model.Table
+	TotalTables(restaurantId string) int
+	AvailableTables(restaurantId string, start time.Time, end time.Time) int
+	ReservationsBetween(restaurantId string, start time.Time, end time.Time) []model.Reservation
 }
 
 type TableServiceImpl struct {
@@ -30,9 +57,31 @@ func NewTableService(repo repository.TableRepository, logger *zap.Logger, eventR
 	return tableService
 }
 
-func (s *TableServiceImpl) InitializeTables(numTables int) error {
+// UniformTables builds numTables tables seating DefaultTableCapacity guests each, labelled T1..Tn
+// and standing in a row so each table can be pushed against its neighbours.
+func UniformTables(numTables int) []model.Table {
+	tables := make([]model.Table, 0, numTables)
+	for i := 1; i <= numTables; i++ {
+		table := model.Table{Id: fmt.Sprintf("T%d", i), Capacity: DefaultTableCapacity}
+		if i > 1 {
+			table.CombinableWith = append(table.CombinableWith, fmt.Sprintf("T%d", i-1))
+		}
+		if i < numTables {
+			table.CombinableWith = append(table.CombinableWith, fmt.Sprintf("T%d", i+1))
+		}
+		tables = append(tables, table)
+	}
+	return tables
+}
+
+func (s *TableServiceImpl) InitializeTables(restaurantId string, tables []model.Table) error {
+	tables, err := normalizeTables(tables)
+	if err != nil {
+		return err
+	}
+
 	resp := make(chan interface{})
-	s.requests <- model.EventRequest{Id: (uuid.New()).String(), Action: "initialize", NumTables: numTables, Response: resp}
+	s.requests <- model.EventRequest{Id: (uuid.New()).String(), Action: "initialize", RestaurantId: restaurantId, Tables: tables, Response: resp}
 	result := <-resp
 	if err, ok := result.(error); ok {
 		return err
@@ -40,6 +89,105 @@ func (s *TableServiceImpl) InitializeTables(numTables int) error {
 	return nil
 }
 
-func (s *TableServiceImpl) AvailableTables() int {
-	return s.tableRepo.AvailableTables()
+func (s *TableServiceImpl) ResizeTables(restaurantId string, tables []model.Table) error {
+	tables, err := normalizeTables(tables)
+	if err != nil {
+		return err
+	}
+
+	resp := make(chan interface{})
+	s.requests <- model.EventRequest{Id: (uuid.New()).String(), Action: "resize", RestaurantId: restaurantId, Tables: tables, Response: resp}
+	result := <-resp
+	if err, ok := result.(error); ok {
+		return err
+	}
+	return nil
+}
+
+func (s *TableServiceImpl) Tables(restaurantId string) []model.Table {
+	return s.tableRepo.Tables(restaurantId)
+}
+
+func (s *TableServiceImpl) TotalTables(restaurantId string) int {
+	return s.tableRepo.TotalTables(restaurantId)
+}
+
+func (s *TableServiceImpl) AvailableTables(restaurantId string, start time.Time, end time.Time) int {
+	return s.tableRepo.AvailableTables(restaurantId, start, end)
+}
+
+func (s *TableServiceImpl) ReservationsBetween(restaurantId string, start time.Time, end time.Time) []model.Reservation {
+	return s.tableRepo.ReservationsBetween(restaurantId, start, end)
+}
+
+// normalizeTables fills in default ids, labels, minimum party sizes and sections, makes the combination
+// graph symmetric and rejects inconsistent tables.
+func normalizeTables(tables []model.Table) ([]model.Table, error) {
+	if len(tables) == 0 {
+		return nil, errors.New("number of tables must be greater than zero")
+	}
+
+	normalized := make([]model.Table, 0, len(tables))
+	seen := make(map[string]bool)
+	for i, table := range tables {
+		if table.Id == "" {
+			table.Id = fmt.Sprintf("T%d", i+1)
+		}
+		if table.Label == "" {
+			table.Label = table.Id
+		}
+		if table.MinPartySize <= 0 {
+			table.MinPartySize = 1
+		}
+		if table.Section == "" {
+			table.Section = model.SectionMainRoom
+		}
+		if !model.IsSection(table.Section) {
+			return nil, fmt.Errorf("table %s has unknown section %s", table.Id, table.Section)
+		}
+		for _, feature := range table.Features {
+			if !model.IsFeature(feature) {
+				return nil, fmt.Errorf("table %s has unknown feature %s", table.Id, feature)
+			}
+		}
+		if table.Capacity <= 0 {
+			return nil, fmt.Errorf("table %s capacity must be greater than zero", table.Id)
+		}
+		if table.MinPartySize > table.Capacity {
+			return nil, fmt.Errorf("table %s minimum party size exceeds its capacity", table.Id)
+		}
+		if seen[table.Id] {
+			return nil, fmt.Errorf("duplicate table id %s", table.Id)
+		}
+		seen[table.Id] = true
+		normalized = append(normalized, table)
+	}
+
+	combinable := make(map[string][]string)
+	for _, table := range normalized {
+		for _, id := range table.CombinableWith {
+			if id == table.Id {
+				return nil, fmt.Errorf("table %s cannot be combined with itself", table.Id)
+			}
+			if !seen[id] {
+				return nil, fmt.Errorf("table %s is combinable with unknown table %s", table.Id, id)
+			}
+			combinable[table.Id] = appendUnique(combinable[table.Id], id)
+			combinable[id] = appendUnique(combinable[id], table.Id)
+		}
+	}
+	for i := range normalized {
+		normalized[i].CombinableWith = combinable[normalized[i].Id]
+	}
+
+	return normalized, nil
+}
+
+func appendUnique(values []string, value string) []string {
+	for _, existing := range values {
+		if existing == value {
+			return values
+		}
+	}
+	return append(values, value)
 }
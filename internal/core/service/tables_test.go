@@ -9,6 +9,7 @@ import (
 	"go.uber.org/mock/gomock"
 	"go.uber.org/zap"
 	"testing"
+	"time"
 )
 
 func TestTablesService(t *testing.T) {
@@ -35,18 +36,42 @@ func TestTablesService(t *testing.T) {
 			tableService := service.NewTableService(mockRepo, logger, &eventRequest)
 
 			// Mock event processor
+			initialized := make(chan []model.Table, 1)
 			go func() {
 				for req := range eventRequest {
 					if req.Action == "initialize" {
+						initialized <- req.Tables
 						req.Response <- nil // Simulate success
 					}
 				}
 			}()
 
 			// Test initialization
-			err := tableService.InitializeTables(10)
+			err := tableService.InitializeTables("r1", []model.Table{{Capacity: 2}, {Id: "booth", Label: "Booth", Capacity: 6, MinPartySize: 3, CombinableWith: []string{"T1"}, Section: model.SectionPatio, Features: []string{model.FeatureWindow}}})
 
 			assert.NoError(t, err)
+			assert.Equal(t, []model.Table{
+				{Id: "T1", Label: "T1", Capacity: 2, MinPartySize: 1, CombinableWith: []string{"booth"}, Section: model.SectionMainRoom},
+				{Id: "booth", Label: "Booth", Capacity: 6, MinPartySize: 3, CombinableWith: []string{"T1"}, Section: model.SectionPatio, Features: []string{model.FeatureWindow}},
+			}, <-initialized)
+		})
+		t.Run("InvalidTables", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockRepository.NewMockTableRepository(ctrl)
+			eventRequest := make(chan model.EventRequest, 100)
+			logger := zap.NewNop()
+			tableService := service.NewTableService(mockRepo, logger, &eventRequest)
+
+			assert.EqualError(t, tableService.InitializeTables("r1", nil), "number of tables must be greater than zero")
+			assert.EqualError(t, tableService.InitializeTables("r1", []model.Table{{Id: "T1"}}), "table T1 capacity must be greater than zero")
+			assert.EqualError(t, tableService.InitializeTables("r1", []model.Table{{Id: "T1", Capacity: 2, MinPartySize: 3}}), "table T1 minimum party size exceeds its capacity")
+			assert.EqualError(t, tableService.InitializeTables("r1", []model.Table{{Id: "T1", Capacity: 2}, {Id: "T1", Capacity: 4}}), "duplicate table id T1")
+			assert.EqualError(t, tableService.InitializeTables("r1", []model.Table{{Id: "T1", Capacity: 2, CombinableWith: []string{"T1"}}}), "table T1 cannot be combined with itself")
+			assert.EqualError(t, tableService.InitializeTables("r1", []model.Table{{Id: "T1", Capacity: 2, CombinableWith: []string{"T2"}}}), "table T1 is combinable with unknown table T2")
+			assert.EqualError(t, tableService.InitializeTables("r1", []model.Table{{Id: "T1", Capacity: 2, Section: "rooftop"}}), "table T1 has unknown section rooftop")
+			assert.EqualError(t, tableService.InitializeTables("r1", []model.Table{{Id: "T1", Capacity: 2, Features: []string{"sea_view"}}}), "table T1 has unknown feature sea_view")
 		})
 		t.Run("Error", func(t *testing.T) {
 			ctrl := gomock.NewController(t)
@@ -67,12 +92,84 @@ func TestTablesService(t *testing.T) {
 			}()
 
 			// Test initialization
-			err := tableService.InitializeTables(10)
+			err := tableService.InitializeTables("r1", service.UniformTables(10))
 
 			assert.Error(t, err)
 			assert.Equal(t, "initialization failed", err.Error())
 		})
 	})
+	t.Run("ResizeTables", func(t *testing.T) {
+		t.Run("Success", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockRepository.NewMockTableRepository(ctrl)
+			eventRequest := make(chan model.EventRequest, 100)
+			logger := zap.NewNop()
+			tableService := service.NewTableService(mockRepo, logger, &eventRequest)
+
+			// Mock event processor
+			resized := make(chan []model.Table, 1)
+			go func() {
+				for req := range eventRequest {
+					if req.Action == "resize" {
+						resized <- req.Tables
+						req.Response <- nil
+					}
+				}
+			}()
+
+			err := tableService.ResizeTables("r1", []model.Table{{Capacity: 2}})
+
+			assert.NoError(t, err)
+			assert.Equal(t, []model.Table{{Id: "T1", Label: "T1", Capacity: 2, MinPartySize: 1, Section: model.SectionMainRoom}}, <-resized)
+		})
+		t.Run("InvalidTables", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockRepository.NewMockTableRepository(ctrl)
+			eventRequest := make(chan model.EventRequest, 100)
+			logger := zap.NewNop()
+			tableService := service.NewTableService(mockRepo, logger, &eventRequest)
+
+			assert.EqualError(t, tableService.ResizeTables("r1", nil), "number of tables must be greater than zero")
+			assert.Empty(t, eventRequest)
+		})
+		t.Run("Conflict", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockRepository.NewMockTableRepository(ctrl)
+			eventRequest := make(chan model.EventRequest, 100)
+			logger := zap.NewNop()
+			tableService := service.NewTableService(mockRepo, logger, &eventRequest)
+
+			// Mock event processor
+			go func() {
+				for req := range eventRequest {
+					if req.Action == "resize" {
+						req.Response <- &service.InventoryConflictError{Reservations: []model.Reservation{{Id: "res-1"}, {Id: "res-2"}}}
+					}
+				}
+			}()
+
+			err := tableService.ResizeTables("r1", service.UniformTables(2))
+
+			var conflictErr *service.InventoryConflictError
+			assert.ErrorAs(t, err, &conflictErr)
+			assert.EqualError(t, err, "tables are still reserved by res-1, res-2")
+		})
+	})
+	t.Run("UniformTables", func(t *testing.T) {
+		tables := service.UniformTables(3)
+
+		assert.Equal(t, []model.Table{
+			{Id: "T1", Capacity: 4, CombinableWith: []string{"T2"}},
+			{Id: "T2", Capacity: 4, CombinableWith: []string{"T1", "T3"}},
+			{Id: "T3", Capacity: 4, CombinableWith: []string{"T2"}},
+		}, tables)
+	})
 	t.Run("AvailableTables", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -82,11 +179,14 @@ func TestTablesService(t *testing.T) {
 		logger := zap.NewNop()
 		tableService := service.NewTableService(mockRepo, logger, &eventRequest)
 
+		start := time.Date(2025, 1, 10, 19, 0, 0, 0, time.UTC)
+		end := start.Add(2 * time.Hour)
+
 		// Mock AvailableTables behavior
-		mockRepo.EXPECT().AvailableTables().Return(8).Times(1)
+		mockRepo.EXPECT().AvailableTables("r1", start, end).Return(8).Times(1)
 
 		// Test available tables
-		availableTables := tableService.AvailableTables()
+		availableTables := tableService.AvailableTables("r1", start, end)
 
 		assert.Equal(t, 8, availableTables)
 	})
@@ -0,0 +1,55 @@
+package service
+
+import (
+	"errors"
+	"sort"
+	"time"
+)
+
+// TurnTimeRule gives parties of at least MinPartySize guests an expected stay of Duration.
+type TurnTimeRule struct {
+	MinPartySize int
+	Duration     time.Duration
+}
+
+// TurnTimes decides how long a booking keeps its tables when it does not ask for a duration, and
+// how long a table needs to be reset before it can be seated again.
+type TurnTimes struct {
+	// Rules are ordered by MinPartySize.
+	Rules  []TurnTimeRule
+	Buffer time.Duration
+}
+
+// NewTurnTimes builds turn times from durations keyed by the smallest party size they apply to.
+func NewTurnTimes(durations map[int]time.Duration, buffer time.Duration) (TurnTimes, error) {
+	if buffer < 0 {
+		return TurnTimes{}, errors.New("turn buffer must not be negative")
+	}
+
+	rules := make([]TurnTimeRule, 0, len(durations))
+	for partySize, duration := range durations {
+		if partySize <= 0 {
+			return TurnTimes{}, errors.New("turn time party size must be greater than zero")
+		}
+		if duration <= 0 {
+			return TurnTimes{}, errors.New("turn time must be greater than zero")
+		}
+		rules = append(rules, TurnTimeRule{MinPartySize: partySize, Duration: duration})
+	}
+	sort.Slice(rules, func(i, j int) bool { return rules[i].MinPartySize < rules[j].MinPartySize })
+
+	return TurnTimes{Rules: rules, Buffer: buffer}, nil
+}
+
+// DurationFor returns the turn time of the largest rule the party reaches, or
+// DefaultReservationDuration when no rule applies.
+func (t TurnTimes) DurationFor(partySize int) time.Duration {
+	duration := DefaultReservationDuration
+	for _, rule := range t.Rules {
+		if partySize < rule.MinPartySize {
+			break
+		}
+		duration = rule.Duration
+	}
+	return duration
+}
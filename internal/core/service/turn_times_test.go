@@ -0,0 +1,40 @@
+package service_test
+
+import (
+	"github.com/bossncn/restaurant-reservation-service/internal/core/service"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestTurnTimes(t *testing.T) {
+	t.Run("NewTurnTimes", func(t *testing.T) {
+		t.Run("Success", func(t *testing.T) {
+			turnTimes, err := service.NewTurnTimes(map[int]time.Duration{6: 150 * time.Minute, 1: 90 * time.Minute}, 15*time.Minute)
+
+			assert.NoError(t, err)
+			assert.Equal(t, []service.TurnTimeRule{{MinPartySize: 1, Duration: 90 * time.Minute}, {MinPartySize: 6, Duration: 150 * time.Minute}}, turnTimes.Rules)
+			assert.Equal(t, 15*time.Minute, turnTimes.Buffer)
+		})
+		t.Run("Invalid", func(t *testing.T) {
+			_, err := service.NewTurnTimes(map[int]time.Duration{0: time.Hour}, 0)
+			assert.EqualError(t, err, "turn time party size must be greater than zero")
+
+			_, err = service.NewTurnTimes(map[int]time.Duration{2: 0}, 0)
+			assert.EqualError(t, err, "turn time must be greater than zero")
+
+			_, err = service.NewTurnTimes(nil, -time.Minute)
+			assert.EqualError(t, err, "turn buffer must not be negative")
+		})
+	})
+	t.Run("DurationFor", func(t *testing.T) {
+		turnTimes, _ := service.NewTurnTimes(map[int]time.Duration{2: 90 * time.Minute, 6: 150 * time.Minute}, 0)
+
+		assert.Equal(t, service.DefaultReservationDuration, turnTimes.DurationFor(1))
+		assert.Equal(t, 90*time.Minute, turnTimes.DurationFor(2))
+		assert.Equal(t, 90*time.Minute, turnTimes.DurationFor(5))
+		assert.Equal(t, 150*time.Minute, turnTimes.DurationFor(6))
+		assert.Equal(t, 150*time.Minute, turnTimes.DurationFor(12))
+		assert.Equal(t, service.DefaultReservationDuration, service.TurnTimes{}.DurationFor(4))
+	})
+}
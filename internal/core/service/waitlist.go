@@ -0,0 +1,81 @@
+package service
+
+import (
+	"errors"
+	"github.com/bossncn/restaurant-reservation-service/internal/core/model"
+	"github.com/bossncn/restaurant-reservation-service/internal/core/repository"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"sync"
+	"time"
+)
+
+type WaitlistService interface {
+	JoinWaitlist(restaurantId string, numCustomers int, guest model.Guest, startTime time.Time, duration time.Duration, requirements model.Seating, preferences model.Seating) (*model.WaitlistEntry, error)
+	// WaitlistPosition returns the entry and its 1-based place in the queue, or 0 once it is no longer waiting.
+	WaitlistPosition(restaurantId string, id string) (*model.WaitlistEntry, int, error)
+	LeaveWaitlist(restaurantId string, id string) (*model.WaitlistEntry, error)
+}
+
+type WaitlistServiceImpl struct {
+	waitlistRepo repository.WaitlistRepository
+	logger       *zap.Logger
+	requests     chan model.EventRequest
+	wg           sync.WaitGroup
+}
+
+func NewWaitlistService(repo repository.WaitlistRepository, logger *zap.Logger, eventRequest *chan model.EventRequest) *WaitlistServiceImpl {
+	waitlistService := &WaitlistServiceImpl{
+		waitlistRepo: repo,
+		logger:       logger,
+		requests:     *eventRequest,
+	}
+	waitlistService.wg.Add(1)
+	return waitlistService
+}
+
+func (s *WaitlistServiceImpl) JoinWaitlist(restaurantId string, numCustomers int, guest model.Guest, startTime time.Time, duration time.Duration, requirements model.Seating, preferences model.Seating) (*model.WaitlistEntry, error) {
+	if numCustomers <= 0 {
+		return nil, errors.New("number of customers must be greater than zero")
+	}
+	if startTime.IsZero() {
+		return nil, errors.New("start time is required")
+	}
+	if duration < 0 {
+		return nil, errors.New("duration must not be negative")
+	}
+
+	resp := make(chan interface{})
+	s.requests <- model.EventRequest{Id: (uuid.New()).String(), Action: "join_waitlist", RestaurantId: restaurantId, PartySize: numCustomers, Guest: guest, StartTime: startTime, Duration: duration, Requirements: requirements, Preferences: preferences, Channel: model.ChannelStaff, Response: resp}
+	result := <-resp
+	if err, ok := result.(error); ok {
+		return nil, err
+	}
+	entry := result.(model.WaitlistEntry)
+	return &entry, nil
+}
+
+func (s *WaitlistServiceImpl) WaitlistPosition(restaurantId string, id string) (*model.WaitlistEntry, int, error) {
+	entry, err := s.waitlistRepo.FindEntryById(restaurantId, id)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	for i, waiting := range s.waitlistRepo.WaitingEntries(restaurantId) {
+		if waiting.Id == id {
+			return entry, i + 1, nil
+		}
+	}
+	return entry, 0, nil
+}
+
+func (s *WaitlistServiceImpl) LeaveWaitlist(restaurantId string, id string) (*model.WaitlistEntry, error) {
+	resp := make(chan interface{})
+	s.requests <- model.EventRequest{Id: (uuid.New()).String(), Action: "leave_waitlist", RestaurantId: restaurantId, WaitlistId: id, Response: resp}
+	result := <-resp
+	if err, ok := result.(error); ok {
+		return nil, err
+	}
+	entry := result.(model.WaitlistEntry)
+	return &entry, nil
+}
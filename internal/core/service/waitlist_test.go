@@ -0,0 +1,158 @@
+package service_test
+
+import (
+	"errors"
+	"github.com/bossncn/restaurant-reservation-service/internal/core/model"
+	mockRepository "github.com/bossncn/restaurant-reservation-service/internal/core/repository/mock"
+	"github.com/bossncn/restaurant-reservation-service/internal/core/service"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"testing"
+	"time"
+)
+
+func TestWaitlistService(t *testing.T) {
+	startTime := time.Date(2025, 1, 10, 19, 0, 0, 0, time.UTC)
+	guest := model.Guest{Name: "Alex Tan", Phone: "+66812345678"}
+
+	t.Run("JoinWaitlist", func(t *testing.T) {
+		t.Run("Success", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockRepository.NewMockWaitlistRepository(ctrl)
+			eventRequest := make(chan model.EventRequest, 100)
+			logger := zap.NewNop()
+			svc := service.NewWaitlistService(mockRepo, logger, &eventRequest)
+
+			// Mock event processor
+			go func() {
+				for req := range eventRequest {
+					if req.Action == "join_waitlist" {
+						req.Response <- model.WaitlistEntry{Id: "w-1", RestaurantId: req.RestaurantId, PartySize: req.PartySize, Guest: req.Guest, StartTime: req.StartTime, Duration: req.Duration, Status: model.WaitlistWaiting}
+					}
+				}
+			}()
+
+			entry, err := svc.JoinWaitlist("r1", 4, guest, startTime, 0, model.Seating{}, model.Seating{})
+
+			assert.NoError(t, err)
+			assert.Equal(t, "w-1", entry.Id)
+			assert.Equal(t, guest, entry.Guest)
+			// The processor picks the turn time when no duration is given
+			assert.Zero(t, entry.Duration)
+		})
+		t.Run("InvalidNumCustomers", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockRepository.NewMockWaitlistRepository(ctrl)
+			eventRequest := make(chan model.EventRequest, 100)
+			logger := zap.NewNop()
+			svc := service.NewWaitlistService(mockRepo, logger, &eventRequest)
+
+			entry, err := svc.JoinWaitlist("r1", 0, guest, startTime, time.Hour, model.Seating{}, model.Seating{})
+
+			assert.EqualError(t, err, "number of customers must be greater than zero")
+			assert.Nil(t, entry)
+		})
+		t.Run("ErrorFromProcessor", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockRepository.NewMockWaitlistRepository(ctrl)
+			eventRequest := make(chan model.EventRequest, 100)
+			logger := zap.NewNop()
+			svc := service.NewWaitlistService(mockRepo, logger, &eventRequest)
+
+			// Mock event processor
+			go func() {
+				for req := range eventRequest {
+					req.Response <- errors.New("tables are available for this time slot")
+				}
+			}()
+
+			entry, err := svc.JoinWaitlist("r1", 4, guest, startTime, time.Hour, model.Seating{}, model.Seating{})
+
+			assert.EqualError(t, err, "tables are available for this time slot")
+			assert.Nil(t, entry)
+		})
+	})
+	t.Run("WaitlistPosition", func(t *testing.T) {
+		t.Run("Waiting", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockRepository.NewMockWaitlistRepository(ctrl)
+			eventRequest := make(chan model.EventRequest, 100)
+			logger := zap.NewNop()
+			svc := service.NewWaitlistService(mockRepo, logger, &eventRequest)
+
+			mockRepo.EXPECT().FindEntryById("r1", "w-2").Return(&model.WaitlistEntry{Id: "w-2", Status: model.WaitlistWaiting}, nil).Times(1)
+			mockRepo.EXPECT().WaitingEntries("r1").Return([]model.WaitlistEntry{{Id: "w-1"}, {Id: "w-2"}}).Times(1)
+
+			entry, position, err := svc.WaitlistPosition("r1", "w-2")
+
+			assert.NoError(t, err)
+			assert.Equal(t, "w-2", entry.Id)
+			assert.Equal(t, 2, position)
+		})
+		t.Run("NoLongerWaiting", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockRepository.NewMockWaitlistRepository(ctrl)
+			eventRequest := make(chan model.EventRequest, 100)
+			logger := zap.NewNop()
+			svc := service.NewWaitlistService(mockRepo, logger, &eventRequest)
+
+			mockRepo.EXPECT().FindEntryById("r1", "w-1").Return(&model.WaitlistEntry{Id: "w-1", Status: model.WaitlistPromoted, ReservationId: "res-1"}, nil).Times(1)
+			mockRepo.EXPECT().WaitingEntries("r1").Return([]model.WaitlistEntry{{Id: "w-2"}}).Times(1)
+
+			entry, position, err := svc.WaitlistPosition("r1", "w-1")
+
+			assert.NoError(t, err)
+			assert.Equal(t, "res-1", entry.ReservationId)
+			assert.Equal(t, 0, position)
+		})
+		t.Run("NotFound", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockRepository.NewMockWaitlistRepository(ctrl)
+			eventRequest := make(chan model.EventRequest, 100)
+			logger := zap.NewNop()
+			svc := service.NewWaitlistService(mockRepo, logger, &eventRequest)
+
+			mockRepo.EXPECT().FindEntryById("r1", "w-1").Return(nil, errors.New("waitlist entry not found")).Times(1)
+
+			_, _, err := svc.WaitlistPosition("r1", "w-1")
+
+			assert.EqualError(t, err, "waitlist entry not found")
+		})
+	})
+	t.Run("LeaveWaitlist", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mockRepository.NewMockWaitlistRepository(ctrl)
+		eventRequest := make(chan model.EventRequest, 100)
+		logger := zap.NewNop()
+		svc := service.NewWaitlistService(mockRepo, logger, &eventRequest)
+
+		// Mock event processor
+		go func() {
+			for req := range eventRequest {
+				if req.Action == "leave_waitlist" {
+					req.Response <- model.WaitlistEntry{Id: req.WaitlistId, Status: model.WaitlistLeft}
+				}
+			}
+		}()
+
+		entry, err := svc.LeaveWaitlist("r1", "w-1")
+
+		assert.NoError(t, err)
+		assert.Equal(t, model.WaitlistLeft, entry.Status)
+	})
+}
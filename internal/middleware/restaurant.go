@@ -0,0 +1,26 @@
+package middleware
+
+import (
+	"github.com/bossncn/go-common/http/echo/response"
+	"github.com/bossncn/go-common/http/model"
+	"github.com/bossncn/go-common/http/model/error_code"
+	"github.com/bossncn/restaurant-reservation-service/internal/core/service"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+// RestaurantMiddleware rejects requests addressed to a restaurant that is not in the registry, so
+// handlers below a /restaurants/:restaurantId group always act on a known tenant.
+func RestaurantMiddleware(logger *zap.Logger, restaurantService service.RestaurantService) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			restaurantId := c.Param("restaurantId")
+			if _, err := restaurantService.FindRestaurant(restaurantId); err != nil {
+				logger.Error("Unknown restaurant", zap.String("restaurantId", restaurantId), zap.Error(err))
+				return response.Response(c, model.CreateError(error_code.NotFound, err.Error()), err)
+			}
+
+			return next(c)
+		}
+	}
+}
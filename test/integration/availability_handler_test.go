@@ -0,0 +1,98 @@
+package integration_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/bossncn/go-common/http/model"
+	"github.com/bossncn/restaurant-reservation-service/internal/adapters/dto"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestIntegrationAvailability(t *testing.T) {
+	send := func(echoInstance *echo.Echo, method string, path string, body string, data interface{}) (int, model.Response) {
+		req := httptest.NewRequest(method, path, bytes.NewReader([]byte(body)))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		echoInstance.ServeHTTP(rec, req)
+
+		var resp model.Response
+		_ = json.Unmarshal([]byte(rec.Body.String()), &resp)
+		if data != nil {
+			jsonData, _ := json.Marshal(resp.Data)
+			_ = json.Unmarshal(jsonData, data)
+		}
+		return rec.Code, resp
+	}
+	availabilityPath := "/public/restaurants/" + restaurantId + "/availability"
+	reservationsPath := "/secure/restaurants/" + restaurantId + "/reservations"
+	bookingAt := func(startTime string) string {
+		return `{"num_customers": 4, "guest": {"name": "Alex Tan", "phone": "+66812345678"}, "start_time": "` + startTime + `"}`
+	}
+
+	t.Run("should list bookable slots without booking them", func(t *testing.T) {
+		echoInstance := Setup()
+		initializeTables(t, echoInstance, 1)
+
+		var availability dto.AvailabilityResponse
+		code, _ := send(echoInstance, http.MethodGet, availabilityPath+"?party_size=4&date=2025-01-10&from=18:00&to=19:00", "", &availability)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Len(t, availability.Slots, 5)
+		assert.Equal(t, []string{"T1"}, availability.Slots[0].TableIds)
+
+		// The search left the table free.
+		code, _ = send(echoInstance, http.MethodPost, reservationsPath, bookingAt("2025-01-10T18:00:00Z"), nil)
+		assert.Equal(t, http.StatusOK, code)
+	})
+	t.Run("should suggest the nearest times when the requested one is full", func(t *testing.T) {
+		echoInstance := Setup()
+		initializeTables(t, echoInstance, 1)
+		code, _ := send(echoInstance, http.MethodPost, reservationsPath, bookingAt("2025-01-10T19:00:00Z"), nil)
+		assert.Equal(t, http.StatusOK, code)
+
+		var availability dto.AvailabilityResponse
+		code, _ = send(echoInstance, http.MethodGet, availabilityPath+"?party_size=4&date=2025-01-10&from=19:30", "", &availability)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Empty(t, availability.Slots)
+
+		// The table is booked from 19:00 to 21:00, so the nearest two hour bookings start from 21:00.
+		assert.Len(t, availability.Alternatives, 3)
+		assert.Equal(t, time.Date(2025, 1, 10, 21, 0, 0, 0, time.UTC), availability.Alternatives[0].StartTime)
+		assert.Equal(t, time.Date(2025, 1, 10, 21, 15, 0, 0, time.UTC), availability.Alternatives[1].StartTime)
+		assert.Equal(t, time.Date(2025, 1, 10, 21, 30, 0, 0, time.UTC), availability.Alternatives[2].StartTime)
+
+		// Asking about 18:30 finds the earlier times closer.
+		code, _ = send(echoInstance, http.MethodGet, availabilityPath+"?party_size=4&date=2025-01-10&from=18:30", "", &availability)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, time.Date(2025, 1, 10, 16, 30, 0, 0, time.UTC), availability.Alternatives[0].StartTime)
+		assert.Equal(t, time.Date(2025, 1, 10, 16, 45, 0, 0, time.UTC), availability.Alternatives[1].StartTime)
+		assert.Equal(t, time.Date(2025, 1, 10, 17, 0, 0, 0, time.UTC), availability.Alternatives[2].StartTime)
+	})
+	t.Run("should only offer times inside the schedule", func(t *testing.T) {
+		echoInstance := Setup()
+		initializeTables(t, echoInstance, 1)
+		code, _ := send(echoInstance, http.MethodPut, "/secure/restaurants/"+restaurantId+"/schedule", `{"shifts": [{"name": "Dinner", "day": "friday", "opens": "18:00", "closes": "22:00"}]}`, nil)
+		assert.Equal(t, http.StatusOK, code)
+
+		var availability dto.AvailabilityResponse
+		code, _ = send(echoInstance, http.MethodGet, availabilityPath+"?party_size=2&date=2025-01-10", "", &availability)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Len(t, availability.Slots, 9)
+		assert.Equal(t, time.Date(2025, 1, 10, 18, 0, 0, 0, time.UTC), availability.Slots[0].StartTime)
+		assert.Equal(t, time.Date(2025, 1, 10, 20, 0, 0, 0, time.UTC), availability.Slots[8].StartTime)
+	})
+	t.Run("should reject an invalid search", func(t *testing.T) {
+		echoInstance := Setup()
+
+		code, _ := send(echoInstance, http.MethodGet, availabilityPath+"?date=2025-01-10", "", nil)
+		assert.Equal(t, http.StatusBadRequest, code)
+
+		code, resp := send(echoInstance, http.MethodGet, availabilityPath+"?party_size=2&date=2025-01-10", "", nil)
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Equal(t, "tables has not been initialized", resp.Data)
+	})
+}
@@ -0,0 +1,92 @@
+package integration_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/bossncn/go-common/http/model"
+	"github.com/bossncn/restaurant-reservation-service/internal/adapters/dto"
+	"github.com/bossncn/restaurant-reservation-service/internal/adapters/event"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestIntegrationTableBlocks(t *testing.T) {
+	send := func(echoInstance *echo.Echo, method string, path string, body string, data interface{}) (int, model.Response) {
+		req := httptest.NewRequest(method, path, bytes.NewReader([]byte(body)))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		echoInstance.ServeHTTP(rec, req)
+
+		var resp model.Response
+		_ = json.Unmarshal([]byte(rec.Body.String()), &resp)
+		if data != nil {
+			jsonData, _ := json.Marshal(resp.Data)
+			_ = json.Unmarshal(jsonData, data)
+		}
+		return rec.Code, resp
+	}
+	blocksPath := "/secure/restaurants/" + restaurantId + "/table/blocks"
+	reservationsPath := "/secure/restaurants/" + restaurantId + "/reservations"
+	statusPath := "/public/restaurants/" + restaurantId + "/table?start_time=2025-01-10T19:00:00Z&end_time=2025-01-10T21:00:00Z"
+	reservation := `{"num_customers": 2, "guest": {"name": "Sam Lee", "phone": "+66812345678"}, "start_time": "2025-01-10T19:00:00Z"}`
+	now := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+
+	t.Run("should keep blocked tables out of bookings until unblocked", func(t *testing.T) {
+		echoInstance := Setup(event.WithClock(&manualClock{now: now}))
+		initializeTables(t, echoInstance, 1)
+
+		var block dto.TableBlockResponse
+		code, _ := send(echoInstance, http.MethodPost, blocksPath, `{"table_ids": ["T1"], "reason": "broken chair"}`, &block)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, now, block.StartTime)
+		assert.Nil(t, block.EndTime)
+
+		var status dto.TablesResponse
+		code, _ = send(echoInstance, http.MethodGet, statusPath, "", &status)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, 0, status.AvailableTables)
+		assert.True(t, status.Tables[0].Blocked)
+		assert.Equal(t, "broken chair", status.Tables[0].BlockReason)
+
+		code, resp := send(echoInstance, http.MethodPost, reservationsPath, reservation, nil)
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Equal(t, "not enough tables available", resp.Data)
+
+		code, _ = send(echoInstance, http.MethodDelete, blocksPath+"/"+block.Id, "", nil)
+		assert.Equal(t, http.StatusOK, code)
+		code, _ = send(echoInstance, http.MethodPost, reservationsPath, reservation, nil)
+		assert.Equal(t, http.StatusOK, code)
+	})
+	t.Run("should only block tables for the time range", func(t *testing.T) {
+		echoInstance := Setup(event.WithClock(&manualClock{now: now}))
+		initializeTables(t, echoInstance, 1)
+
+		code, _ := send(echoInstance, http.MethodPost, blocksPath, `{"table_ids": ["T1"], "start_time": "2025-01-10T15:00:00Z", "end_time": "2025-01-10T16:00:00Z", "reason": "staff meal"}`, nil)
+		assert.Equal(t, http.StatusOK, code)
+
+		code, _ = send(echoInstance, http.MethodPost, reservationsPath, reservation, nil)
+		assert.Equal(t, http.StatusOK, code)
+	})
+	t.Run("should report reservations on the tables instead of blocking them", func(t *testing.T) {
+		echoInstance := Setup(event.WithClock(&manualClock{now: now}))
+		initializeTables(t, echoInstance, 1)
+
+		var booked dto.ReservationResponse
+		code, _ := send(echoInstance, http.MethodPost, reservationsPath, reservation, &booked)
+		assert.Equal(t, http.StatusOK, code)
+
+		var conflict dto.InventoryConflictResponse
+		code, _ = send(echoInstance, http.MethodPost, blocksPath, `{"table_ids": ["T1"], "reason": "private buyout"}`, &conflict)
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Equal(t, booked.BookingId, conflict.Reservations[0].BookingId)
+
+		var status dto.TablesResponse
+		code, _ = send(echoInstance, http.MethodGet, statusPath, "", &status)
+		assert.Equal(t, http.StatusOK, code)
+		assert.False(t, status.Tables[0].Blocked)
+	})
+}
@@ -0,0 +1,86 @@
+package integration_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/bossncn/go-common/http/model"
+	"github.com/bossncn/restaurant-reservation-service/internal/adapters/dto"
+	"github.com/bossncn/restaurant-reservation-service/internal/adapters/event"
+	coreModel "github.com/bossncn/restaurant-reservation-service/internal/core/model"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestIntegrationBookingWindow(t *testing.T) {
+	send := func(echoInstance *echo.Echo, method string, path string, body string, data interface{}) (int, model.Response) {
+		req := httptest.NewRequest(method, path, bytes.NewReader([]byte(body)))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		echoInstance.ServeHTTP(rec, req)
+
+		var resp model.Response
+		_ = json.Unmarshal([]byte(rec.Body.String()), &resp)
+		if data != nil {
+			jsonData, _ := json.Marshal(resp.Data)
+			_ = json.Unmarshal(jsonData, data)
+		}
+		return rec.Code, resp
+	}
+	now := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+	onlinePath := "/public/restaurants/" + restaurantId + "/reservations"
+	staffPath := "/secure/restaurants/" + restaurantId + "/reservations"
+	availabilityPath := "/public/restaurants/" + restaurantId + "/availability"
+	bookingAt := func(startTime string) string {
+		return `{"num_customers": 2, "guest": {"name": "Alex Tan", "phone": "+66812345678"}, "start_time": "` + startTime + `"}`
+	}
+	setup := func(t *testing.T) *echo.Echo {
+		echoInstance := Setup(event.WithClock(&manualClock{now: now}),
+			event.WithBookingWindow(coreModel.ChannelPublic, coreModel.BookingWindow{MinLeadTime: 2 * time.Hour, MaxAdvance: 60 * 24 * time.Hour}))
+		initializeTables(t, echoInstance, 4)
+		return echoInstance
+	}
+
+	t.Run("should book online inside the public window", func(t *testing.T) {
+		echoInstance := setup(t)
+
+		var reservation dto.ReservationResponse
+		code, _ := send(echoInstance, http.MethodPost, onlinePath, bookingAt("2025-01-10T19:00:00Z"), &reservation)
+		assert.Equal(t, http.StatusOK, code)
+		assert.NotEmpty(t, reservation.BookingId)
+		assert.Equal(t, time.Date(2025, 1, 10, 19, 0, 0, 0, time.UTC), reservation.StartTime)
+	})
+	t.Run("should reject online bookings outside the public window with distinct codes", func(t *testing.T) {
+		echoInstance := setup(t)
+
+		code, resp := send(echoInstance, http.MethodPost, onlinePath, bookingAt("2025-01-10T13:00:00Z"), nil)
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Equal(t, "ERR01001", resp.Code)
+		assert.Equal(t, "booking is too close to its start time, bookings must be made at least 2 hours ahead", resp.Data)
+
+		code, resp = send(echoInstance, http.MethodPost, onlinePath, bookingAt("2025-03-20T19:00:00Z"), nil)
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Equal(t, "ERR01002", resp.Code)
+		assert.Equal(t, "booking is too far ahead, bookings open 60 days ahead", resp.Data)
+	})
+	t.Run("should let staff book outside the public window", func(t *testing.T) {
+		echoInstance := setup(t)
+
+		code, _ := send(echoInstance, http.MethodPost, staffPath, bookingAt("2025-01-10T13:00:00Z"), nil)
+		assert.Equal(t, http.StatusOK, code)
+		code, _ = send(echoInstance, http.MethodPost, staffPath, bookingAt("2025-03-20T19:00:00Z"), nil)
+		assert.Equal(t, http.StatusOK, code)
+	})
+	t.Run("should only offer slots inside the public window", func(t *testing.T) {
+		echoInstance := setup(t)
+
+		var availability dto.AvailabilityResponse
+		code, _ := send(echoInstance, http.MethodGet, availabilityPath+"?party_size=2&date=2025-01-10&from=13:00&to=14:30", "", &availability)
+		assert.Equal(t, http.StatusOK, code)
+		assert.NotEmpty(t, availability.Slots)
+		assert.Equal(t, time.Date(2025, 1, 10, 14, 0, 0, 0, time.UTC), availability.Slots[0].StartTime)
+	})
+}
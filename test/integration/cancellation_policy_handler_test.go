@@ -0,0 +1,129 @@
+package integration_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/bossncn/go-common/http/model"
+	"github.com/bossncn/restaurant-reservation-service/internal/adapters/dto"
+	"github.com/bossncn/restaurant-reservation-service/internal/adapters/event"
+	coreModel "github.com/bossncn/restaurant-reservation-service/internal/core/model"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestIntegrationCancellationPolicy(t *testing.T) {
+	send := func(echoInstance *echo.Echo, method string, path string, body string, data interface{}) (int, model.Response) {
+		req := httptest.NewRequest(method, path, bytes.NewReader([]byte(body)))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		echoInstance.ServeHTTP(rec, req)
+
+		var resp model.Response
+		_ = json.Unmarshal([]byte(rec.Body.String()), &resp)
+		if data != nil {
+			jsonData, _ := json.Marshal(resp.Data)
+			_ = json.Unmarshal(jsonData, data)
+		}
+		return rec.Code, resp
+	}
+	reservationsPath := "/secure/restaurants/" + restaurantId + "/reservations"
+	eventsPath := "/secure/restaurants/" + restaurantId + "/events"
+	booking := `{"num_customers": 2, "guest": {"name": "Alex Tan", "phone": "+66812345678"}, "start_time": "2025-01-10T19:00:00Z"}`
+	freeUntil := time.Date(2025, 1, 9, 19, 0, 0, 0, time.UTC)
+	policy := dto.CancellationPolicyResponse{CutoffMinutes: 1440, Fee: 500, ForfeitDeposit: true}
+	setup := func(t *testing.T, now time.Time) *echo.Echo {
+		echoInstance := Setup(event.WithClock(&manualClock{now: now}),
+			event.WithCancellationPolicy(coreModel.CancellationPolicy{Cutoff: 24 * time.Hour, Fee: 500, ForfeitDeposit: true}))
+		initializeTables(t, echoInstance, 4)
+		return echoInstance
+	}
+
+	t.Run("should cancel free of charge before the cutoff", func(t *testing.T) {
+		echoInstance := setup(t, time.Date(2025, 1, 8, 12, 0, 0, 0, time.UTC))
+
+		var reservation dto.ReservationResponse
+		code, _ := send(echoInstance, http.MethodPost, reservationsPath, booking, &reservation)
+		assert.Equal(t, http.StatusOK, code)
+
+		var cancellation dto.CancelReservationResponse
+		code, _ = send(echoInstance, http.MethodDelete, reservationsPath+"/"+reservation.BookingId, "", &cancellation)
+		assert.Equal(t, http.StatusOK, code)
+		assert.False(t, cancellation.FeeApplies)
+		assert.Zero(t, cancellation.Fee)
+		assert.Equal(t, freeUntil, *cancellation.FreeUntil)
+		assert.Equal(t, policy, cancellation.Policy)
+	})
+	t.Run("should charge the fee after the cutoff unless staff waive it", func(t *testing.T) {
+		echoInstance := setup(t, time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC))
+
+		var late, waived dto.ReservationResponse
+		code, _ := send(echoInstance, http.MethodPost, reservationsPath, booking, &late)
+		assert.Equal(t, http.StatusOK, code)
+		code, _ = send(echoInstance, http.MethodPost, reservationsPath, booking, &waived)
+		assert.Equal(t, http.StatusOK, code)
+
+		var cancellation dto.CancelReservationResponse
+		code, _ = send(echoInstance, http.MethodDelete, reservationsPath+"/"+late.BookingId, "", &cancellation)
+		assert.Equal(t, http.StatusOK, code)
+		assert.True(t, cancellation.FeeApplies)
+		assert.Equal(t, 500, cancellation.Fee)
+		assert.False(t, cancellation.FeeWaived)
+
+		cancellation = dto.CancelReservationResponse{}
+		code, _ = send(echoInstance, http.MethodDelete, reservationsPath+"/"+waived.BookingId+"?waive_fee=true", "", &cancellation)
+		assert.Equal(t, http.StatusOK, code)
+		assert.False(t, cancellation.FeeApplies)
+		assert.Zero(t, cancellation.Fee)
+		assert.True(t, cancellation.FeeWaived)
+	})
+	t.Run("should charge a late series occurrence unless staff waive it", func(t *testing.T) {
+		fridays := `{"num_customers": 2, "guest": {"name": "Alex Tan", "phone": "+66812345678"}, "start_time": "2025-01-10T19:00:00Z", "recurrence": {"count": 2}}`
+
+		for _, waive := range []bool{false, true} {
+			echoInstance := setup(t, time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC))
+
+			var series dto.ReservationSeriesResponse
+			code, _ := send(echoInstance, http.MethodPost, reservationsPath+"/series", fridays, &series)
+			assert.Equal(t, http.StatusOK, code)
+
+			path := reservationsPath + "/series/" + series.SeriesId
+			if waive {
+				path += "?waive_fee=true"
+			}
+			var cancelled dto.CancelSeriesResponse
+			code, _ = send(echoInstance, http.MethodDelete, path, "", &cancelled)
+			assert.Equal(t, http.StatusOK, code)
+			assert.Len(t, cancelled.Occurrences, 2)
+			// Only tonight's occurrence is inside the cutoff; next week's is cancelled free of charge.
+			assert.Equal(t, !waive, cancelled.Occurrences[0].FeeApplies)
+			assert.Equal(t, waive, cancelled.Occurrences[0].FeeWaived)
+			assert.False(t, cancelled.Occurrences[1].FeeApplies)
+			assert.False(t, cancelled.Occurrences[1].FeeWaived)
+			if !waive {
+				assert.Equal(t, 500, cancelled.Occurrences[0].Fee)
+			}
+		}
+	})
+	t.Run("should forfeit a paid deposit after the cutoff", func(t *testing.T) {
+		echoInstance := setup(t, time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC))
+
+		var reservation dto.ReservationResponse
+		code, _ := send(echoInstance, http.MethodPost, eventsPath, `{"headcount": 12, "contact": {"name": "Sam Lee", "phone": "+66812345678"}, "start_time": "2025-01-10T18:00:00Z", "end_time": "2025-01-10T23:00:00Z", "deposit_status": "paid"}`, &reservation)
+		assert.Equal(t, http.StatusOK, code)
+
+		var cancellation dto.CancelReservationResponse
+		code, _ = send(echoInstance, http.MethodDelete, reservationsPath+"/"+reservation.BookingId, "", &cancellation)
+		assert.Equal(t, http.StatusOK, code)
+		assert.True(t, cancellation.FeeApplies)
+		assert.True(t, cancellation.DepositForfeited)
+		assert.Zero(t, cancellation.Fee)
+
+		code, _ = send(echoInstance, http.MethodGet, reservationsPath+"/"+reservation.BookingId, "", &reservation)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "forfeited", reservation.DepositStatus)
+	})
+}
@@ -0,0 +1,87 @@
+package integration_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/bossncn/go-common/http/model"
+	"github.com/bossncn/restaurant-reservation-service/internal/adapters/dto"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestIntegrationEventBooking(t *testing.T) {
+	send := func(echoInstance *echo.Echo, method string, path string, body string, data interface{}) (int, model.Response) {
+		req := httptest.NewRequest(method, path, bytes.NewReader([]byte(body)))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		echoInstance.ServeHTTP(rec, req)
+
+		var resp model.Response
+		_ = json.Unmarshal([]byte(rec.Body.String()), &resp)
+		if data != nil {
+			jsonData, _ := json.Marshal(resp.Data)
+			_ = json.Unmarshal(jsonData, data)
+		}
+		return rec.Code, resp
+	}
+	eventsPath := "/secure/restaurants/" + restaurantId + "/events"
+	reservationsPath := "/secure/restaurants/" + restaurantId + "/reservations"
+	setup := func(t *testing.T) *echo.Echo {
+		echoInstance := Setup()
+		code, _ := send(echoInstance, http.MethodPost, "/public/restaurants/"+restaurantId+"/table/init", `{"tables": [{"id": "P1", "capacity": 8, "section": "private_room"}, {"id": "P2", "capacity": 8, "section": "private_room"}, {"id": "M1", "capacity": 4}]}`, nil)
+		assert.Equal(t, http.StatusOK, code)
+		return echoInstance
+	}
+
+	t.Run("should hold every table in the section for the event", func(t *testing.T) {
+		echoInstance := setup(t)
+
+		var booking dto.ReservationResponse
+		code, _ := send(echoInstance, http.MethodPost, eventsPath, `{"headcount": 30, "contact": {"name": "Sam Lee", "phone": "+66812345678"}, "section": "private_room", "start_time": "2025-01-10T18:00:00Z", "end_time": "2025-01-10T23:00:00Z", "deposit_status": "pending"}`, &booking)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "event", booking.Type)
+		assert.Equal(t, "pending", booking.DepositStatus)
+		assert.ElementsMatch(t, []string{"P1", "P2"}, booking.TableIds)
+
+		code, resp := send(echoInstance, http.MethodPost, reservationsPath, `{"num_customers": 2, "guest": {"name": "Alex Tan", "phone": "+66812345679"}, "start_time": "2025-01-10T19:00:00Z", "requirements": {"section": "private_room"}}`, nil)
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Equal(t, "not enough tables available", resp.Data)
+
+		var reservation dto.ReservationResponse
+		code, _ = send(echoInstance, http.MethodPost, reservationsPath, `{"num_customers": 2, "guest": {"name": "Alex Tan", "phone": "+66812345679"}, "start_time": "2025-01-10T19:00:00Z"}`, &reservation)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, []string{"M1"}, reservation.TableIds)
+
+		code, _ = send(echoInstance, http.MethodPut, eventsPath+"/"+booking.BookingId+"/deposit", `{"status": "paid"}`, &booking)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "paid", booking.DepositStatus)
+	})
+	t.Run("should report reservations standing in the way of a buyout", func(t *testing.T) {
+		echoInstance := setup(t)
+
+		var reservation dto.ReservationResponse
+		code, _ := send(echoInstance, http.MethodPost, reservationsPath, `{"num_customers": 2, "guest": {"name": "Alex Tan", "phone": "+66812345679"}, "start_time": "2025-01-10T19:00:00Z"}`, &reservation)
+		assert.Equal(t, http.StatusOK, code)
+
+		var conflict dto.InventoryConflictResponse
+		code, _ = send(echoInstance, http.MethodPost, eventsPath, `{"headcount": 60, "contact": {"name": "Sam Lee", "phone": "+66812345678"}, "start_time": "2025-01-10T18:00:00Z", "end_time": "2025-01-10T23:00:00Z"}`, &conflict)
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Len(t, conflict.Reservations, 1)
+		assert.Equal(t, reservation.BookingId, conflict.Reservations[0].BookingId)
+	})
+	t.Run("should not track deposits on regular reservations", func(t *testing.T) {
+		echoInstance := setup(t)
+
+		var reservation dto.ReservationResponse
+		code, _ := send(echoInstance, http.MethodPost, reservationsPath, `{"num_customers": 2, "guest": {"name": "Alex Tan", "phone": "+66812345679"}, "start_time": "2025-01-10T19:00:00Z"}`, &reservation)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "table", reservation.Type)
+
+		code, resp := send(echoInstance, http.MethodPut, eventsPath+"/"+reservation.BookingId+"/deposit", `{"status": "paid"}`, nil)
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Equal(t, "deposits are only tracked on event bookings", resp.Data)
+	})
+}
@@ -0,0 +1,82 @@
+package integration_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/bossncn/go-common/http/model"
+	"github.com/bossncn/restaurant-reservation-service/internal/adapters/dto"
+	"github.com/bossncn/restaurant-reservation-service/internal/adapters/event"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestIntegrationHolds(t *testing.T) {
+	send := func(echoInstance *echo.Echo, method string, path string, body string) (int, model.Response, dto.ReservationResponse) {
+		req := httptest.NewRequest(method, path, bytes.NewReader([]byte(body)))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		echoInstance.ServeHTTP(rec, req)
+
+		var resp model.Response
+		_ = json.Unmarshal([]byte(rec.Body.String()), &resp)
+		jsonData, _ := json.Marshal(resp.Data)
+		var data dto.ReservationResponse
+		_ = json.Unmarshal(jsonData, &data)
+		return rec.Code, resp, data
+	}
+	holdsPath := "/secure/restaurants/" + restaurantId + "/holds"
+	reservationsPath := "/secure/restaurants/" + restaurantId + "/reservations"
+	holdBody := `{"num_customers": 4, "start_time": "2025-01-10T19:00:00Z"}`
+	guestBody := `{"guest": {"name": "Alex Tan", "phone": "+66812345678"}}`
+	now := time.Date(2025, 1, 9, 12, 0, 0, 0, time.UTC)
+
+	t.Run("should confirm a hold before it expires", func(t *testing.T) {
+		clock := &manualClock{now: now}
+		echoInstance := Setup(event.WithClock(clock), event.WithHoldTTL(10*time.Minute))
+		initializeTables(t, echoInstance, 1)
+
+		code, _, held := send(echoInstance, http.MethodPost, holdsPath, holdBody)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "held", held.Status)
+		assert.Equal(t, now.Add(10*time.Minute), *held.HoldExpiresAt)
+		assert.Equal(t, 0, held.RemainingTables)
+
+		clock.Advance(9 * time.Minute)
+
+		code, _, confirmed := send(echoInstance, http.MethodPost, reservationsPath+"/"+held.BookingId+"/confirm", guestBody)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "confirmed", confirmed.Status)
+		assert.Equal(t, "Alex Tan", confirmed.Guest.Name)
+		assert.Nil(t, confirmed.HoldExpiresAt)
+
+		// A confirmed booking never expires
+		clock.Advance(time.Hour)
+
+		_, _, found := send(echoInstance, http.MethodGet, reservationsPath+"/"+held.BookingId, "")
+		assert.Equal(t, "confirmed", found.Status)
+	})
+	t.Run("should release the tables once the hold expires", func(t *testing.T) {
+		clock := &manualClock{now: now}
+		echoInstance := Setup(event.WithClock(clock), event.WithHoldTTL(10*time.Minute))
+		initializeTables(t, echoInstance, 1)
+
+		_, _, held := send(echoInstance, http.MethodPost, holdsPath, holdBody)
+
+		clock.Advance(10 * time.Minute)
+
+		code, resp, _ := send(echoInstance, http.MethodPost, reservationsPath+"/"+held.BookingId+"/confirm", guestBody)
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Equal(t, "hold has expired", resp.Data)
+
+		_, _, expired := send(echoInstance, http.MethodGet, reservationsPath+"/"+held.BookingId, "")
+		assert.Equal(t, "expired", expired.Status)
+		assert.Equal(t, 1, expired.RemainingTables)
+
+		code, _, _ = send(echoInstance, http.MethodPost, reservationsPath, `{"num_customers": 4, "guest": {"name": "Sam Lee", "phone": "+66812345679"}, "start_time": "2025-01-10T19:00:00Z"}`)
+		assert.Equal(t, http.StatusOK, code)
+	})
+}
@@ -0,0 +1,134 @@
+package integration_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/bossncn/go-common/http/model"
+	"github.com/bossncn/restaurant-reservation-service/internal/adapters/dto"
+	"github.com/bossncn/restaurant-reservation-service/internal/adapters/event"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestIntegrationOverbooking(t *testing.T) {
+	send := func(echoInstance *echo.Echo, method string, path string, body string, data interface{}) (int, model.Response) {
+		req := httptest.NewRequest(method, path, bytes.NewReader([]byte(body)))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		echoInstance.ServeHTTP(rec, req)
+
+		var resp model.Response
+		_ = json.Unmarshal([]byte(rec.Body.String()), &resp)
+		if data != nil {
+			jsonData, _ := json.Marshal(resp.Data)
+			_ = json.Unmarshal(jsonData, data)
+		}
+		return rec.Code, resp
+	}
+	schedulePath := "/secure/restaurants/" + restaurantId + "/schedule"
+	reservationsPath := "/secure/restaurants/" + restaurantId + "/reservations"
+	tablesPath := "/public/restaurants/" + restaurantId + "/table"
+	// 10 January 2025 is a Friday; dinner overbooks up to half of the four tables.
+	schedule := `{"shifts": [{"name": "Dinner", "day": "friday", "opens": "17:00", "closes": "23:00", "overbooking_percent": 50}]}`
+	booking := `{"num_customers": 2, "guest": {"name": "Alex Tan", "phone": "+66812345678"}, "start_time": "2025-01-10T19:00:00Z", "duration_minutes": 120}`
+	// The morning of the booked day.
+	now := time.Date(2025, 1, 10, 9, 0, 0, 0, time.UTC)
+	setup := func(t *testing.T) (*echo.Echo, []string) {
+		echoInstance := Setup(event.WithClock(&manualClock{now: now}))
+		initializeTables(t, echoInstance, 4)
+		code, _ := send(echoInstance, http.MethodPut, schedulePath, schedule, nil)
+		assert.Equal(t, http.StatusOK, code)
+		booked := make([]string, 0, 4)
+		for i := 0; i < 4; i++ {
+			var reservation dto.ReservationResponse
+			code, _ = send(echoInstance, http.MethodPost, reservationsPath, booking, &reservation)
+			assert.Equal(t, http.StatusOK, code)
+			assert.False(t, reservation.Overbooked)
+			booked = append(booked, reservation.BookingId)
+		}
+		return echoInstance, booked
+	}
+
+	t.Run("should accept bookings beyond the free tables up to the allowance and mark them", func(t *testing.T) {
+		echoInstance, _ := setup(t)
+
+		overbooked := make([]string, 0, 2)
+		for i := 0; i < 2; i++ {
+			var reservation dto.ReservationResponse
+			code, _ := send(echoInstance, http.MethodPost, reservationsPath, booking, &reservation)
+			assert.Equal(t, http.StatusOK, code)
+			assert.Equal(t, "confirmed", reservation.Status)
+			assert.True(t, reservation.Overbooked)
+			assert.Equal(t, 1, reservation.OverbookedTables)
+			assert.Zero(t, reservation.TablesReserved)
+			overbooked = append(overbooked, reservation.BookingId)
+		}
+
+		code, resp := send(echoInstance, http.MethodPost, reservationsPath, booking, nil)
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Equal(t, "not enough tables available", resp.Data)
+
+		var tables dto.TablesResponse
+		code, _ = send(echoInstance, http.MethodGet, tablesPath+"?start_time=2025-01-10T19:00:00Z&end_time=2025-01-10T21:00:00Z", "", &tables)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, 4, tables.TotalTables)
+		assert.Equal(t, 0, tables.AvailableTables)
+		assert.Equal(t, 2, tables.OverbookingAllowance)
+		assert.Equal(t, 2, tables.OverbookedTables)
+		assert.Len(t, tables.Reservations, 6)
+		assert.Equal(t, map[string]int{overbooked[0]: 1, overbooked[1]: 1}, tables.OverbookedReservations)
+	})
+	t.Run("should free room in the band when an overbooked reservation is cancelled", func(t *testing.T) {
+		echoInstance, _ := setup(t)
+
+		var reservation dto.ReservationResponse
+		code, _ := send(echoInstance, http.MethodPost, reservationsPath, `{"num_customers": 6, "guest": {"name": "Alex Tan", "phone": "+66812345678"}, "start_time": "2025-01-10T19:00:00Z", "duration_minutes": 120}`, &reservation)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, 2, reservation.OverbookedTables)
+
+		code, _ = send(echoInstance, http.MethodPost, reservationsPath, booking, nil)
+		assert.Equal(t, http.StatusBadRequest, code)
+
+		code, _ = send(echoInstance, http.MethodDelete, reservationsPath+"/"+reservation.BookingId, "", nil)
+		assert.Equal(t, http.StatusOK, code)
+
+		code, _ = send(echoInstance, http.MethodPost, reservationsPath, booking, &reservation)
+		assert.Equal(t, http.StatusOK, code)
+		assert.True(t, reservation.Overbooked)
+	})
+	t.Run("should seat an overbooked reservation once a booked party cancels", func(t *testing.T) {
+		echoInstance, booked := setup(t)
+
+		var overbooked dto.ReservationResponse
+		code, _ := send(echoInstance, http.MethodPost, reservationsPath, booking, &overbooked)
+		assert.Equal(t, http.StatusOK, code)
+		assert.True(t, overbooked.Overbooked)
+
+		// With every table taken the overbooked party cannot be seated yet.
+		code, resp := send(echoInstance, http.MethodPost, reservationsPath+"/"+overbooked.BookingId+"/seat", "", nil)
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Equal(t, "not enough tables available to seat the overbooked reservation", resp.Data)
+
+		code, _ = send(echoInstance, http.MethodDelete, reservationsPath+"/"+booked[0], "", nil)
+		assert.Equal(t, http.StatusOK, code)
+
+		var reseated dto.ReservationResponse
+		code, _ = send(echoInstance, http.MethodGet, reservationsPath+"/"+overbooked.BookingId, "", &reseated)
+		assert.Equal(t, http.StatusOK, code)
+		assert.False(t, reseated.Overbooked)
+		assert.Equal(t, 1, reseated.TablesReserved)
+
+		var tables dto.TablesResponse
+		code, _ = send(echoInstance, http.MethodGet, tablesPath+"?start_time=2025-01-10T19:00:00Z&end_time=2025-01-10T21:00:00Z", "", &tables)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, 0, tables.AvailableTables)
+		assert.Equal(t, 0, tables.OverbookedTables)
+
+		code, _ = send(echoInstance, http.MethodPost, reservationsPath+"/"+overbooked.BookingId+"/seat", "", nil)
+		assert.Equal(t, http.StatusOK, code)
+	})
+}
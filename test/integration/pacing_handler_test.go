@@ -0,0 +1,93 @@
+package integration_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/bossncn/go-common/http/model"
+	"github.com/bossncn/restaurant-reservation-service/internal/adapters/dto"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestIntegrationPacing(t *testing.T) {
+	send := func(echoInstance *echo.Echo, method string, path string, body string, data interface{}) (int, model.Response) {
+		req := httptest.NewRequest(method, path, bytes.NewReader([]byte(body)))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		echoInstance.ServeHTTP(rec, req)
+
+		var resp model.Response
+		_ = json.Unmarshal([]byte(rec.Body.String()), &resp)
+		if data != nil {
+			jsonData, _ := json.Marshal(resp.Data)
+			_ = json.Unmarshal(jsonData, data)
+		}
+		return rec.Code, resp
+	}
+	schedulePath := "/secure/restaurants/" + restaurantId + "/schedule"
+	reservationsPath := "/secure/restaurants/" + restaurantId + "/reservations"
+	availabilityPath := "/public/restaurants/" + restaurantId + "/availability"
+	// 10 January 2025 is a Friday; the kitchen takes 4 new covers every 15 minutes.
+	schedule := `{"shifts": [{"name": "Dinner", "day": "friday", "opens": "17:00", "closes": "23:00", "max_covers": 4}]}`
+	bookingAt := func(startTime string) string {
+		return `{"num_customers": 2, "guest": {"name": "Alex Tan", "phone": "+66812345678"}, "start_time": "` + startTime + `"}`
+	}
+	setup := func(t *testing.T) *echo.Echo {
+		echoInstance := Setup()
+		initializeTables(t, echoInstance, 4)
+		code, _ := send(echoInstance, http.MethodPut, schedulePath, schedule, nil)
+		assert.Equal(t, http.StatusOK, code)
+		return echoInstance
+	}
+
+	t.Run("should turn away arrivals beyond the interval's covers while tables are free", func(t *testing.T) {
+		echoInstance := setup(t)
+
+		code, _ := send(echoInstance, http.MethodPost, reservationsPath, bookingAt("2025-01-10T19:00:00Z"), nil)
+		assert.Equal(t, http.StatusOK, code)
+		code, _ = send(echoInstance, http.MethodPost, reservationsPath, bookingAt("2025-01-10T19:10:00Z"), nil)
+		assert.Equal(t, http.StatusOK, code)
+
+		code, resp := send(echoInstance, http.MethodPost, reservationsPath, bookingAt("2025-01-10T19:05:00Z"), nil)
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Equal(t, "pacing limit reached for arrivals between 19:00 and 19:15", resp.Data)
+		assert.Equal(t, "ERR01005", resp.Code)
+
+		// The next interval has its own allowance.
+		code, _ = send(echoInstance, http.MethodPost, reservationsPath, bookingAt("2025-01-10T19:15:00Z"), nil)
+		assert.Equal(t, http.StatusOK, code)
+	})
+	t.Run("should leave paced intervals out of availability", func(t *testing.T) {
+		echoInstance := setup(t)
+
+		code, _ := send(echoInstance, http.MethodPost, reservationsPath, `{"num_customers": 4, "guest": {"name": "Alex Tan", "phone": "+66812345678"}, "start_time": "2025-01-10T19:00:00Z"}`, nil)
+		assert.Equal(t, http.StatusOK, code)
+
+		var availability dto.AvailabilityResponse
+		code, _ = send(echoInstance, http.MethodGet, availabilityPath+"?party_size=2&date=2025-01-10&from=19:00&to=19:30", "", &availability)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Len(t, availability.Slots, 2)
+		assert.Equal(t, time.Date(2025, 1, 10, 19, 15, 0, 0, time.UTC), availability.Slots[0].StartTime)
+	})
+	t.Run("should pace arrivals after midnight in the shift's intervals", func(t *testing.T) {
+		echoInstance := Setup()
+		initializeTables(t, echoInstance, 4)
+		// Intervals of a shift opening at 22:10 start at 00:10, 00:25, and so on after midnight.
+		code, _ := send(echoInstance, http.MethodPut, schedulePath, `{"shifts": [{"name": "Late", "day": "friday", "opens": "22:10", "closes": "27:00", "max_covers": 4}]}`, nil)
+		assert.Equal(t, http.StatusOK, code)
+
+		code, _ = send(echoInstance, http.MethodPost, reservationsPath, bookingAt("2025-01-11T00:10:00Z"), nil)
+		assert.Equal(t, http.StatusOK, code)
+		code, _ = send(echoInstance, http.MethodPost, reservationsPath, bookingAt("2025-01-11T00:20:00Z"), nil)
+		assert.Equal(t, http.StatusOK, code)
+
+		code, resp := send(echoInstance, http.MethodPost, reservationsPath, bookingAt("2025-01-11T00:15:00Z"), nil)
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Equal(t, "pacing limit reached for arrivals between 00:10 and 00:25", resp.Data)
+		assert.Equal(t, "ERR01005", resp.Code)
+	})
+}
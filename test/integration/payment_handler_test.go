@@ -0,0 +1,146 @@
+package integration_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/bossncn/go-common/http/model"
+	"github.com/bossncn/restaurant-reservation-service/internal/adapters/dto"
+	"github.com/bossncn/restaurant-reservation-service/internal/adapters/event"
+	"github.com/bossncn/restaurant-reservation-service/internal/adapters/memory"
+	coreModel "github.com/bossncn/restaurant-reservation-service/internal/core/model"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestIntegrationPayments(t *testing.T) {
+	send := func(echoInstance *echo.Echo, method string, path string, body string, data interface{}) (int, model.Response) {
+		req := httptest.NewRequest(method, path, bytes.NewReader([]byte(body)))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		echoInstance.ServeHTTP(rec, req)
+
+		var resp model.Response
+		_ = json.Unmarshal([]byte(rec.Body.String()), &resp)
+		if data != nil {
+			jsonData, _ := json.Marshal(resp.Data)
+			_ = json.Unmarshal(jsonData, data)
+		}
+		return rec.Code, resp
+	}
+	now := time.Date(2025, 1, 9, 12, 0, 0, 0, time.UTC)
+	onlinePath := "/public/restaurants/" + restaurantId + "/reservations"
+	staffPath := "/secure/restaurants/" + restaurantId + "/reservations"
+	tablesPath := "/public/restaurants/" + restaurantId + "/table?start_time=2025-01-10T19:00:00Z"
+	largeParty := `{"num_customers": 6, "guest": {"name": "Alex Tan", "phone": "+66812345678"}, "start_time": "2025-01-10T19:00:00Z"}`
+	setup := func(t *testing.T, clock *manualClock) (*echo.Echo, *memory.FakePaymentGateway) {
+		gateway := memory.NewFakePaymentGateway()
+		echoInstance := Setup(event.WithClock(clock),
+			event.WithPayments(gateway, coreModel.DepositPolicy{MinPartySize: 6, AmountPerGuest: 1000, PaymentTimeout: 15 * time.Minute}))
+		initializeTables(t, echoInstance, 4)
+		return echoInstance, gateway
+	}
+	availableTables := func(t *testing.T, echoInstance *echo.Echo) int {
+		var tables dto.TablesResponse
+		code, _ := send(echoInstance, http.MethodGet, tablesPath, "", &tables)
+		assert.Equal(t, http.StatusOK, code)
+		return tables.AvailableTables
+	}
+
+	t.Run("should confirm a large party once its deposit is paid", func(t *testing.T) {
+		echoInstance, gateway := setup(t, &manualClock{now: now})
+
+		var reservation dto.ReservationResponse
+		code, _ := send(echoInstance, http.MethodPost, onlinePath, largeParty, &reservation)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "pending_payment", reservation.Status)
+		assert.Equal(t, "pending", reservation.DepositStatus)
+		assert.Equal(t, 6000, reservation.DepositAmount)
+		assert.Equal(t, now.Add(15*time.Minute), *reservation.PaymentDueAt)
+		assert.Equal(t, 2, availableTables(t, echoInstance))
+
+		var paid dto.ReservationResponse
+		code, _ = send(echoInstance, http.MethodPost, onlinePath+"/"+reservation.BookingId+"/payment", `{"token": "tok_visa"}`, &paid)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "confirmed", paid.Status)
+		assert.Equal(t, "paid", paid.DepositStatus)
+		assert.Nil(t, paid.PaymentDueAt)
+		assert.Len(t, gateway.Payments, 1)
+	})
+	t.Run("should release the tables when the payment is declined", func(t *testing.T) {
+		echoInstance, _ := setup(t, &manualClock{now: now})
+
+		var reservation dto.ReservationResponse
+		code, _ := send(echoInstance, http.MethodPost, onlinePath, largeParty, &reservation)
+		assert.Equal(t, http.StatusOK, code)
+
+		code, resp := send(echoInstance, http.MethodPost, onlinePath+"/"+reservation.BookingId+"/payment", `{"token": "`+memory.DeclinedToken+`"}`, nil)
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Equal(t, "ERR01003", resp.Code)
+		assert.Equal(t, "payment declined, the tables have been released", resp.Data)
+		assert.Equal(t, 4, availableTables(t, echoInstance))
+
+		code, _ = send(echoInstance, http.MethodGet, staffPath+"/"+reservation.BookingId, "", &reservation)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "payment_failed", reservation.Status)
+	})
+	t.Run("should release the tables when the payment times out", func(t *testing.T) {
+		clock := &manualClock{now: now}
+		echoInstance, _ := setup(t, clock)
+
+		var reservation dto.ReservationResponse
+		code, _ := send(echoInstance, http.MethodPost, onlinePath, largeParty, &reservation)
+		assert.Equal(t, http.StatusOK, code)
+
+		clock.Advance(15 * time.Minute)
+
+		code, resp := send(echoInstance, http.MethodPost, onlinePath+"/"+reservation.BookingId+"/payment", `{"token": "tok_visa"}`, nil)
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Equal(t, "cannot pay the deposit of a expired reservation", resp.Data)
+		assert.Equal(t, 4, availableTables(t, echoInstance))
+	})
+	t.Run("should refund the deposit when the booking is cancelled", func(t *testing.T) {
+		echoInstance, gateway := setup(t, &manualClock{now: now})
+
+		var reservation dto.ReservationResponse
+		code, _ := send(echoInstance, http.MethodPost, staffPath, largeParty, &reservation)
+		assert.Equal(t, http.StatusOK, code)
+		code, _ = send(echoInstance, http.MethodPost, onlinePath+"/"+reservation.BookingId+"/payment", `{"token": "tok_visa"}`, nil)
+		assert.Equal(t, http.StatusOK, code)
+
+		var cancellation dto.CancelReservationResponse
+		code, _ = send(echoInstance, http.MethodDelete, staffPath+"/"+reservation.BookingId, "", &cancellation)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, 6000, cancellation.Refunded)
+		for _, payment := range gateway.Payments {
+			assert.Equal(t, 6000, payment.Refunded)
+		}
+
+		code, _ = send(echoInstance, http.MethodGet, staffPath+"/"+reservation.BookingId, "", &reservation)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "refunded", reservation.DepositStatus)
+	})
+	t.Run("should ask for the deposit when a held large party is confirmed", func(t *testing.T) {
+		echoInstance, gateway := setup(t, &manualClock{now: now})
+
+		var held dto.ReservationResponse
+		code, _ := send(echoInstance, http.MethodPost, "/secure/restaurants/"+restaurantId+"/holds", `{"num_customers": 6, "start_time": "2025-01-10T19:00:00Z"}`, &held)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "held", held.Status)
+
+		var confirmed dto.ReservationResponse
+		code, _ = send(echoInstance, http.MethodPost, staffPath+"/"+held.BookingId+"/confirm", `{"guest": {"name": "Alex Tan", "phone": "+66812345678"}}`, &confirmed)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "pending_payment", confirmed.Status)
+		assert.Equal(t, 6000, confirmed.DepositAmount)
+
+		var paid dto.ReservationResponse
+		code, _ = send(echoInstance, http.MethodPost, onlinePath+"/"+held.BookingId+"/payment", `{"token": "tok_visa"}`, &paid)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "confirmed", paid.Status)
+		assert.Len(t, gateway.Payments, 1)
+	})
+}
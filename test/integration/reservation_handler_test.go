@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"github.com/bossncn/go-common/http/model"
 	"github.com/bossncn/restaurant-reservation-service/internal/adapters/dto"
+	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 	"net/http"
 	"net/http/httptest"
@@ -13,15 +14,15 @@ import (
 )
 
 func TestIntegrationReservations(t *testing.T) {
-	t.Run("POST /secure/reservations", func(t *testing.T) {
+	t.Run("POST /secure/restaurants/:restaurantId/reservations", func(t *testing.T) {
 		t.Run("should return 200 OK", func(t *testing.T) {
 			echoInstance := Setup()
 
 			// Setup
 			initializeTables(t, echoInstance, 2)
 
-			reqBody := `{"num_customers": 5}`
-			req := httptest.NewRequest(http.MethodPost, "/secure/reservations", bytes.NewReader([]byte(reqBody)))
+			reqBody := `{"num_customers": 5, "guest": {"name": "Alex Tan", "phone": "+66812345678"}, "start_time": "2025-01-10T19:00:00Z"}`
+			req := httptest.NewRequest(http.MethodPost, "/secure/restaurants/"+restaurantId+"/reservations", bytes.NewReader([]byte(reqBody)))
 			req.Header.Set("Content-Type", "application/json")
 			rec := httptest.NewRecorder()
 
@@ -42,11 +43,121 @@ func TestIntegrationReservations(t *testing.T) {
 			assert.Equal(t, 2, data.TablesReserved)
 			assert.Equal(t, 0, data.RemainingTables)
 		})
+		t.Run("should seat the party at the smallest table that fits", func(t *testing.T) {
+			echoInstance := Setup()
+
+			// Setup
+			reqBody := `{"tables": [{"id": "six", "capacity": 6}, {"id": "two", "capacity": 2}, {"id": "four", "capacity": 4}]}`
+			req := httptest.NewRequest(http.MethodPost, "/public/restaurants/"+restaurantId+"/table/init", bytes.NewReader([]byte(reqBody)))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			echoInstance.ServeHTTP(rec, req)
+			assert.Equal(t, http.StatusOK, rec.Code)
+
+			reqBody = `{"num_customers": 2, "guest": {"name": "Alex Tan", "phone": "+66812345678"}, "start_time": "2025-01-10T19:00:00Z"}`
+			req = httptest.NewRequest(http.MethodPost, "/secure/restaurants/"+restaurantId+"/reservations", bytes.NewReader([]byte(reqBody)))
+			req.Header.Set("Content-Type", "application/json")
+			rec = httptest.NewRecorder()
+
+			// Action
+			echoInstance.ServeHTTP(rec, req)
+
+			// Assert
+			var resp model.Response
+			_ = json.Unmarshal([]byte(rec.Body.String()), &resp)
+
+			jsonData, _ := json.Marshal(resp.Data)
+
+			var data dto.ReservationResponse
+			_ = json.Unmarshal(jsonData, &data)
+
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Equal(t, []string{"two"}, data.TableIds)
+			assert.Equal(t, 2, data.RemainingTables)
+		})
+		t.Run("should only combine tables that can be pushed together", func(t *testing.T) {
+			echoInstance := Setup()
+
+			// Setup
+			reqBody := `{"tables": [{"id": "A", "capacity": 4, "combinable_with": ["B"]}, {"id": "B", "capacity": 4}, {"id": "C", "capacity": 4}]}`
+			req := httptest.NewRequest(http.MethodPost, "/public/restaurants/"+restaurantId+"/table/init", bytes.NewReader([]byte(reqBody)))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			echoInstance.ServeHTTP(rec, req)
+			assert.Equal(t, http.StatusOK, rec.Code)
+
+			reqBody = `{"num_customers": 7, "guest": {"name": "Alex Tan", "phone": "+66812345678"}, "start_time": "2025-01-10T19:00:00Z"}`
+			req = httptest.NewRequest(http.MethodPost, "/secure/restaurants/"+restaurantId+"/reservations", bytes.NewReader([]byte(reqBody)))
+			req.Header.Set("Content-Type", "application/json")
+			rec = httptest.NewRecorder()
+
+			// Action
+			echoInstance.ServeHTTP(rec, req)
+
+			// Assert
+			var resp model.Response
+			_ = json.Unmarshal([]byte(rec.Body.String()), &resp)
+
+			jsonData, _ := json.Marshal(resp.Data)
+
+			var data dto.ReservationResponse
+			_ = json.Unmarshal(jsonData, &data)
+
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.True(t, data.Combined)
+			assert.ElementsMatch(t, []string{"A", "B"}, data.TableIds)
+			assert.Len(t, data.Tables, 2)
+
+			// C is free but cannot be pushed against anything left.
+			req = httptest.NewRequest(http.MethodPost, "/secure/restaurants/"+restaurantId+"/reservations", bytes.NewReader([]byte(reqBody)))
+			req.Header.Set("Content-Type", "application/json")
+			rec = httptest.NewRecorder()
+
+			echoInstance.ServeHTTP(rec, req)
+
+			_ = json.Unmarshal([]byte(rec.Body.String()), &resp)
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+			assert.Equal(t, "not enough tables available", resp.Data)
+		})
+		t.Run("should reserve the same tables for different time slots", func(t *testing.T) {
+			echoInstance := Setup()
+
+			// Setup
+			initializeTables(t, echoInstance, 2)
+
+			for _, startTime := range []string{"2025-01-10T19:00:00Z", "2025-01-11T12:00:00Z"} {
+				reqBody := fmt.Sprintf(`{"num_customers": 5, "guest": {"name": "Alex Tan", "phone": "+66812345678"}, "start_time": "%s"}`, startTime)
+				req := httptest.NewRequest(http.MethodPost, "/secure/restaurants/"+restaurantId+"/reservations", bytes.NewReader([]byte(reqBody)))
+				req.Header.Set("Content-Type", "application/json")
+				rec := httptest.NewRecorder()
+
+				// Action
+				echoInstance.ServeHTTP(rec, req)
+
+				// Assert
+				assert.Equal(t, http.StatusOK, rec.Code)
+			}
+
+			reqBody := `{"num_customers": 1, "guest": {"name": "Alex Tan", "phone": "+66812345678"}, "start_time": "2025-01-10T20:00:00Z", "duration_minutes": 60}`
+			req := httptest.NewRequest(http.MethodPost, "/secure/restaurants/"+restaurantId+"/reservations", bytes.NewReader([]byte(reqBody)))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+
+			// Action
+			echoInstance.ServeHTTP(rec, req)
+
+			// Assert
+			var resp model.Response
+			_ = json.Unmarshal([]byte(rec.Body.String()), &resp)
+
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+			assert.Equal(t, "not enough tables available", resp.Data)
+		})
 		t.Run("should return 400 Bad Request", func(t *testing.T) {
 			echoInstance := Setup()
 
-			reqBody := `{"num_customers": 5}`
-			req := httptest.NewRequest(http.MethodPost, "/secure/reservations", bytes.NewReader([]byte(reqBody)))
+			reqBody := `{"num_customers": 5, "guest": {"name": "Alex Tan", "phone": "+66812345678"}, "start_time": "2025-01-10T19:00:00Z"}`
+			req := httptest.NewRequest(http.MethodPost, "/secure/restaurants/"+restaurantId+"/reservations", bytes.NewReader([]byte(reqBody)))
 			req.Header.Set("Content-Type", "application/json")
 			rec := httptest.NewRecorder()
 
@@ -61,15 +172,15 @@ func TestIntegrationReservations(t *testing.T) {
 			assert.Equal(t, "tables has not been initialized", resp.Data)
 		})
 	})
-	t.Run("DELETE /reservations/:id", func(t *testing.T) {
+	t.Run("DELETE /secure/restaurants/:restaurantId/reservations/:id", func(t *testing.T) {
 		t.Run("should return 200 OK", func(t *testing.T) {
 			echoInstance := Setup()
 
 			// Setup
 			initializeTables(t, echoInstance, 2)
 
-			reqBody := `{"num_customers": 5}`
-			req := httptest.NewRequest(http.MethodPost, "/secure/reservations", bytes.NewReader([]byte(reqBody)))
+			reqBody := `{"num_customers": 5, "guest": {"name": "Alex Tan", "phone": "+66812345678"}, "start_time": "2025-01-10T19:00:00Z"}`
+			req := httptest.NewRequest(http.MethodPost, "/secure/restaurants/"+restaurantId+"/reservations", bytes.NewReader([]byte(reqBody)))
 			req.Header.Set("Content-Type", "application/json")
 			rec := httptest.NewRecorder()
 
@@ -88,7 +199,7 @@ func TestIntegrationReservations(t *testing.T) {
 			assert.Equal(t, http.StatusOK, rec.Code)
 			assert.NotNil(t, data.BookingId)
 
-			deleteReq := httptest.NewRequest(http.MethodDelete, fmt.Sprintf("/secure/reservations/%s", data.BookingId), nil)
+			deleteReq := httptest.NewRequest(http.MethodDelete, fmt.Sprintf("/secure/restaurants/%s/reservations/%s", restaurantId, data.BookingId), nil)
 			req.Header.Set("Content-Type", "application/json")
 			deleteRec := httptest.NewRecorder()
 
@@ -115,8 +226,8 @@ func TestIntegrationReservations(t *testing.T) {
 				// Setup
 				initializeTables(t, echoInstance, 2)
 
-				reqBody := `{"num_customers": 5}`
-				req := httptest.NewRequest(http.MethodPost, "/secure/reservations", bytes.NewReader([]byte(reqBody)))
+				reqBody := `{"num_customers": 5, "guest": {"name": "Alex Tan", "phone": "+66812345678"}, "start_time": "2025-01-10T19:00:00Z"}`
+				req := httptest.NewRequest(http.MethodPost, "/secure/restaurants/"+restaurantId+"/reservations", bytes.NewReader([]byte(reqBody)))
 				req.Header.Set("Content-Type", "application/json")
 				rec := httptest.NewRecorder()
 
@@ -135,7 +246,7 @@ func TestIntegrationReservations(t *testing.T) {
 				assert.Equal(t, http.StatusOK, rec.Code)
 				assert.NotNil(t, data.BookingId)
 
-				deleteReq := httptest.NewRequest(http.MethodDelete, fmt.Sprintf("/secure/reservations/%s", "somthing_else"), nil)
+				deleteReq := httptest.NewRequest(http.MethodDelete, fmt.Sprintf("/secure/restaurants/%s/reservations/%s", restaurantId, "somthing_else"), nil)
 				req.Header.Set("Content-Type", "application/json")
 				deleteRec := httptest.NewRecorder()
 
@@ -151,4 +262,125 @@ func TestIntegrationReservations(t *testing.T) {
 			})
 		})
 	})
+	t.Run("reservation lifecycle", func(t *testing.T) {
+		send := func(echoInstance *echo.Echo, method string, path string, body string) (int, model.Response) {
+			req := httptest.NewRequest(method, path, bytes.NewReader([]byte(body)))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			echoInstance.ServeHTTP(rec, req)
+
+			var resp model.Response
+			_ = json.Unmarshal([]byte(rec.Body.String()), &resp)
+			return rec.Code, resp
+		}
+		decode := func(resp model.Response) dto.ReservationResponse {
+			jsonData, _ := json.Marshal(resp.Data)
+			var data dto.ReservationResponse
+			_ = json.Unmarshal(jsonData, &data)
+			return data
+		}
+		reservationsPath := "/secure/restaurants/" + restaurantId + "/reservations"
+
+		t.Run("should release the table once the party has finished", func(t *testing.T) {
+			echoInstance := Setup()
+			initializeTables(t, echoInstance, 1)
+
+			code, resp := send(echoInstance, http.MethodPost, reservationsPath, `{"num_customers": 4, "guest": {"name": "Alex Tan", "phone": "+66812345678"}, "start_time": "2025-01-10T19:00:00Z"}`)
+			assert.Equal(t, http.StatusOK, code)
+			booking := decode(resp)
+			assert.Equal(t, "confirmed", booking.Status)
+
+			code, resp = send(echoInstance, http.MethodPost, reservationsPath+"/"+booking.BookingId+"/seat", "")
+			assert.Equal(t, http.StatusOK, code)
+			assert.Equal(t, "seated", decode(resp).Status)
+			assert.Equal(t, 0, decode(resp).RemainingTables)
+
+			code, resp = send(echoInstance, http.MethodPost, reservationsPath+"/"+booking.BookingId+"/complete", "")
+			assert.Equal(t, http.StatusOK, code)
+			assert.Equal(t, "completed", decode(resp).Status)
+			assert.Equal(t, 1, decode(resp).RemainingTables)
+
+			// A finished reservation cannot be seated again
+			code, resp = send(echoInstance, http.MethodPost, reservationsPath+"/"+booking.BookingId+"/seat", "")
+			assert.Equal(t, http.StatusBadRequest, code)
+			assert.Equal(t, "cannot change reservation from completed to seated", resp.Data)
+		})
+		t.Run("should keep cancelled reservations queryable", func(t *testing.T) {
+			echoInstance := Setup()
+			initializeTables(t, echoInstance, 1)
+
+			_, resp := send(echoInstance, http.MethodPost, reservationsPath, `{"num_customers": 4, "guest": {"name": "Alex Tan", "phone": "+66812345678"}, "start_time": "2025-01-10T19:00:00Z"}`)
+			booking := decode(resp)
+
+			code, _ := send(echoInstance, http.MethodDelete, reservationsPath+"/"+booking.BookingId, "")
+			assert.Equal(t, http.StatusOK, code)
+
+			code, resp = send(echoInstance, http.MethodGet, reservationsPath+"/"+booking.BookingId, "")
+			assert.Equal(t, http.StatusOK, code)
+			assert.Equal(t, "cancelled", decode(resp).Status)
+			assert.Equal(t, dto.GuestResponse{Name: "Alex Tan", Phone: "+66812345678"}, decode(resp).Guest)
+			assert.Equal(t, []string{"T1"}, decode(resp).TableIds)
+
+			code, resp = send(echoInstance, http.MethodDelete, reservationsPath+"/"+booking.BookingId, "")
+			assert.Equal(t, http.StatusBadRequest, code)
+			assert.Equal(t, "cannot change reservation from cancelled to cancelled", resp.Data)
+		})
+		t.Run("should reject invalid guest contact details", func(t *testing.T) {
+			echoInstance := Setup()
+			initializeTables(t, echoInstance, 1)
+
+			code, resp := send(echoInstance, http.MethodPost, reservationsPath, `{"num_customers": 4, "guest": {"name": "Alex Tan", "phone": "12", "email": "not-an-email"}, "start_time": "2025-01-10T19:00:00Z"}`)
+
+			assert.Equal(t, http.StatusBadRequest, code)
+			assert.Equal(t, []interface{}{
+				map[string]interface{}{"field": "guest.phone", "message": "must be a phone number in international format, e.g. +66812345678"},
+				map[string]interface{}{"field": "guest.email", "message": "must be a valid email address"},
+			}, resp.Data)
+		})
+		t.Run("should grow a party onto combined tables", func(t *testing.T) {
+			echoInstance := Setup()
+			initializeTables(t, echoInstance, 3)
+
+			_, resp := send(echoInstance, http.MethodPost, reservationsPath, `{"num_customers": 4, "guest": {"name": "Alex Tan", "phone": "+66812345678"}, "start_time": "2025-01-10T19:00:00Z"}`)
+			booking := decode(resp)
+			assert.Equal(t, []string{"T1"}, booking.TableIds)
+
+			code, resp := send(echoInstance, http.MethodPatch, reservationsPath+"/"+booking.BookingId, `{"num_customers": 7}`)
+			assert.Equal(t, http.StatusOK, code)
+			assert.Equal(t, booking.BookingId, decode(resp).BookingId)
+			assert.Equal(t, 2, decode(resp).TablesReserved)
+			assert.Contains(t, decode(resp).TableIds, "T1")
+			assert.Equal(t, 1, decode(resp).RemainingTables)
+		})
+		t.Run("should keep the original tables when the new party does not fit", func(t *testing.T) {
+			echoInstance := Setup()
+			initializeTables(t, echoInstance, 2)
+
+			_, resp := send(echoInstance, http.MethodPost, reservationsPath, `{"num_customers": 4, "guest": {"name": "Alex Tan", "phone": "+66812345678"}, "start_time": "2025-01-10T19:00:00Z"}`)
+			booking := decode(resp)
+			_, _ = send(echoInstance, http.MethodPost, reservationsPath, `{"num_customers": 4, "guest": {"name": "Sam Lee", "phone": "+66812345679"}, "start_time": "2025-01-10T19:00:00Z"}`)
+
+			code, resp := send(echoInstance, http.MethodPatch, reservationsPath+"/"+booking.BookingId, `{"num_customers": 7}`)
+			assert.Equal(t, http.StatusBadRequest, code)
+			assert.Equal(t, "not enough tables available", resp.Data)
+
+			_, resp = send(echoInstance, http.MethodGet, reservationsPath+"/"+booking.BookingId, "")
+			assert.Equal(t, booking.TableIds, decode(resp).TableIds)
+			assert.Equal(t, 0, decode(resp).RemainingTables)
+		})
+		t.Run("should free the table of a no-show", func(t *testing.T) {
+			echoInstance := Setup()
+			initializeTables(t, echoInstance, 1)
+
+			_, resp := send(echoInstance, http.MethodPost, reservationsPath, `{"num_customers": 4, "guest": {"name": "Alex Tan", "phone": "+66812345678"}, "start_time": "2025-01-10T19:00:00Z"}`)
+			booking := decode(resp)
+
+			code, resp := send(echoInstance, http.MethodPost, reservationsPath+"/"+booking.BookingId+"/no-show", "")
+			assert.Equal(t, http.StatusOK, code)
+			assert.Equal(t, "no_show", decode(resp).Status)
+
+			code, _ = send(echoInstance, http.MethodPost, reservationsPath, `{"num_customers": 4, "guest": {"name": "Alex Tan", "phone": "+66812345678"}, "start_time": "2025-01-10T19:00:00Z"}`)
+			assert.Equal(t, http.StatusOK, code)
+		})
+	})
 }
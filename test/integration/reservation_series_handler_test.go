@@ -0,0 +1,99 @@
+package integration_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/bossncn/go-common/http/model"
+	"github.com/bossncn/restaurant-reservation-service/internal/adapters/dto"
+	"github.com/bossncn/restaurant-reservation-service/internal/adapters/event"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestIntegrationReservationSeries(t *testing.T) {
+	send := func(echoInstance *echo.Echo, method string, path string, body string, data interface{}) (int, model.Response) {
+		req := httptest.NewRequest(method, path, bytes.NewReader([]byte(body)))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		echoInstance.ServeHTTP(rec, req)
+
+		var resp model.Response
+		_ = json.Unmarshal([]byte(rec.Body.String()), &resp)
+		if data != nil {
+			jsonData, _ := json.Marshal(resp.Data)
+			_ = json.Unmarshal(jsonData, data)
+		}
+		return rec.Code, resp
+	}
+	reservationsPath := "/secure/restaurants/" + restaurantId + "/reservations"
+	seriesPath := reservationsPath + "/series"
+	fridays := `{"num_customers": 2, "guest": {"name": "Alex Tan", "phone": "+66812345678"}, "start_time": "2025-01-10T19:00:00Z", "recurrence": {"count": 4}}`
+	bookingAt := func(startTime string) string {
+		return `{"num_customers": 2, "guest": {"name": "Sam Lee", "phone": "+66812345679"}, "start_time": "` + startTime + `"}`
+	}
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("should book every occurrence on its own tables", func(t *testing.T) {
+		echoInstance := Setup(event.WithClock(&manualClock{now: now}))
+		initializeTables(t, echoInstance, 1)
+
+		var series dto.ReservationSeriesResponse
+		code, _ := send(echoInstance, http.MethodPost, seriesPath, fridays, &series)
+		assert.Equal(t, http.StatusOK, code)
+		assert.NotEmpty(t, series.SeriesId)
+		assert.Len(t, series.Occurrences, 4)
+		assert.Equal(t, time.Date(2025, 1, 31, 19, 0, 0, 0, time.UTC), series.Occurrences[3].StartTime)
+
+		// Each occurrence holds the only table.
+		code, _ = send(echoInstance, http.MethodPost, reservationsPath, bookingAt("2025-01-24T19:00:00Z"), nil)
+		assert.Equal(t, http.StatusBadRequest, code)
+
+		// Cancelling one occurrence frees its table and leaves the rest of the series booked.
+		code, _ = send(echoInstance, http.MethodDelete, reservationsPath+"/"+series.Occurrences[2].BookingId, "", nil)
+		assert.Equal(t, http.StatusOK, code)
+		code, _ = send(echoInstance, http.MethodPost, reservationsPath, bookingAt("2025-01-24T19:00:00Z"), nil)
+		assert.Equal(t, http.StatusOK, code)
+		code, _ = send(echoInstance, http.MethodPost, reservationsPath, bookingAt("2025-01-31T19:00:00Z"), nil)
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+	t.Run("should cancel the whole series", func(t *testing.T) {
+		echoInstance := Setup(event.WithClock(&manualClock{now: now}))
+		initializeTables(t, echoInstance, 1)
+
+		var series dto.ReservationSeriesResponse
+		code, _ := send(echoInstance, http.MethodPost, seriesPath, fridays, &series)
+		assert.Equal(t, http.StatusOK, code)
+
+		var cancelled dto.CancelSeriesResponse
+		code, _ = send(echoInstance, http.MethodDelete, seriesPath+"/"+series.SeriesId, "", &cancelled)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Len(t, cancelled.BookingIds, 4)
+
+		var reservation dto.ReservationResponse
+		code, _ = send(echoInstance, http.MethodGet, reservationsPath+"/"+series.Occurrences[1].BookingId, "", &reservation)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "cancelled", reservation.Status)
+		code, _ = send(echoInstance, http.MethodPost, reservationsPath, bookingAt("2025-01-17T19:00:00Z"), nil)
+		assert.Equal(t, http.StatusOK, code)
+	})
+	t.Run("should report conflicting occurrences and book none of them", func(t *testing.T) {
+		echoInstance := Setup(event.WithClock(&manualClock{now: now}))
+		initializeTables(t, echoInstance, 1)
+		code, _ := send(echoInstance, http.MethodPost, reservationsPath, bookingAt("2025-01-17T19:30:00Z"), nil)
+		assert.Equal(t, http.StatusOK, code)
+
+		var conflict dto.RecurrenceConflictResponse
+		code, _ = send(echoInstance, http.MethodPost, seriesPath, fridays, &conflict)
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Len(t, conflict.Occurrences, 1)
+		assert.Equal(t, time.Date(2025, 1, 17, 19, 0, 0, 0, time.UTC), conflict.Occurrences[0].StartTime)
+		assert.Equal(t, "not enough tables available", conflict.Occurrences[0].Reason)
+
+		code, _ = send(echoInstance, http.MethodPost, reservationsPath, bookingAt("2025-01-10T19:00:00Z"), nil)
+		assert.Equal(t, http.StatusOK, code)
+	})
+}
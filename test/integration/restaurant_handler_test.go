@@ -0,0 +1,126 @@
+package integration_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/bossncn/go-common/http/model"
+	"github.com/bossncn/go-common/http/model/error_code"
+	"github.com/bossncn/restaurant-reservation-service/internal/adapters/dto"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func createRestaurant(t *testing.T, echoInstance *echo.Echo, id string, name string) {
+	reqJSON, _ := json.Marshal(dto.CreateRestaurantRequest{Id: id, Name: name})
+	req := httptest.NewRequest(http.MethodPost, "/secure/restaurants", bytes.NewReader(reqJSON))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	echoInstance.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestIntegrationRestaurants(t *testing.T) {
+	t.Run("POST /secure/restaurants", func(t *testing.T) {
+		t.Run("should list the registered restaurants", func(t *testing.T) {
+			echoInstance := Setup()
+
+			createRestaurant(t, echoInstance, "harbour", "Harbour")
+
+			req := httptest.NewRequest(http.MethodGet, "/public/restaurants", nil)
+			rec := httptest.NewRecorder()
+
+			// Action
+			echoInstance.ServeHTTP(rec, req)
+
+			// Assert
+			var resp model.Response
+			_ = json.Unmarshal([]byte(rec.Body.String()), &resp)
+
+			jsonData, _ := json.Marshal(resp.Data)
+
+			var data []dto.RestaurantResponse
+			_ = json.Unmarshal(jsonData, &data)
+
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Equal(t, []dto.RestaurantResponse{{Id: "downtown", Name: "Downtown"}, {Id: "harbour", Name: "Harbour"}}, data)
+		})
+		t.Run("should return 400 for a duplicate id", func(t *testing.T) {
+			echoInstance := Setup()
+
+			req := httptest.NewRequest(http.MethodPost, "/secure/restaurants", bytes.NewReader([]byte(`{"id": "downtown", "name": "Again"}`)))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+
+			// Action
+			echoInstance.ServeHTTP(rec, req)
+
+			// Assert
+			var resp model.Response
+			_ = json.Unmarshal([]byte(rec.Body.String()), &resp)
+
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+			assert.Equal(t, "restaurant downtown already exists", resp.Data)
+		})
+	})
+	t.Run("unknown restaurant", func(t *testing.T) {
+		echoInstance := Setup()
+
+		req := httptest.NewRequest(http.MethodPost, "/public/restaurants/nowhere/table/init", bytes.NewReader([]byte(`{"num_tables": 2}`)))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+
+		// Action
+		echoInstance.ServeHTTP(rec, req)
+
+		// Assert
+		var resp model.Response
+		_ = json.Unmarshal([]byte(rec.Body.String()), &resp)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Equal(t, error_code.NotFound, resp.Code)
+		assert.Equal(t, "restaurant not found", resp.Data)
+	})
+	t.Run("isolation between restaurants", func(t *testing.T) {
+		echoInstance := Setup()
+
+		createRestaurant(t, echoInstance, "harbour", "Harbour")
+		initializeTables(t, echoInstance, 1)
+
+		reqBody := `{"num_customers": 4, "guest": {"name": "Alex Tan", "phone": "+66812345678"}, "start_time": "2025-01-10T19:00:00Z"}`
+		req := httptest.NewRequest(http.MethodPost, "/secure/restaurants/"+restaurantId+"/reservations", bytes.NewReader([]byte(reqBody)))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		echoInstance.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var resp model.Response
+		_ = json.Unmarshal([]byte(rec.Body.String()), &resp)
+		jsonData, _ := json.Marshal(resp.Data)
+		var data dto.ReservationResponse
+		_ = json.Unmarshal(jsonData, &data)
+
+		// The other venue has no inventory of its own yet.
+		req = httptest.NewRequest(http.MethodPost, "/secure/restaurants/harbour/reservations", bytes.NewReader([]byte(reqBody)))
+		req.Header.Set("Content-Type", "application/json")
+		rec = httptest.NewRecorder()
+		echoInstance.ServeHTTP(rec, req)
+
+		_ = json.Unmarshal([]byte(rec.Body.String()), &resp)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Equal(t, "tables has not been initialized", resp.Data)
+
+		// Nor can it cancel the first venue's booking.
+		req = httptest.NewRequest(http.MethodDelete, "/secure/restaurants/harbour/reservations/"+data.BookingId, nil)
+		rec = httptest.NewRecorder()
+		echoInstance.ServeHTTP(rec, req)
+
+		_ = json.Unmarshal([]byte(rec.Body.String()), &resp)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Equal(t, "tables has not been initialized", resp.Data)
+	})
+}
@@ -0,0 +1,142 @@
+package integration_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/bossncn/go-common/http/model"
+	"github.com/bossncn/restaurant-reservation-service/internal/adapters/dto"
+	"github.com/bossncn/restaurant-reservation-service/internal/adapters/event"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestIntegrationSchedule(t *testing.T) {
+	send := func(echoInstance *echo.Echo, method string, path string, body string, data interface{}) (int, model.Response) {
+		req := httptest.NewRequest(method, path, bytes.NewReader([]byte(body)))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		echoInstance.ServeHTTP(rec, req)
+
+		var resp model.Response
+		_ = json.Unmarshal([]byte(rec.Body.String()), &resp)
+		if data != nil {
+			jsonData, _ := json.Marshal(resp.Data)
+			_ = json.Unmarshal(jsonData, data)
+		}
+		return rec.Code, resp
+	}
+	schedulePath := "/secure/restaurants/" + restaurantId + "/schedule"
+	reservationsPath := "/secure/restaurants/" + restaurantId + "/reservations"
+	// Bangkok is UTC+7, so Friday dinner from 17:00 to 22:00 local is 10:00 to 15:00 UTC.
+	schedule := `{
+		"time_zone": "Asia/Bangkok",
+		"shifts": [
+			{"name": "Lunch", "day": "friday", "opens": "11:00", "closes": "14:00"},
+			{"name": "Dinner", "day": "friday", "opens": "17:00", "closes": "22:00", "max_tables": 1}
+		],
+		"closures": [{"date": "2025-01-17", "reason": "staff party"}]
+	}`
+	bookingAt := func(startTime string) string {
+		return `{"num_customers": 2, "guest": {"name": "Alex Tan", "phone": "+66812345678"}, "start_time": "` + startTime + `"}`
+	}
+
+	t.Run("should publish the schedule", func(t *testing.T) {
+		echoInstance := Setup()
+
+		code, _ := send(echoInstance, http.MethodPut, schedulePath, schedule, nil)
+		assert.Equal(t, http.StatusOK, code)
+
+		var saved dto.ScheduleResponse
+		code, _ = send(echoInstance, http.MethodGet, "/public/restaurants/"+restaurantId+"/schedule", "", &saved)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "Asia/Bangkok", saved.TimeZone)
+		assert.Len(t, saved.Shifts, 2)
+		assert.Equal(t, "17:00", saved.Shifts[1].Opens)
+		assert.Equal(t, "staff party", saved.Closures[0].Reason)
+	})
+	t.Run("should only accept bookings inside a shift", func(t *testing.T) {
+		echoInstance := Setup()
+		initializeTables(t, echoInstance, 3)
+		code, _ := send(echoInstance, http.MethodPut, schedulePath, schedule, nil)
+		assert.Equal(t, http.StatusOK, code)
+
+		// 19:00 local on Friday falls in the dinner shift.
+		code, _ = send(echoInstance, http.MethodPost, reservationsPath, bookingAt("2025-01-10T12:00:00Z"), nil)
+		assert.Equal(t, http.StatusOK, code)
+
+		// 15:00 local is between lunch and dinner.
+		code, resp := send(echoInstance, http.MethodPost, reservationsPath, bookingAt("2025-01-10T08:00:00Z"), nil)
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Equal(t, "restaurant is closed at the requested time", resp.Data)
+		assert.Equal(t, "ERR01004", resp.Code)
+
+		// Saturday has no shifts.
+		code, _ = send(echoInstance, http.MethodPost, reservationsPath, bookingAt("2025-01-11T12:00:00Z"), nil)
+		assert.Equal(t, http.StatusBadRequest, code)
+
+		code, resp = send(echoInstance, http.MethodPost, reservationsPath, bookingAt("2025-01-17T12:00:00Z"), nil)
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Equal(t, "restaurant is closed at the requested time: staff party", resp.Data)
+		assert.Equal(t, "ERR01004", resp.Code)
+	})
+	t.Run("should cap the tables booked in a shift", func(t *testing.T) {
+		echoInstance := Setup(event.WithClock(&manualClock{now: time.Date(2025, 1, 9, 12, 0, 0, 0, time.UTC)}))
+		initializeTables(t, echoInstance, 3)
+		code, _ := send(echoInstance, http.MethodPut, schedulePath, schedule, nil)
+		assert.Equal(t, http.StatusOK, code)
+
+		code, _ = send(echoInstance, http.MethodPost, reservationsPath, bookingAt("2025-01-10T12:00:00Z"), nil)
+		assert.Equal(t, http.StatusOK, code)
+
+		// Two tables are still free, but dinner only takes one table at a time.
+		code, resp := send(echoInstance, http.MethodPost, reservationsPath, bookingAt("2025-01-10T12:00:00Z"), nil)
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Equal(t, "not enough tables available", resp.Data)
+
+		// The party can wait for the table to come free.
+		code, _ = send(echoInstance, http.MethodPost, "/secure/restaurants/"+restaurantId+"/waitlist", bookingAt("2025-01-10T12:00:00Z"), nil)
+		assert.Equal(t, http.StatusOK, code)
+	})
+	t.Run("should reject an invalid schedule", func(t *testing.T) {
+		echoInstance := Setup()
+
+		code, resp := send(echoInstance, http.MethodPut, schedulePath, `{"shifts": [{"name": "Dinner", "day": "friday", "opens": "22:00", "closes": "17:00"}]}`, nil)
+
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Equal(t, "shift Dinner must open before midnight and close within a day of opening", resp.Data)
+	})
+	t.Run("should book a shift past midnight against the day it opened", func(t *testing.T) {
+		echoInstance := Setup()
+		initializeTables(t, echoInstance, 3)
+		// Friday's late shift runs from 22:00 to 2am on Saturday local time, 15:00 to 19:00 UTC.
+		late := `{
+			"time_zone": "Asia/Bangkok",
+			"shifts": [{"name": "Late", "day": "friday", "opens": "22:00", "closes": "26:00"}],
+			"closures": [{"date": "2025-01-17", "reason": "staff party"}]
+		}`
+		code, _ := send(echoInstance, http.MethodPut, schedulePath, late, nil)
+		assert.Equal(t, http.StatusOK, code)
+
+		var saved dto.ScheduleResponse
+		code, _ = send(echoInstance, http.MethodGet, "/public/restaurants/"+restaurantId+"/schedule", "", &saved)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "26:00", saved.Shifts[0].Closes)
+
+		// 23:30 on Friday runs past midnight.
+		code, _ = send(echoInstance, http.MethodPost, reservationsPath, bookingAt("2025-01-10T16:30:00Z"), nil)
+		assert.Equal(t, http.StatusOK, code)
+
+		// Midnight on Saturday is still Friday's shift.
+		code, _ = send(echoInstance, http.MethodPost, reservationsPath, bookingAt("2025-01-10T17:00:00Z"), nil)
+		assert.Equal(t, http.StatusOK, code)
+
+		// Closing Friday closes its shift on Saturday morning too.
+		code, resp := send(echoInstance, http.MethodPost, reservationsPath, bookingAt("2025-01-17T17:00:00Z"), nil)
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Equal(t, "restaurant is closed at the requested time: staff party", resp.Data)
+	})
+}
@@ -0,0 +1,73 @@
+package integration_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/bossncn/go-common/http/model"
+	"github.com/bossncn/restaurant-reservation-service/internal/adapters/dto"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestIntegrationSeating(t *testing.T) {
+	send := func(echoInstance *echo.Echo, method string, path string, body string, data interface{}) (int, model.Response) {
+		req := httptest.NewRequest(method, path, bytes.NewReader([]byte(body)))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		echoInstance.ServeHTTP(rec, req)
+
+		var resp model.Response
+		_ = json.Unmarshal([]byte(rec.Body.String()), &resp)
+		if data != nil {
+			jsonData, _ := json.Marshal(resp.Data)
+			_ = json.Unmarshal(jsonData, data)
+		}
+		return rec.Code, resp
+	}
+	reservationsPath := "/secure/restaurants/" + restaurantId + "/reservations"
+	setup := func(t *testing.T) *echo.Echo {
+		echoInstance := Setup()
+		code, _ := send(echoInstance, http.MethodPost, "/public/restaurants/"+restaurantId+"/table/init", `{"tables": [{"id": "P1", "capacity": 4, "section": "patio"}, {"id": "W1", "capacity": 4, "features": ["window", "wheelchair_accessible"]}]}`, nil)
+		assert.Equal(t, http.StatusOK, code)
+		return echoInstance
+	}
+
+	t.Run("should seat a party at a table meeting its requirements", func(t *testing.T) {
+		echoInstance := setup(t)
+
+		var reservation dto.ReservationResponse
+		code, _ := send(echoInstance, http.MethodPost, reservationsPath, `{"num_customers": 2, "guest": {"name": "Sam Lee", "phone": "+66812345678"}, "start_time": "2025-01-10T19:00:00Z", "requirements": {"features": ["wheelchair_accessible"]}}`, &reservation)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, []string{"W1"}, reservation.TableIds)
+		assert.Equal(t, "main_room", reservation.Tables[0].Section)
+		assert.Equal(t, []string{"wheelchair_accessible"}, reservation.Requirements.Features)
+
+		// The only accessible table is taken, so a second accessible booking fails even though P1 is free.
+		code, resp := send(echoInstance, http.MethodPost, reservationsPath, `{"num_customers": 2, "guest": {"name": "Alex Tan", "phone": "+66812345679"}, "start_time": "2025-01-10T19:00:00Z", "requirements": {"features": ["wheelchair_accessible"]}}`, nil)
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Equal(t, "not enough tables available", resp.Data)
+	})
+	t.Run("should report preferences that could not be met", func(t *testing.T) {
+		echoInstance := setup(t)
+
+		var reservation dto.ReservationResponse
+		code, _ := send(echoInstance, http.MethodPost, reservationsPath, `{"num_customers": 2, "guest": {"name": "Sam Lee", "phone": "+66812345678"}, "start_time": "2025-01-10T19:00:00Z", "preferences": {"section": "patio"}}`, &reservation)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, []string{"P1"}, reservation.TableIds)
+		assert.Empty(t, reservation.UnmetPreferences)
+
+		code, _ = send(echoInstance, http.MethodPost, reservationsPath, `{"num_customers": 2, "guest": {"name": "Alex Tan", "phone": "+66812345679"}, "start_time": "2025-01-10T19:00:00Z", "preferences": {"section": "patio"}}`, &reservation)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, []string{"W1"}, reservation.TableIds)
+		assert.Equal(t, []string{"patio"}, reservation.UnmetPreferences)
+	})
+	t.Run("should reject unknown sections", func(t *testing.T) {
+		echoInstance := setup(t)
+
+		code, _ := send(echoInstance, http.MethodPost, reservationsPath, `{"num_customers": 2, "guest": {"name": "Sam Lee", "phone": "+66812345678"}, "start_time": "2025-01-10T19:00:00Z", "requirements": {"section": "rooftop"}}`, nil)
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+}
@@ -3,20 +3,46 @@ package integration_test
 import (
 	"github.com/bossncn/restaurant-reservation-service/internal/adapters/event"
 	"github.com/bossncn/restaurant-reservation-service/internal/adapters/http"
+	"github.com/bossncn/restaurant-reservation-service/internal/core/model"
 	"github.com/labstack/echo/v4"
 	"go.uber.org/zap"
+	"sync"
+	"time"
 )
 
-func Setup() *echo.Echo {
+// restaurantId is the venue registered by Setup.
+const restaurantId = "downtown"
+
+// Setup wires the application against in-memory repositories. opts are applied to the event
+// processor, e.g. to control the clock.
+func Setup(opts ...event.Option) *echo.Echo {
 	logger := zap.NewNop()
 	e := echo.New()
 	repo := http.InitRepository()
-	eventProcessor, requestEvent := event.NewProcessor(repo.TableRepository, repo.ReservationRepository, logger)
+	_, _ = repo.RestaurantRepository.CreateRestaurant(model.Restaurant{Id: restaurantId, Name: "Downtown"})
+	eventProcessor, requestEvent := event.NewProcessor(repo.TableRepository, repo.ReservationRepository, logger, append([]event.Option{event.WithWaitlist(repo.WaitlistRepository), event.WithSchedule(repo.ScheduleRepository), event.WithBlocks(repo.BlockRepository)}, opts...)...)
 	go eventProcessor.ProcessRequests()
 	service := http.InitService(logger, repo, requestEvent)
 	handlers := http.InitHandler(logger, service)
-	handlers.TableHandler.RegisterRoutes(e.Group("/public"))
-	handlers.ReservationHandler.RegisterRoutes(e.Group("/public"), e.Group("/secure"))
+	http.RegisterRoutes(e, http.InitMiddleware(logger, service), handlers)
 
 	return e
 }
+
+// manualClock is a clock the tests move forward by hand.
+type manualClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *manualClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *manualClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
@@ -16,9 +16,9 @@ import (
 
 func initializeTables(t *testing.T, echoInstance *echo.Echo, numTables int) {
 	// Setup
-	expected, _ := json.Marshal(model.CreateResponse("0", "Success", dto.InitializeTableResponse{TotalTables: numTables}))
+	expected, _ := json.Marshal(model.CreateResponse("0", "Success", dto.InitializeTableResponse{TotalTables: numTables, TotalSeats: numTables * 4}))
 	reqBody := fmt.Sprintf(`{"num_tables": %d}`, numTables)
-	req := httptest.NewRequest(http.MethodPost, "/public/table/init", bytes.NewReader([]byte(reqBody)))
+	req := httptest.NewRequest(http.MethodPost, "/public/restaurants/"+restaurantId+"/table/init", bytes.NewReader([]byte(reqBody)))
 	req.Header.Set("Content-Type", "application/json")
 	rec := httptest.NewRecorder()
 
@@ -31,7 +31,7 @@ func initializeTables(t *testing.T, echoInstance *echo.Echo, numTables int) {
 }
 
 func TestIntegrationTables(t *testing.T) {
-	t.Run("POST /public/table/init", func(t *testing.T) {
+	t.Run("POST /public/restaurants/:restaurantId/table/init", func(t *testing.T) {
 		t.Run("should return 200", func(t *testing.T) {
 			echoInstance := Setup()
 
@@ -43,7 +43,7 @@ func TestIntegrationTables(t *testing.T) {
 			initializeTables(t, echoInstance, 10)
 
 			reqBody := fmt.Sprintf(`{"num_tables": 10}`)
-			req := httptest.NewRequest(http.MethodPost, "/public/table/init", bytes.NewReader([]byte(reqBody)))
+			req := httptest.NewRequest(http.MethodPost, "/public/restaurants/"+restaurantId+"/table/init", bytes.NewReader([]byte(reqBody)))
 			req.Header.Set("Content-Type", "application/json")
 			rec := httptest.NewRecorder()
 
@@ -0,0 +1,89 @@
+package integration_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/bossncn/go-common/http/model"
+	"github.com/bossncn/restaurant-reservation-service/internal/adapters/dto"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestIntegrationTableResize(t *testing.T) {
+	send := func(echoInstance *echo.Echo, method string, path string, body string, data interface{}) (int, model.Response) {
+		req := httptest.NewRequest(method, path, bytes.NewReader([]byte(body)))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		echoInstance.ServeHTTP(rec, req)
+
+		var resp model.Response
+		_ = json.Unmarshal([]byte(rec.Body.String()), &resp)
+		if data != nil {
+			jsonData, _ := json.Marshal(resp.Data)
+			_ = json.Unmarshal(jsonData, data)
+		}
+		return rec.Code, resp
+	}
+	tablePath := "/secure/restaurants/" + restaurantId + "/table"
+	reservationsPath := "/secure/restaurants/" + restaurantId + "/reservations"
+	reservation := `{"num_customers": 4, "guest": {"name": "Sam Lee", "phone": "+66812345678"}, "start_time": "2099-01-10T19:00:00Z"}`
+
+	t.Run("should add tables without losing reservations", func(t *testing.T) {
+		echoInstance := Setup()
+		initializeTables(t, echoInstance, 1)
+
+		var booked dto.ReservationResponse
+		code, _ := send(echoInstance, http.MethodPost, reservationsPath, reservation, &booked)
+		assert.Equal(t, http.StatusOK, code)
+		code, _ = send(echoInstance, http.MethodPost, reservationsPath, reservation, nil)
+		assert.Equal(t, http.StatusBadRequest, code)
+
+		var resized dto.InitializeTableResponse
+		code, _ = send(echoInstance, http.MethodPut, tablePath, `{"num_tables": 2}`, &resized)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, dto.InitializeTableResponse{TotalTables: 2, TotalSeats: 8}, resized)
+
+		var found dto.ReservationResponse
+		code, _ = send(echoInstance, http.MethodGet, reservationsPath+"/"+booked.BookingId, "", &found)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, []string{"T1"}, found.TableIds)
+
+		var second dto.ReservationResponse
+		code, _ = send(echoInstance, http.MethodPost, reservationsPath, reservation, &second)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, []string{"T2"}, second.TableIds)
+	})
+	t.Run("should refuse to remove reserved tables", func(t *testing.T) {
+		echoInstance := Setup()
+		initializeTables(t, echoInstance, 2)
+
+		code, _ := send(echoInstance, http.MethodPost, reservationsPath, reservation, nil)
+		assert.Equal(t, http.StatusOK, code)
+		var booked dto.ReservationResponse
+		code, _ = send(echoInstance, http.MethodPost, reservationsPath, reservation, &booked)
+		assert.Equal(t, http.StatusOK, code)
+
+		var conflict dto.InventoryConflictResponse
+		code, _ = send(echoInstance, http.MethodPut, tablePath, `{"num_tables": 1}`, &conflict)
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Len(t, conflict.Reservations, 1)
+		assert.Equal(t, booked.BookingId, conflict.Reservations[0].BookingId)
+		assert.Equal(t, []string{"T2"}, conflict.Reservations[0].TableIds)
+
+		// Once the reservation is cancelled the table can go.
+		code, _ = send(echoInstance, http.MethodDelete, reservationsPath+"/"+booked.BookingId, "", nil)
+		assert.Equal(t, http.StatusOK, code)
+		code, _ = send(echoInstance, http.MethodPut, tablePath, `{"num_tables": 1}`, nil)
+		assert.Equal(t, http.StatusOK, code)
+	})
+	t.Run("should return 400 before initialization", func(t *testing.T) {
+		echoInstance := Setup()
+
+		code, resp := send(echoInstance, http.MethodPut, tablePath, `{"num_tables": 2}`, nil)
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Equal(t, "tables has not been initialized", resp.Data)
+	})
+}
@@ -0,0 +1,73 @@
+package integration_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/bossncn/go-common/http/model"
+	"github.com/bossncn/restaurant-reservation-service/internal/adapters/dto"
+	"github.com/bossncn/restaurant-reservation-service/internal/adapters/event"
+	"github.com/bossncn/restaurant-reservation-service/internal/core/service"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestIntegrationTurnTimes(t *testing.T) {
+	send := func(echoInstance *echo.Echo, body string) (int, model.Response, dto.ReservationResponse) {
+		req := httptest.NewRequest(http.MethodPost, "/secure/restaurants/"+restaurantId+"/reservations", bytes.NewReader([]byte(body)))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		echoInstance.ServeHTTP(rec, req)
+
+		var resp model.Response
+		_ = json.Unmarshal([]byte(rec.Body.String()), &resp)
+		jsonData, _ := json.Marshal(resp.Data)
+		var data dto.ReservationResponse
+		_ = json.Unmarshal(jsonData, &data)
+		return rec.Code, resp, data
+	}
+	booking := func(size string, startTime string, extra string) string {
+		return `{"num_customers": ` + size + `, "guest": {"name": "Alex Tan", "phone": "+66812345678"}, "start_time": "` + startTime + `"` + extra + `}`
+	}
+	turnTimes, _ := service.NewTurnTimes(map[int]time.Duration{1: 90 * time.Minute, 3: 150 * time.Minute}, 15*time.Minute)
+
+	t.Run("should size the booking by party size", func(t *testing.T) {
+		echoInstance := Setup(event.WithTurnTimes(turnTimes))
+		initializeTables(t, echoInstance, 2)
+
+		code, _, small := send(echoInstance, booking("2", "2025-01-10T18:00:00Z", ""))
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, time.Date(2025, 1, 10, 19, 30, 0, 0, time.UTC), small.EndTime)
+
+		code, _, large := send(echoInstance, booking("4", "2025-01-10T18:00:00Z", ""))
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, time.Date(2025, 1, 10, 20, 30, 0, 0, time.UTC), large.EndTime)
+	})
+	t.Run("should keep the buffer free between seatings", func(t *testing.T) {
+		echoInstance := Setup(event.WithTurnTimes(turnTimes))
+		initializeTables(t, echoInstance, 1)
+
+		code, _, _ := send(echoInstance, booking("2", "2025-01-10T18:00:00Z", ""))
+		assert.Equal(t, http.StatusOK, code)
+
+		// The first party leaves at 19:30, so the table is not ready again until 19:45.
+		code, resp, _ := send(echoInstance, booking("2", "2025-01-10T19:30:00Z", ""))
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Equal(t, "not enough tables available", resp.Data)
+
+		code, _, _ = send(echoInstance, booking("2", "2025-01-10T19:45:00Z", ""))
+		assert.Equal(t, http.StatusOK, code)
+	})
+	t.Run("should let a booking override its turn time", func(t *testing.T) {
+		echoInstance := Setup(event.WithTurnTimes(turnTimes))
+		initializeTables(t, echoInstance, 1)
+
+		code, _, reservation := send(echoInstance, booking("2", "2025-01-10T18:00:00Z", `, "duration_minutes": 240`))
+
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, time.Date(2025, 1, 10, 22, 0, 0, 0, time.UTC), reservation.EndTime)
+	})
+}
@@ -0,0 +1,126 @@
+package integration_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/bossncn/go-common/http/model"
+	"github.com/bossncn/restaurant-reservation-service/internal/adapters/dto"
+	"github.com/bossncn/restaurant-reservation-service/internal/adapters/event"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestIntegrationWaitlist(t *testing.T) {
+	send := func(echoInstance *echo.Echo, method string, path string, body string, data interface{}) (int, model.Response) {
+		req := httptest.NewRequest(method, path, bytes.NewReader([]byte(body)))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		echoInstance.ServeHTTP(rec, req)
+
+		var resp model.Response
+		_ = json.Unmarshal([]byte(rec.Body.String()), &resp)
+		if data != nil {
+			jsonData, _ := json.Marshal(resp.Data)
+			_ = json.Unmarshal(jsonData, data)
+		}
+		return rec.Code, resp
+	}
+	reservationsPath := "/secure/restaurants/" + restaurantId + "/reservations"
+	waitlistPath := "/secure/restaurants/" + restaurantId + "/waitlist"
+	partyOf := func(size string, name string) string {
+		return `{"num_customers": ` + size + `, "guest": {"name": "` + name + `", "phone": "+66812345678"}, "start_time": "2025-01-10T19:00:00Z"}`
+	}
+	now := time.Date(2025, 1, 9, 12, 0, 0, 0, time.UTC)
+
+	t.Run("should promote waiting parties in order when a table is released", func(t *testing.T) {
+		echoInstance := Setup(event.WithClock(&manualClock{now: now}))
+		initializeTables(t, echoInstance, 1)
+
+		var booking dto.ReservationResponse
+		code, _ := send(echoInstance, http.MethodPost, reservationsPath, partyOf("4", "Alex Tan"), &booking)
+		assert.Equal(t, http.StatusOK, code)
+
+		var first, second dto.WaitlistEntryResponse
+		code, _ = send(echoInstance, http.MethodPost, waitlistPath, partyOf("2", "Sam Lee"), &first)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, 1, first.Position)
+		code, _ = send(echoInstance, http.MethodPost, waitlistPath, partyOf("3", "Kim Ng"), &second)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, 2, second.Position)
+
+		code, _ = send(echoInstance, http.MethodDelete, reservationsPath+"/"+booking.BookingId, "", nil)
+		assert.Equal(t, http.StatusOK, code)
+
+		var promoted, stillWaiting dto.WaitlistEntryResponse
+		send(echoInstance, http.MethodGet, waitlistPath+"/"+first.WaitlistId, "", &promoted)
+		assert.Equal(t, "promoted", promoted.Status)
+		assert.NotEmpty(t, promoted.ReservationId)
+
+		send(echoInstance, http.MethodGet, waitlistPath+"/"+second.WaitlistId, "", &stillWaiting)
+		assert.Equal(t, "waiting", stillWaiting.Status)
+		assert.Equal(t, 1, stillWaiting.Position)
+
+		var reservation dto.ReservationResponse
+		code, _ = send(echoInstance, http.MethodGet, reservationsPath+"/"+promoted.ReservationId, "", &reservation)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "confirmed", reservation.Status)
+		assert.Equal(t, "Sam Lee", reservation.Guest.Name)
+	})
+	t.Run("should not queue a party that can be seated", func(t *testing.T) {
+		echoInstance := Setup(event.WithClock(&manualClock{now: now}))
+		initializeTables(t, echoInstance, 1)
+
+		code, resp := send(echoInstance, http.MethodPost, waitlistPath, partyOf("2", "Sam Lee"), nil)
+
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Equal(t, "tables are available for this time slot", resp.Data)
+	})
+	t.Run("should skip parties that left the waitlist", func(t *testing.T) {
+		echoInstance := Setup(event.WithClock(&manualClock{now: now}))
+		initializeTables(t, echoInstance, 1)
+
+		var booking dto.ReservationResponse
+		send(echoInstance, http.MethodPost, reservationsPath, partyOf("4", "Alex Tan"), &booking)
+
+		var entry dto.WaitlistEntryResponse
+		send(echoInstance, http.MethodPost, waitlistPath, partyOf("2", "Sam Lee"), &entry)
+
+		code, _ := send(echoInstance, http.MethodDelete, waitlistPath+"/"+entry.WaitlistId, "", &entry)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "left", entry.Status)
+
+		send(echoInstance, http.MethodDelete, reservationsPath+"/"+booking.BookingId, "", nil)
+
+		send(echoInstance, http.MethodGet, waitlistPath+"/"+entry.WaitlistId, "", &entry)
+		assert.Equal(t, "left", entry.Status)
+		assert.Empty(t, entry.ReservationId)
+	})
+	t.Run("should expire parties whose time slot has started", func(t *testing.T) {
+		clock := &manualClock{now: now}
+		echoInstance := Setup(event.WithClock(clock))
+		initializeTables(t, echoInstance, 1)
+
+		var booking dto.ReservationResponse
+		send(echoInstance, http.MethodPost, reservationsPath, partyOf("4", "Alex Tan"), &booking)
+
+		var entry dto.WaitlistEntryResponse
+		code, _ := send(echoInstance, http.MethodPost, waitlistPath, partyOf("2", "Sam Lee"), &entry)
+		assert.Equal(t, http.StatusOK, code)
+
+		// The table comes free after dinner has started, which is too late for the waiting party.
+		clock.Advance(31*time.Hour + 30*time.Minute)
+		code, resp := send(echoInstance, http.MethodPost, waitlistPath, partyOf("2", "Kim Ng"), nil)
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Equal(t, "cannot wait for a time slot that has already started", resp.Data)
+
+		send(echoInstance, http.MethodDelete, reservationsPath+"/"+booking.BookingId, "", nil)
+
+		send(echoInstance, http.MethodGet, waitlistPath+"/"+entry.WaitlistId, "", &entry)
+		assert.Equal(t, "expired", entry.Status)
+		assert.Empty(t, entry.ReservationId)
+	})
+}
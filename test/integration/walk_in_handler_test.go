@@ -0,0 +1,99 @@
+package integration_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/bossncn/go-common/http/model"
+	"github.com/bossncn/restaurant-reservation-service/internal/adapters/dto"
+	"github.com/bossncn/restaurant-reservation-service/internal/adapters/event"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestIntegrationWalkIns(t *testing.T) {
+	send := func(echoInstance *echo.Echo, method string, path string, body string, data interface{}) (int, model.Response) {
+		req := httptest.NewRequest(method, path, bytes.NewReader([]byte(body)))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		echoInstance.ServeHTTP(rec, req)
+
+		var resp model.Response
+		_ = json.Unmarshal([]byte(rec.Body.String()), &resp)
+		if data != nil {
+			jsonData, _ := json.Marshal(resp.Data)
+			_ = json.Unmarshal(jsonData, data)
+		}
+		return rec.Code, resp
+	}
+	walkInsPath := "/secure/restaurants/" + restaurantId + "/walk-ins"
+	reservationsPath := "/secure/restaurants/" + restaurantId + "/reservations"
+	now := time.Date(2025, 1, 10, 19, 0, 0, 0, time.UTC)
+
+	t.Run("should seat a walk-in on a free table", func(t *testing.T) {
+		echoInstance := Setup(event.WithClock(&manualClock{now: now}))
+		initializeTables(t, echoInstance, 1)
+
+		var seated dto.ReservationResponse
+		code, _ := send(echoInstance, http.MethodPost, walkInsPath, `{"num_customers": 2, "name": "Sam Lee"}`, &seated)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "seated", seated.Status)
+		assert.True(t, seated.WalkIn)
+		assert.Equal(t, now, seated.StartTime)
+
+		// The walk-in holds the same inventory advance bookings use.
+		code, resp := send(echoInstance, http.MethodPost, reservationsPath, `{"num_customers": 2, "guest": {"name": "Alex Tan", "phone": "+66812345678"}, "start_time": "2025-01-10T20:00:00Z"}`, nil)
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Equal(t, "not enough tables available", resp.Data)
+
+		code, _ = send(echoInstance, http.MethodPost, reservationsPath+"/"+seated.BookingId+"/complete", "", nil)
+		assert.Equal(t, http.StatusOK, code)
+		code, _ = send(echoInstance, http.MethodPost, reservationsPath, `{"num_customers": 2, "guest": {"name": "Alex Tan", "phone": "+66812345678"}, "start_time": "2025-01-10T20:00:00Z"}`, nil)
+		assert.Equal(t, http.StatusOK, code)
+	})
+	t.Run("should estimate the wait when no table is free", func(t *testing.T) {
+		clock := &manualClock{now: now.Add(-30 * time.Minute)}
+		echoInstance := Setup(event.WithClock(clock))
+		initializeTables(t, echoInstance, 1)
+
+		var first dto.ReservationResponse
+		code, _ := send(echoInstance, http.MethodPost, walkInsPath, `{"num_customers": 2}`, &first)
+		assert.Equal(t, http.StatusOK, code)
+
+		clock.Advance(30 * time.Minute)
+		var wait dto.WalkInWaitResponse
+		code, _ = send(echoInstance, http.MethodPost, walkInsPath, `{"num_customers": 2}`, &wait)
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Equal(t, 90, wait.EstimatedWaitMinutes)
+		assert.Equal(t, now.Add(90*time.Minute), wait.AvailableAt)
+	})
+	t.Run("should keep the tables of a party seated past its end until it is completed", func(t *testing.T) {
+		clock := &manualClock{now: now.Add(-time.Hour)}
+		echoInstance := Setup(event.WithClock(clock))
+		initializeTables(t, echoInstance, 1)
+
+		var booking dto.ReservationResponse
+		code, _ := send(echoInstance, http.MethodPost, reservationsPath, `{"num_customers": 2, "guest": {"name": "Alex Tan", "phone": "+66812345678"}, "start_time": "2025-01-10T19:00:00Z"}`, &booking)
+		assert.Equal(t, http.StatusOK, code)
+		clock.Advance(time.Hour)
+		code, _ = send(echoInstance, http.MethodPost, reservationsPath+"/"+booking.BookingId+"/seat", "", nil)
+		assert.Equal(t, http.StatusOK, code)
+
+		// The party is still at the table half an hour after its booking ended, and nobody knows
+		// when it will leave, so no wait is estimated either.
+		clock.Advance(booking.EndTime.Sub(booking.StartTime) + 30*time.Minute)
+		code, resp := send(echoInstance, http.MethodPost, walkInsPath, `{"num_customers": 2}`, nil)
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Equal(t, "not enough tables available", resp.Data)
+
+		code, _ = send(echoInstance, http.MethodPost, reservationsPath+"/"+booking.BookingId+"/complete", "", nil)
+		assert.Equal(t, http.StatusOK, code)
+		var seated dto.ReservationResponse
+		code, _ = send(echoInstance, http.MethodPost, walkInsPath, `{"num_customers": 2}`, &seated)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "seated", seated.Status)
+	})
+}